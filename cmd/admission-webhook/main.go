@@ -23,16 +23,20 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	// Embed the time zone database to validate the location of time intervals.
+	_ "time/tzdata"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/promql/parser"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/prometheus-operator/prometheus-operator/internal/goruntime"
 	logging "github.com/prometheus-operator/prometheus-operator/internal/log"
 	"github.com/prometheus-operator/prometheus-operator/internal/metrics"
 	"github.com/prometheus-operator/prometheus-operator/pkg/admission"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8s"
 	"github.com/prometheus-operator/prometheus-operator/pkg/server"
 	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
 )
@@ -113,7 +117,28 @@ func main() {
 
 	r := metrics.NewRegistry("prometheus_operator_admission_webhook")
 
-	mux.Handle("/metrics", promhttp.HandlerFor(r, promhttp.HandlerOpts{}))
+	var kclient kubernetes.Interface
+	if serverConfig.AuthConfig.Enabled {
+		restConfig, err := k8s.NewClusterConfig(k8s.ClusterConfig{})
+		if err != nil {
+			logger.Error("failed to create Kubernetes client configuration", "err", err)
+			os.Exit(1)
+		}
+
+		kclient, err = kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			logger.Error("failed to create Kubernetes client", "err", err)
+			os.Exit(1)
+		}
+	}
+
+	auth, err := server.NewDelegatedAuth(logger, &serverConfig.AuthConfig, kclient)
+	if err != nil {
+		logger.Error("failed to configure delegated authentication and authorization", "err", err)
+		os.Exit(1)
+	}
+
+	mux.Handle("/metrics", auth.Handler(promhttp.HandlerFor(r, promhttp.HandlerOpts{})))
 
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
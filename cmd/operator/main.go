@@ -27,6 +27,8 @@ import (
 	"strings"
 	"syscall"
 	"time"
+	// Embed the time zone database to validate the location of time intervals.
+	_ "time/tzdata"
 
 	"github.com/blang/semver/v4"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -35,6 +37,7 @@ import (
 	"github.com/prometheus/prometheus/promql/parser"
 	"golang.org/x/sync/errgroup"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	eventsv1 "k8s.io/api/events/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -57,6 +60,7 @@ import (
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8s"
 	"github.com/prometheus-operator/prometheus-operator/pkg/kubelet"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 	prometheusagentcontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/agent"
 	prometheuscontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/server"
 	"github.com/prometheus-operator/prometheus-operator/pkg/server"
@@ -131,6 +135,9 @@ var (
 	kubeletSyncPeriod    time.Duration
 	kubeletHTTPMetrics   bool
 
+	enableAlertmanagerRouteExplain  bool
+	enableAlertmanagerReceiverTests bool
+
 	featureGates = k8sflag.NewMapStringBool(ptr.To(map[string]bool{}))
 )
 
@@ -164,6 +171,8 @@ func parseFlags(fs *flag.FlagSet) {
 	fs.Var(&cfg.ReloaderConfig.MemoryRequests, "config-reloader-memory-request", "Config Reloader memory requests. Value \"0\" disables it and causes no request to be configured.")
 	fs.Var(&cfg.ReloaderConfig.MemoryLimits, "config-reloader-memory-limit", "Config Reloader memory limits. Value \"0\" disables it and causes no limit to be configured.")
 	fs.BoolVar(&cfg.ReloaderConfig.EnableProbes, "enable-config-reloader-probes", false, "Enable liveness, readiness, and startup probes for the config-reloader container. Default: false")
+	fs.BoolVar(&cfg.ReloaderConfig.EnableDelegatedAuth, "enable-config-reloader-delegated-auth", false, "Authenticate and authorize the requests to the metrics endpoint of the config-reloader container using the Kubernetes TokenReview and SubjectAccessReview APIs. Default: false")
+	fs.DurationVar(&cfg.ReloaderConfig.DelegatedAuthCacheTTL, "config-reloader-delegated-auth-cache-ttl", time.Minute, "Duration for which the config-reloader container caches the delegated authentication and authorization decisions.")
 
 	fs.StringVar(&cfg.AlertmanagerDefaultBaseImage, "alertmanager-default-base-image", operator.DefaultAlertmanagerBaseImage, "Alertmanager default base image (path without tag/version)")
 	fs.StringVar(&cfg.PrometheusDefaultBaseImage, "prometheus-default-base-image", operator.DefaultPrometheusBaseImage, "Prometheus default base image (path without tag/version)")
@@ -195,6 +204,8 @@ func parseFlags(fs *flag.FlagSet) {
 
 	fs.Float64Var(&memlimitRatio, "auto-gomemlimit-ratio", defaultMemlimitRatio, "The ratio of reserved GOMEMLIMIT memory to the detected maximum container or system memory. The value should be greater than 0.0 and less than 1.0. Default: 0.0 (disabled).")
 	fs.BoolVar(&disableUnmanagedPrometheusConfiguration, "disable-unmanaged-prometheus-configuration", false, "Disable support for unmanaged Prometheus configuration when all resource selectors are nil. As stated in the API documentation, unmanaged Prometheus configuration is a deprecated feature which can be avoided with '.spec.additionalScrapeConfigs' or the ScrapeConfig CRD. Default: false.")
+	fs.BoolVar(&enableAlertmanagerReceiverTests, "enable-alertmanager-receiver-tests", false, "Enable the processing of ReceiverTest objects. The operator sends the test notifications itself which requires network egress from the operator to the receivers' endpoints. Default: false.")
+	fs.BoolVar(&enableAlertmanagerRouteExplain, "enable-alertmanager-route-explain", false, "Enable the /debug/alertmanager/routes endpoint which explains how Alertmanager objects route alerts. The endpoint exposes the routing trees of all Alertmanager objects, it is recommended to enable --web.enable-delegated-auth as well. Default: false.")
 	cfg.RegisterFeatureGatesFlags(fs, featureGates)
 
 	logging.RegisterFlags(fs, &logConfig)
@@ -291,7 +302,8 @@ func start() int {
 	logger.Info("Operator's configuration",
 		"watch_referenced_objects_in_all_namespaces", cfg.WatchObjectRefsInAllNamespaces,
 		"controller_id", cfg.ControllerID,
-		"enable_config_reloader_probes", cfg.ReloaderConfig.EnableProbes)
+		"enable_config_reloader_probes", cfg.ReloaderConfig.EnableProbes,
+		"enable_config_reloader_delegated_auth", cfg.ReloaderConfig.EnableDelegatedAuth)
 	goruntime.SetMemLimit(logger, memlimitRatio)
 
 	if len(cfg.Namespaces.AllowList) > 0 && len(cfg.Namespaces.DenyList) > 0 {
@@ -425,6 +437,32 @@ func start() int {
 		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithScrapeConfig())
 	}
 
+	// The HTTPRoute resource is optional, it is only needed when Probe objects
+	// select Gateway API HTTPRoutes.
+	httpRouteSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AllowList.Slice(),
+		prompkg.HTTPRouteGroupVersionResource.GroupVersion(),
+		prompkg.HTTPRouteGroupVersionResource.Resource,
+		k8s.ResourceAttribute{
+			Group:    prompkg.HTTPRouteGroupVersionResource.Group,
+			Version:  prompkg.HTTPRouteGroupVersionResource.Version,
+			Resource: prompkg.HTTPRouteGroupVersionResource.Resource,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check HTTPRoute support", "err", err)
+		cancel()
+		return 1
+	}
+	if httpRouteSupported {
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithHTTPRoute())
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithHTTPRoute())
+	}
+
 	// EndpointSlice v1 became available with Kubernetes v1.21.0.
 	endpointSliceSupported := cfg.KubernetesVersion.GTE(semver.MustParse("1.21.0"))
 	logger.Info("Kubernetes API capabilities", "endpointslices", endpointSliceSupported)
@@ -433,6 +471,64 @@ func start() int {
 		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithEndpointSlice())
 	}
 
+	alertmanagerSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AlertmanagerAllowList.Slice(),
+		monitoringv1.SchemeGroupVersion,
+		monitoringv1.AlertmanagerName,
+		k8s.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1.Version,
+			Resource: monitoringv1.AlertmanagerName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8s.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1.AlertmanagerName),
+			Verbs:    []string{"update"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check Alertmanager support", "err", err)
+		cancel()
+		return 1
+	}
+
+	// The Prometheus and ThanosRuler controllers watch the Alertmanager
+	// resources only when the Alertmanager CRD is installed and the operator
+	// has the required permissions.
+	if alertmanagerSupported {
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithAlertmanager())
+
+		// ThanosRuler also resolves the web port of the Alertmanager pods
+		// from the governing services.
+		allowed, errs, err := k8s.IsAllowed(ctx,
+			kclient.AuthorizationV1().SelfSubjectAccessReviews(),
+			cfg.Namespaces.AlertmanagerAllowList.Slice(),
+			k8s.ResourceAttribute{
+				Group:    corev1.SchemeGroupVersion.Group,
+				Version:  corev1.SchemeGroupVersion.Version,
+				Resource: "services",
+				Verbs:    []string{"get", "list", "watch"},
+			})
+		if err != nil {
+			logger.Error("failed to check permissions on Service resource", "err", err)
+			cancel()
+			return 1
+		}
+
+		if allowed {
+			thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithAlertmanager())
+		} else {
+			for _, reason := range errs {
+				logger.Warn("missing permissions to watch Service resources, alertmanagerSelector and alertmanagerRefs are ignored for ThanosRuler", "reason", reason)
+			}
+		}
+	}
+
 	prometheusSupported, err := checkPrerequisites(
 		ctx,
 		logger,
@@ -568,30 +664,38 @@ func start() int {
 		}
 	}
 
-	alertmanagerSupported, err := checkPrerequisites(
-		ctx,
-		logger,
-		kclient,
-		cfg.Namespaces.AlertmanagerAllowList.Slice(),
-		monitoringv1.SchemeGroupVersion,
-		monitoringv1.AlertmanagerName,
-		k8s.ResourceAttribute{
-			Group:    monitoring.GroupName,
-			Version:  monitoringv1.Version,
-			Resource: monitoringv1.AlertmanagerName,
-			Verbs:    []string{"get", "list", "watch"},
-		},
-		k8s.ResourceAttribute{
-			Group:    monitoring.GroupName,
-			Version:  monitoringv1.Version,
-			Resource: fmt.Sprintf("%s/status", monitoringv1.AlertmanagerName),
-			Verbs:    []string{"update"},
-		},
-	)
-	if err != nil {
-		logger.Error("failed to check Alertmanager support", "err", err)
-		cancel()
-		return 1
+	if enableAlertmanagerReceiverTests {
+		receiverTestSupported, err := checkPrerequisites(
+			ctx,
+			logger,
+			kclient,
+			cfg.Namespaces.AlertmanagerConfigAllowList.Slice(),
+			monitoringv1alpha1.SchemeGroupVersion,
+			monitoringv1alpha1.ReceiverTestName,
+			k8s.ResourceAttribute{
+				Group:    monitoring.GroupName,
+				Version:  monitoringv1alpha1.Version,
+				Resource: monitoringv1alpha1.ReceiverTestName,
+				Verbs:    []string{"get", "list", "watch"},
+			},
+			k8s.ResourceAttribute{
+				Group:    monitoring.GroupName,
+				Version:  monitoringv1alpha1.Version,
+				Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.ReceiverTestName),
+				Verbs:    []string{"update"},
+			},
+		)
+		if err != nil {
+			logger.Error("failed to check ReceiverTest support", "err", err)
+			cancel()
+			return 1
+		}
+		if receiverTestSupported {
+			alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithReceiverTest())
+		}
+	}
+	if enableAlertmanagerRouteExplain {
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithRouteExplain())
 	}
 
 	var ao *alertmanagercontroller.Operator
@@ -736,12 +840,25 @@ func start() int {
 
 	r.MustRegister(cfg.Gates)
 
-	mux.Handle("/metrics", promhttp.HandlerFor(r, promhttp.HandlerOpts{}))
-	mux.Handle("/debug/pprof/", http.HandlerFunc(pprof.Index))
-	mux.Handle("/debug/pprof/cmdline", http.HandlerFunc(pprof.Cmdline))
-	mux.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
-	mux.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
-	mux.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
+	auth, err := server.NewDelegatedAuth(logger, &serverConfig.AuthConfig, kclient)
+	if err != nil {
+		logger.Error("failed to configure delegated authentication and authorization", "err", err)
+		cancel()
+		return 1
+	}
+
+	mux.Handle("/metrics", auth.Handler(promhttp.HandlerFor(r, promhttp.HandlerOpts{})))
+	mux.Handle("/debug/pprof/", auth.Handler(http.HandlerFunc(pprof.Index)))
+	mux.Handle("/debug/pprof/cmdline", auth.Handler(http.HandlerFunc(pprof.Cmdline)))
+	mux.Handle("/debug/pprof/profile", auth.Handler(http.HandlerFunc(pprof.Profile)))
+	mux.Handle("/debug/pprof/symbol", auth.Handler(http.HandlerFunc(pprof.Symbol)))
+	mux.Handle("/debug/pprof/trace", auth.Handler(http.HandlerFunc(pprof.Trace)))
+	if ao != nil && enableAlertmanagerRouteExplain {
+		if !serverConfig.AuthConfig.Enabled {
+			logger.Warn("the Alertmanager route explain endpoint is enabled without authentication, consider enabling --web.enable-delegated-auth")
+		}
+		mux.Handle("/debug/alertmanager/routes", auth.Handler(ao.RouteExplainHandler()))
+	}
 	mux.Handle("/healthz", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
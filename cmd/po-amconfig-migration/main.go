@@ -0,0 +1,153 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// po-amconfig-migration converts an Alertmanager configuration file into
+// AlertmanagerConfig and Secret manifests.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
+)
+
+func main() {
+	fs := flag.CommandLine
+	versionutil.RegisterFlags(fs)
+
+	var configFile = fs.String("config-file", "", "path to the Alertmanager configuration file.")
+	var namespace = fs.String("namespace", "default", "namespace of the Alertmanager object.")
+	var name = fs.String("name", "", "name of the AlertmanagerConfig object holding the root route (default: the name of the Alertmanager object).")
+	var amName = fs.String("alertmanager", "", "name of the Alertmanager object.")
+	var destination = fs.String("output-dir", "", "directory where the manifests are written (default: standard output).")
+
+	// No need to check for errors because Parse would exit on error.
+	_ = fs.Parse(os.Args[1:])
+
+	if versionutil.ShouldPrintVersion() {
+		versionutil.Print(os.Stdout, "po-amconfig-migration")
+		os.Exit(0)
+	}
+
+	if *configFile == "" {
+		log.Print("please specify 'config-file' flag")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	if *amName == "" {
+		log.Print("please specify 'alertmanager' flag")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	if *name == "" {
+		*name = *amName
+	}
+
+	b, err := os.ReadFile(*configFile)
+	if err != nil {
+		log.Fatalf("failed to read file '%v': %v", *configFile, err)
+	}
+
+	res, err := alertmanager.ImportConfiguration(b, alertmanager.ImportOptions{
+		Namespace: *namespace,
+		Name:      *name,
+	})
+	if err != nil {
+		log.Fatalf("failed to convert '%v': %v", *configFile, err)
+	}
+
+	for _, w := range res.Warnings {
+		log.Printf("warning: %s", w)
+	}
+
+	manifests := []manifest{{
+		name: "alertmanager-" + *amName,
+		obj:  alertmanagerPatch(*namespace, *amName, res.AlertmanagerConfiguration),
+	}}
+	for _, amc := range res.AlertmanagerConfigs {
+		manifests = append(manifests, manifest{name: "alertmanagerconfig-" + amc.Name, obj: amc})
+	}
+	for _, s := range res.Secrets {
+		manifests = append(manifests, manifest{name: "secret-" + s.Name, obj: s})
+	}
+
+	if *destination == "" {
+		var buf bytes.Buffer
+		for _, m := range manifests {
+			out, err := yaml.Marshal(m.obj)
+			if err != nil {
+				log.Fatalf("failed to marshal %s: %v", m.name, err)
+			}
+			buf.WriteString("---\n")
+			buf.Write(out)
+		}
+
+		if _, err := os.Stdout.Write(buf.Bytes()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := os.MkdirAll(*destination, 0o755); err != nil {
+		log.Fatalf("failed to create directory '%v': %v", *destination, err)
+	}
+
+	for _, m := range manifests {
+		out, err := yaml.Marshal(m.obj)
+		if err != nil {
+			log.Fatalf("failed to marshal %s: %v", m.name, err)
+		}
+
+		p := filepath.Join(*destination, m.name+".yaml")
+		if err := os.WriteFile(p, out, 0o600); err != nil {
+			log.Fatalf("failed to write file '%v': %v", p, err)
+		}
+		log.Printf("written %s", p)
+	}
+}
+
+type manifest struct {
+	name string
+	obj  any
+}
+
+// alertmanagerPatch returns a merge patch for the Alertmanager object which
+// references the converted configuration. The namespace matcher is disabled
+// to preserve the routing tree of the original configuration.
+func alertmanagerPatch(namespace, name string, amConfiguration *monitoringv1.AlertmanagerConfiguration) map[string]any {
+	return map[string]any{
+		"apiVersion": monitoringv1.SchemeGroupVersion.String(),
+		"kind":       monitoringv1.AlertmanagersKind,
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": map[string]any{
+			"alertmanagerConfiguration": amConfiguration,
+			"alertmanagerConfigMatcherStrategy": monitoringv1.AlertmanagerConfigMatcherStrategy{
+				Type: monitoringv1.NoneConfigMatcherStrategyType,
+			},
+		},
+	}
+}
@@ -0,0 +1,337 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// po-amroute explains how the configuration generated by the operator for an
+// Alertmanager object routes an alert, from a set of Kubernetes manifests.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sYAML "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
+)
+
+type stringSlice []string
+
+func (s *stringSlice) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringSlice) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+func main() {
+	fs := flag.CommandLine
+	versionutil.RegisterFlags(fs)
+
+	var files stringSlice
+	fs.Var(&files, "f", "path to a file containing Kubernetes manifests (Alertmanager, AlertmanagerConfig, Secret, ConfigMap and Namespace objects). Can be repeated.")
+	var amName = fs.String("alertmanager", "", "name ('<name>' or '<namespace>/<name>') of the Alertmanager object. Optional if the manifests contain only one Alertmanager object.")
+	var evalTime = fs.String("time", "", "RFC3339 time at which the time intervals are evaluated (default: now).")
+	var output = fs.String("o", "text", "output format (text or json).")
+
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s -f <file> [flags] <label name>=<label value>...\n\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	// No need to check for errors because Parse would exit on error.
+	_ = fs.Parse(os.Args[1:])
+
+	if versionutil.ShouldPrintVersion() {
+		versionutil.Print(os.Stdout, "po-amroute")
+		os.Exit(0)
+	}
+
+	if len(files) == 0 {
+		log.Print("please specify at least one 'f' flag")
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	if *output != "text" && *output != "json" {
+		log.Fatalf("invalid output format %q", *output)
+	}
+
+	lset, err := alertmanager.ParseAlertLabels(fs.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	t := time.Now()
+	if *evalTime != "" {
+		t, err = time.Parse(time.RFC3339, *evalTime)
+		if err != nil {
+			log.Fatalf("invalid 'time' flag: %v", err)
+		}
+	}
+
+	m := &manifests{}
+	for _, f := range files {
+		if err := m.load(f); err != nil {
+			log.Fatalf("failed to load %q: %v", f, err)
+		}
+	}
+
+	am, err := m.alertmanager(*amName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	cfg, err := alertmanager.GenerateConfiguration(
+		context.Background(),
+		logger,
+		am,
+		alertmanager.Resources{
+			Client:              fake.NewClientset(m.objects...).CoreV1(),
+			Namespaces:          m.namespaces,
+			AlertmanagerConfigs: m.amConfigs,
+		},
+	)
+	if err != nil {
+		log.Fatalf("failed to generate the configuration: %v", err)
+	}
+
+	explanation, err := alertmanager.ExplainRoute(cfg, lset, t)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *output == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(explanation); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	printExplanation(os.Stdout, explanation)
+}
+
+// manifests holds the objects decoded from the manifest files.
+type manifests struct {
+	alertmanagers []*monitoringv1.Alertmanager
+	amConfigs     []*monitoringv1alpha1.AlertmanagerConfig
+	namespaces    []*corev1.Namespace
+	// objects are the Secret and ConfigMap objects.
+	objects []runtime.Object
+}
+
+func (m *manifests) load(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dec := k8sYAML.NewYAMLOrJSONDecoder(f, 4096)
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+
+		if err := m.add(raw); err != nil {
+			return err
+		}
+	}
+}
+
+func (m *manifests) add(raw json.RawMessage) error {
+	var tm metav1.TypeMeta
+	if err := json.Unmarshal(raw, &tm); err != nil {
+		return err
+	}
+
+	switch {
+	case tm.Kind == "List":
+		var l struct {
+			Items []json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(raw, &l); err != nil {
+			return err
+		}
+
+		for _, item := range l.Items {
+			if err := m.add(item); err != nil {
+				return err
+			}
+		}
+
+	case tm.Kind == monitoringv1.AlertmanagersKind:
+		am := &monitoringv1.Alertmanager{}
+		if err := json.Unmarshal(raw, am); err != nil {
+			return err
+		}
+		am.Namespace = namespaceOrDefault(am.Namespace)
+		m.alertmanagers = append(m.alertmanagers, am)
+
+	case tm.Kind == monitoringv1alpha1.AlertmanagerConfigKind && tm.APIVersion == monitoringv1beta1.SchemeGroupVersion.String():
+		src := &monitoringv1beta1.AlertmanagerConfig{}
+		if err := json.Unmarshal(raw, src); err != nil {
+			return err
+		}
+
+		amc := &monitoringv1alpha1.AlertmanagerConfig{}
+		if err := src.ConvertTo(amc); err != nil {
+			return fmt.Errorf("failed to convert AlertmanagerConfig %q: %w", src.Name, err)
+		}
+		amc.Namespace = namespaceOrDefault(amc.Namespace)
+		m.amConfigs = append(m.amConfigs, amc)
+
+	case tm.Kind == monitoringv1alpha1.AlertmanagerConfigKind:
+		amc := &monitoringv1alpha1.AlertmanagerConfig{}
+		if err := json.Unmarshal(raw, amc); err != nil {
+			return err
+		}
+		amc.Namespace = namespaceOrDefault(amc.Namespace)
+		m.amConfigs = append(m.amConfigs, amc)
+
+	case tm.Kind == "Namespace":
+		ns := &corev1.Namespace{}
+		if err := json.Unmarshal(raw, ns); err != nil {
+			return err
+		}
+		m.namespaces = append(m.namespaces, ns)
+
+	case tm.Kind == "Secret":
+		s := &corev1.Secret{}
+		if err := json.Unmarshal(raw, s); err != nil {
+			return err
+		}
+		s.Namespace = namespaceOrDefault(s.Namespace)
+		// Mimic the API server which converts stringData into data.
+		for k, v := range s.StringData {
+			if s.Data == nil {
+				s.Data = map[string][]byte{}
+			}
+			s.Data[k] = []byte(v)
+		}
+		m.objects = append(m.objects, s)
+
+	case tm.Kind == "ConfigMap":
+		cm := &corev1.ConfigMap{}
+		if err := json.Unmarshal(raw, cm); err != nil {
+			return err
+		}
+		cm.Namespace = namespaceOrDefault(cm.Namespace)
+		m.objects = append(m.objects, cm)
+	}
+
+	return nil
+}
+
+// alertmanager returns the Alertmanager object matching the given name. If
+// the name is empty, the manifests must contain exactly one Alertmanager
+// object.
+func (m *manifests) alertmanager(name string) (*monitoringv1.Alertmanager, error) {
+	if name == "" {
+		if len(m.alertmanagers) != 1 {
+			return nil, fmt.Errorf("found %d Alertmanager objects, please specify the 'alertmanager' flag", len(m.alertmanagers))
+		}
+
+		return m.alertmanagers[0], nil
+	}
+
+	ns, n, found := strings.Cut(name, "/")
+	if !found {
+		ns, n = "", name
+	}
+
+	for _, am := range m.alertmanagers {
+		if am.Name == n && (ns == "" || am.Namespace == ns) {
+			return am, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Alertmanager %q not found", name)
+}
+
+func namespaceOrDefault(ns string) string {
+	if ns == "" {
+		return metav1.NamespaceDefault
+	}
+
+	return ns
+}
+
+func printExplanation(w io.Writer, explanation *alertmanager.RouteExplanation) {
+	fmt.Fprintf(w, "Labels: %s\n", explanation.Labels)
+	fmt.Fprintf(w, "Time:   %s\n", explanation.Time.Format(time.RFC3339))
+
+	for i, r := range explanation.Routes {
+		fmt.Fprintf(w, "\nRoute #%d\n", i+1)
+
+		tw := tabwriter.NewWriter(w, 0, 0, 1, ' ', 0)
+		fmt.Fprintf(tw, "  Path:\t%s\n", strings.Join(r.Path, " -> "))
+		fmt.Fprintf(tw, "  Receiver:\t%s\n", r.Receiver)
+		fmt.Fprintf(tw, "  Group by:\t%s\n", strings.Join(r.GroupBy, ", "))
+		fmt.Fprintf(tw, "  Group wait:\t%s\n", r.GroupWait)
+		fmt.Fprintf(tw, "  Group interval:\t%s\n", r.GroupInterval)
+		fmt.Fprintf(tw, "  Repeat interval:\t%s\n", r.RepeatInterval)
+		if len(r.MuteTimeIntervals) > 0 {
+			fmt.Fprintf(tw, "  Mute time intervals:\t%s\n", formatTimeIntervals(r.MuteTimeIntervals))
+		}
+		if len(r.ActiveTimeIntervals) > 0 {
+			fmt.Fprintf(tw, "  Active time intervals:\t%s\n", formatTimeIntervals(r.ActiveTimeIntervals))
+		}
+		fmt.Fprintf(tw, "  Muted:\t%t\n", r.Muted)
+		tw.Flush()
+	}
+}
+
+func formatTimeIntervals(tis []alertmanager.TimeIntervalStatus) string {
+	s := make([]string, 0, len(tis))
+	for _, ti := range tis {
+		state := "inactive"
+		if ti.Contains {
+			state = "active"
+		}
+		s = append(s, fmt.Sprintf("%s (%s)", ti.Name, state))
+	}
+
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,149 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// po-scrapeconfig-migration converts Prometheus scrape configurations into
+// ScrapeConfig and Secret manifests.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"log"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
+)
+
+func main() {
+	fs := flag.CommandLine
+	versionutil.RegisterFlags(fs)
+
+	var configFile = fs.String("config-file", "", "path to a file containing a list of scrape configurations (e.g. the content of the additionalScrapeConfigs Secret) or a Prometheus configuration file.")
+	var namespace = fs.String("namespace", "default", "namespace of the generated objects.")
+	var labels = fs.String("labels", "", "comma-separated list of labels (<name>=<value>) added to the ScrapeConfig objects.")
+	var destination = fs.String("output-dir", "", "directory where the manifests are written (default: standard output).")
+	var verify = fs.Bool("verify", false, "generate the configuration of the ScrapeConfig objects and report the differences with the input.")
+
+	// No need to check for errors because Parse would exit on error.
+	_ = fs.Parse(os.Args[1:])
+
+	if versionutil.ShouldPrintVersion() {
+		versionutil.Print(os.Stdout, "po-scrapeconfig-migration")
+		os.Exit(0)
+	}
+
+	if *configFile == "" {
+		log.Print("please specify 'config-file' flag")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	lset := map[string]string{}
+	if *labels != "" {
+		for l := range strings.SplitSeq(*labels, ",") {
+			k, v, found := strings.Cut(l, "=")
+			if !found || k == "" {
+				log.Fatalf("invalid label %q", l)
+			}
+			lset[k] = v
+		}
+	}
+
+	b, err := os.ReadFile(*configFile)
+	if err != nil {
+		log.Fatalf("failed to read file '%v': %v", *configFile, err)
+	}
+
+	res, err := prometheus.ImportScrapeConfigs(b, prometheus.ScrapeConfigImportOptions{
+		Namespace: *namespace,
+		Labels:    lset,
+	})
+	if err != nil {
+		log.Fatalf("failed to convert '%v': %v", *configFile, err)
+	}
+
+	for _, w := range res.Warnings {
+		log.Printf("warning: %s", w)
+	}
+
+	if *verify {
+		logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+		diffs, err := res.Verify(logger)
+		if err != nil {
+			log.Fatalf("failed to verify the conversion: %v", err)
+		}
+
+		for _, d := range diffs {
+			log.Printf("difference: %s", d)
+		}
+
+		if len(diffs) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	manifests := []manifest{}
+	for _, sc := range res.ScrapeConfigs {
+		manifests = append(manifests, manifest{name: "scrapeconfig-" + sc.Name, obj: sc})
+	}
+	for _, s := range res.Secrets {
+		manifests = append(manifests, manifest{name: "secret-" + s.Name, obj: s})
+	}
+
+	if *destination == "" {
+		var buf bytes.Buffer
+		for _, m := range manifests {
+			out, err := yaml.Marshal(m.obj)
+			if err != nil {
+				log.Fatalf("failed to marshal %s: %v", m.name, err)
+			}
+			buf.WriteString("---\n")
+			buf.Write(out)
+		}
+
+		if _, err := os.Stdout.Write(buf.Bytes()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := os.MkdirAll(*destination, 0o755); err != nil {
+		log.Fatalf("failed to create directory '%v': %v", *destination, err)
+	}
+
+	for _, m := range manifests {
+		out, err := yaml.Marshal(m.obj)
+		if err != nil {
+			log.Fatalf("failed to marshal %s: %v", m.name, err)
+		}
+
+		p := filepath.Join(*destination, m.name+".yaml")
+		if err := os.WriteFile(p, out, 0o600); err != nil {
+			log.Fatalf("failed to write file '%v': %v", p, err)
+		}
+		log.Printf("written %s", p)
+	}
+}
+
+type manifest struct {
+	name string
+	obj  any
+}
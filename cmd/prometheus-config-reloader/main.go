@@ -15,15 +15,21 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	stdlog "log"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"syscall"
 	"time"
@@ -34,11 +40,14 @@ import (
 	"github.com/prometheus/common/version"
 	"github.com/prometheus/exporter-toolkit/web"
 	"github.com/thanos-io/thanos/pkg/reloader"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/prometheus-operator/prometheus-operator/internal/goruntime"
 	logging "github.com/prometheus-operator/prometheus-operator/internal/log"
 	"github.com/prometheus-operator/prometheus-operator/internal/metrics"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8s"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/server"
 	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
 )
 
@@ -47,6 +56,9 @@ const (
 	defaultDelayInterval = 1 * time.Second  // 1 second seems a reasonable amount of time for the kubelet to update the secrets/configmaps.
 	defaultRetryInterval = 5 * time.Second  // 5 seconds was the value previously hardcoded in github.com/thanos-io/thanos/pkg/reloader.
 	defaultReloadTimeout = 30 * time.Second // 30 seconds was the default value
+	defaultAuthCacheTTL  = 1 * time.Minute  // 1 minute is consistent with the operator's default.
+	configPartsInterval  = 5 * time.Second  // How often the config file is reassembled from its parts.
+	configPartsAttempts  = 12               // How many times the config file is assembled at startup when the parts don't match the checksum.
 
 	defaultGOMemlimitRatio = "0.0"
 
@@ -61,9 +73,21 @@ func main() {
 	cfgFile := app.Flag("config-file", "config file watched by the reloader").
 		String()
 
+	cfgFileParts := app.Flag("config-file-parts", "glob pattern of the files which are concatenated in lexical order to create the config file (disabled when empty)").
+		String()
+
+	cfgFilePartsChecksum := app.Flag("config-file-parts-checksum", "file containing the hex-encoded SHA256 checksum of the concatenated config file parts. The config file isn't updated until the parts match the checksum (disabled when empty)").
+		String()
+
 	cfgSubstFile := app.Flag("config-envsubst-file", "output file for environment variable substituted config file").
 		String()
 
+	cfgDir := app.Flag("config-dir", "directory of config files watched by the reloader (disabled when empty)").
+		String()
+
+	cfgDirOutput := app.Flag("config-dir-output", "output directory for the decompressed and environment variable substituted files of the config directory").
+		String()
+
 	watchInterval := app.Flag("watch-interval", "how often the reloader re-reads the configuration file and directories; when set to 0, the program runs only once and exits").Default(defaultWatchInterval.String()).Duration()
 	delayInterval := app.Flag("delay-interval", "how long the reloader waits before reloading after it has detected a change").Default(defaultDelayInterval.String()).Duration()
 	retryInterval := app.Flag("retry-interval", "how long the reloader waits before retrying in case the endpoint returned an error").Default(defaultRetryInterval.String()).Duration()
@@ -91,6 +115,17 @@ func main() {
 		"[EXPERIMENTAL] Path to configuration file that can enable TLS or authentication. See: https://prometheus.io/docs/prometheus/latest/configuration/https/",
 	).Default("").String()
 
+	var authConfig server.AuthConfig
+	app.Flag(
+		"web-enable-delegated-auth",
+		"authenticate and authorize the requests to the metrics endpoint using the Kubernetes TokenReview and SubjectAccessReview APIs").
+		BoolVar(&authConfig.Enabled)
+
+	app.Flag(
+		"web-delegated-auth-cache-ttl",
+		"duration for which the delegated authentication and authorization decisions are cached").
+		Default(defaultAuthCacheTTL.String()).DurationVar(&authConfig.CacheTTL)
+
 	var logConfig logging.Config
 	app.Flag(
 		"log-format",
@@ -155,6 +190,64 @@ func main() {
 		ctx, cancel = context.WithCancel(context.Background())
 	)
 
+	if *cfgFileParts != "" {
+		if *cfgFile == "" {
+			logger.Error("The config-file-parts flag requires the config-file flag")
+			os.Exit(2)
+		}
+
+		// Assemble the config file once before the reloader starts. The
+		// parts may not match the checksum while the Secrets are being
+		// updated.
+		for i := 1; ; i++ {
+			_, err := concatFiles(*cfgFileParts, *cfgFilePartsChecksum, *cfgFile)
+			if err == nil {
+				break
+			}
+
+			if !errors.Is(err, errChecksumMismatch) || i == configPartsAttempts {
+				logger.Error("Failed to assemble the config file", "err", err)
+				os.Exit(1)
+			}
+
+			logger.Warn("Failed to assemble the config file, retrying", "err", err)
+			time.Sleep(configPartsInterval)
+		}
+
+		if *watchInterval != 0 {
+			g.Add(func() error {
+				ticker := time.NewTicker(configPartsInterval)
+				defer ticker.Stop()
+
+				for {
+					select {
+					case <-ctx.Done():
+						return nil
+					case <-ticker.C:
+					}
+
+					changed, err := concatFiles(*cfgFileParts, *cfgFilePartsChecksum, *cfgFile)
+					if errors.Is(err, errChecksumMismatch) {
+						// The parts will eventually match the checksum.
+						logger.Debug("Config file parts not ready", "err", err)
+						continue
+					}
+
+					if err != nil {
+						logger.Error("Failed to assemble the config file", "err", err)
+						continue
+					}
+
+					if changed {
+						logger.Info("Config file assembled from parts", "pattern", *cfgFileParts, "file", *cfgFile)
+					}
+				}
+			}, func(error) {
+				cancel()
+			})
+		}
+	}
+
 	{
 		opts := reloader.Options{
 			CfgFile:                       *cfgFile,
@@ -166,6 +259,24 @@ func main() {
 			TolerateEnvVarExpansionErrors: true,
 		}
 
+		if *cfgDir != "" {
+			if *cfgDirOutput == "" {
+				logger.Error("The config-dir flag requires the config-dir-output flag")
+				os.Exit(2)
+			}
+
+			// The reloader expects the output directory to exist.
+			if err := os.MkdirAll(*cfgDirOutput, 0o755); err != nil {
+				logger.Error("Failed to create the config output directory", "err", err)
+				os.Exit(1)
+			}
+
+			opts.CfgDirs = []reloader.CfgDirOption{{
+				Dir:       *cfgDir,
+				OutputDir: *cfgDirOutput,
+			}}
+		}
+
 		switch *reloadMethod {
 		case signalReloadMethod:
 			opts.RuntimeInfoURL = *runtimeInfoURL
@@ -189,7 +300,28 @@ func main() {
 	}
 
 	if *listenAddress != "" && *watchInterval != 0 {
-		http.Handle("/metrics", promhttp.HandlerFor(r, promhttp.HandlerOpts{Registry: r}))
+		var kclient kubernetes.Interface
+		if authConfig.Enabled {
+			restConfig, err := k8s.NewClusterConfig(k8s.ClusterConfig{})
+			if err != nil {
+				logger.Error("Failed to create Kubernetes client configuration", "err", err)
+				os.Exit(2)
+			}
+
+			kclient, err = kubernetes.NewForConfig(restConfig)
+			if err != nil {
+				logger.Error("Failed to create Kubernetes client", "err", err)
+				os.Exit(2)
+			}
+		}
+
+		auth, err := server.NewDelegatedAuth(logger, &authConfig, kclient)
+		if err != nil {
+			logger.Error("Unable to configure delegated authentication and authorization", "err", err)
+			os.Exit(2)
+		}
+
+		http.Handle("/metrics", auth.Handler(promhttp.HandlerFor(r, promhttp.HandlerOpts{Registry: r})))
 		http.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
 			w.WriteHeader(http.StatusOK)
 			w.Write([]byte(`{"status":"up"}`))
@@ -252,3 +384,66 @@ func createOrdinalEnvvar(fromName string) error {
 	val := reg.FindString(os.Getenv(fromName))
 	return os.Setenv(statefulsetOrdinalEnvvar, val)
 }
+
+// errChecksumMismatch is returned when the config file parts don't match
+// the expected checksum.
+var errChecksumMismatch = errors.New("checksum mismatch")
+
+// concatFiles concatenates the files matching the glob pattern in lexical
+// order and writes the result to the output file. If checksumFile isn't
+// empty, the output file is only written when the SHA256 checksum of the
+// concatenated content is equal to the content of checksumFile. It returns
+// true if the content of the output file has changed.
+func concatFiles(pattern, checksumFile, output string) (bool, error) {
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return false, err
+	}
+
+	if len(files) == 0 {
+		return false, fmt.Errorf("no file matching %q", pattern)
+	}
+	slices.Sort(files)
+
+	var b bytes.Buffer
+	for _, f := range files {
+		content, err := os.ReadFile(f)
+		if err != nil {
+			return false, err
+		}
+		b.Write(content)
+	}
+
+	if checksumFile != "" {
+		expected, err := os.ReadFile(checksumFile)
+		if err != nil {
+			return false, err
+		}
+
+		checksum := sha256.Sum256(b.Bytes())
+		if got := hex.EncodeToString(checksum[:]); got != strings.TrimSpace(string(expected)) {
+			return false, fmt.Errorf("%w: got %s, expected %s", errChecksumMismatch, got, strings.TrimSpace(string(expected)))
+		}
+	}
+
+	current, err := os.ReadFile(output)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	if err == nil && bytes.Equal(current, b.Bytes()) {
+		return false, nil
+	}
+
+	// Write to a temporary file and rename it to avoid partial reads.
+	tmp := output + ".tmp"
+	if err := os.WriteFile(tmp, b.Bytes(), 0o644); err != nil {
+		return false, err
+	}
+
+	if err := os.Rename(tmp, output); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
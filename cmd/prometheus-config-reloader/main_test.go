@@ -15,14 +15,18 @@
 package main
 
 import (
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/go-test/deep"
+	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
@@ -81,3 +85,99 @@ func TestCreateHTTPClient(t *testing.T) {
 		}
 	})
 }
+
+func TestConcatFiles(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "config.yaml")
+	pattern := filepath.Join(dir, "config.yaml.part-*")
+
+	_, err := concatFiles(pattern, "", output)
+	require.Error(t, err)
+
+	for f, content := range map[string]string{
+		"config.yaml.part-001": "b",
+		"config.yaml.part-000": "a",
+		"config.yaml.part-002": "c",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, f), []byte(content), 0o600))
+	}
+
+	changed, err := concatFiles(pattern, "", output)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	b, err := os.ReadFile(output)
+	require.NoError(t, err)
+	require.Equal(t, "abc", string(b))
+
+	// The output file is left untouched when the parts haven't changed.
+	changed, err = concatFiles(pattern, "", output)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "config.yaml.part-002")))
+	changed, err = concatFiles(pattern, "", output)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	b, err = os.ReadFile(output)
+	require.NoError(t, err)
+	require.Equal(t, "ab", string(b))
+}
+
+func TestConcatFilesWithChecksum(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "config.yaml")
+	pattern := filepath.Join(dir, "config.yaml.part-*")
+	checksumFile := filepath.Join(dir, "config.sha256")
+
+	writeFiles := func(files map[string]string) {
+		t.Helper()
+		for f, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, f), []byte(content), 0o600))
+		}
+	}
+	checksum := func(s string) string {
+		sum := sha256.Sum256([]byte(s))
+		return hex.EncodeToString(sum[:])
+	}
+
+	writeFiles(map[string]string{
+		"config.yaml.part-000": "a",
+		"config.yaml.part-001": "b",
+		"config.sha256":        checksum("ab") + "\n",
+	})
+
+	changed, err := concatFiles(pattern, checksumFile, output)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	// Only one part has been updated: the output file isn't modified.
+	writeFiles(map[string]string{
+		"config.yaml.part-000": "c",
+		"config.sha256":        checksum("cd"),
+	})
+
+	_, err = concatFiles(pattern, checksumFile, output)
+	require.ErrorIs(t, err, errChecksumMismatch)
+
+	b, err := os.ReadFile(output)
+	require.NoError(t, err)
+	require.Equal(t, "ab", string(b))
+
+	writeFiles(map[string]string{
+		"config.yaml.part-001": "d",
+	})
+
+	changed, err = concatFiles(pattern, checksumFile, output)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	b, err = os.ReadFile(output)
+	require.NoError(t, err)
+	require.Equal(t, "cd", string(b))
+
+	// The checksum file is required.
+	_, err = concatFiles(pattern, filepath.Join(dir, "missing"), output)
+	require.Error(t, err)
+}
@@ -10,6 +10,9 @@ local defaults = {
     requests: { cpu: '', memory: '' },
   },
   enableReloaderProbes: false,
+  // Authenticate and authorize the requests to the metrics and debug
+  // endpoints with the TokenReview and SubjectAccessReview APIs.
+  enableDelegatedAuth: false,
   repairPolicy: '',  // can be 'none' (default), 'delete' or 'evict'
   goGC: '30',
   port: 8080,
@@ -51,6 +54,7 @@ function(params) {
   '0prometheusruleCustomResourceDefinition': import 'prometheusrules-crd.json',
   '0thanosrulerCustomResourceDefinition': import 'thanosrulers-crd.json',
   '0scrapeconfigCustomResourceDefinition': import 'scrapeconfigs-crd.json',
+  '0receivertestCustomResourceDefinition': import 'receivertests-crd.json',
 
   clusterRoleBinding: {
     apiVersion: 'rbac.authorization.k8s.io/v1',
@@ -86,8 +90,11 @@ function(params) {
                  'alertmanagers/finalizers',
                  'alertmanagers/status',
                  'alertmanagerconfigs',
+                 'receivertests',
+                 'receivertests/status',
                  'prometheuses',
                  'prometheuses/finalizers',
+                 'prometheuses/scale',
                  'prometheuses/status',
                  'prometheusagents',
                  'prometheusagents/finalizers',
@@ -129,7 +136,7 @@ function(params) {
                  'services',
                  'services/finalizers',
                ],
-               verbs: ['get', 'create', 'update', 'delete'],
+               verbs: ['get', 'list', 'watch', 'create', 'update', 'delete'],
              },
              {
                apiGroups: [''],
@@ -156,6 +163,11 @@ function(params) {
                resources: ['storageclasses'],
                verbs: ['get'],
              },
+             {
+               apiGroups: ['gateway.networking.k8s.io'],
+               resources: ['httproutes'],
+               verbs: ['get', 'list', 'watch'],
+             },
            ] + (
              if po.config.kubeletEndpointsEnabled then
                [
@@ -184,6 +196,23 @@ function(params) {
              else
                []
            )
+           + (
+             if po.config.enableDelegatedAuth then
+               [
+                 {
+                   apiGroups: ['authentication.k8s.io'],
+                   resources: ['tokenreviews'],
+                   verbs: ['create'],
+                 },
+                 {
+                   apiGroups: ['authorization.k8s.io'],
+                   resources: ['subjectaccessreviews'],
+                   verbs: ['create'],
+                 },
+               ]
+             else
+               []
+           )
            + (
              if po.config.repairPolicy == 'evict' then
                [
@@ -205,6 +234,8 @@ function(params) {
       if value != '' then [arg + '=' + value] else [];
     local enableReloaderProbesArg(value) =
       if value == true then ['--enable-config-reloader-probes=true'] else [];
+    local enableDelegatedAuthArg(value) =
+      if value == true then ['--web.enable-delegated-auth=true'] else [];
 
     local container = {
       name: po.config.name,
@@ -222,6 +253,7 @@ function(params) {
             optionalArg('--config-reloader-cpu-request', po.config.configReloaderResources.requests.cpu) +
             optionalArg('--config-reloader-memory-request', po.config.configReloaderResources.requests.memory) +
             enableReloaderProbesArg(po.config.enableReloaderProbes) +
+            enableDelegatedAuthArg(po.config.enableDelegatedAuth) +
             optionalArg('--repair-policy-for-statefulsets', po.config.repairPolicy),
       ports: [{
         containerPort: po.config.port,
@@ -17,6 +17,7 @@ package alertmanager
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -24,6 +25,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/prometheus/alertmanager/config"
@@ -32,6 +34,8 @@ import (
 	"github.com/prometheus/common/model"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
@@ -155,48 +159,84 @@ var _ enforcer = &namespaceEnforcer{}
 func (ne *namespaceEnforcer) processInhibitRule(crKey types.NamespacedName, ir *inhibitRule) *inhibitRule {
 	// Inhibition rule created from AlertmanagerConfig resources should only match
 	// alerts that come from the same namespace.
-	delete(ir.SourceMatchRE, inhibitRuleNamespaceKey)
-	delete(ir.TargetMatchRE, inhibitRuleNamespaceKey)
+	return enforceInhibitRuleMatcher(ir, inhibitRuleNamespaceKey, crKey.Namespace, ne.matchersV2Allowed)
+}
+
+// processRoute on namespaceEnforcer modifies the route configuration to match alerts
+// originating only from the given namespace.
+func (ne *namespaceEnforcer) processRoute(crKey types.NamespacedName, r *route) *route {
+	// Routes created from AlertmanagerConfig resources should only match
+	// alerts that come from the same namespace.
+	return enforceRouteMatcher(r, "namespace", crKey.Namespace, ne.matchersV2Allowed)
+}
+
+// labelEnforcer enforces a label matcher whose value depends on the
+// AlertmanagerConfig object.
+type labelEnforcer struct {
+	label             string
+	matchersV2Allowed bool
+
+	// values maps the AlertmanagerConfig objects to the value of the label.
+	values map[types.NamespacedName]string
+}
+
+var _ enforcer = &labelEnforcer{}
+
+// processInhibitRule for labelEnforcer modifies the inhibition rule to match
+// alerts having the label value of the given AlertmanagerConfig object.
+func (le *labelEnforcer) processInhibitRule(crKey types.NamespacedName, ir *inhibitRule) *inhibitRule {
+	return enforceInhibitRuleMatcher(ir, le.label, le.values[crKey], le.matchersV2Allowed)
+}
 
-	if !ne.matchersV2Allowed {
-		ir.SourceMatch[inhibitRuleNamespaceKey] = crKey.Namespace
-		ir.TargetMatch[inhibitRuleNamespaceKey] = crKey.Namespace
+// processRoute for labelEnforcer modifies the route configuration to match
+// alerts having the label value of the given AlertmanagerConfig object.
+func (le *labelEnforcer) processRoute(crKey types.NamespacedName, r *route) *route {
+	return enforceRouteMatcher(r, le.label, le.values[crKey], le.matchersV2Allowed)
+}
+
+// enforceInhibitRuleMatcher modifies the inhibition rule so that the source
+// and target alerts have the given label value.
+func enforceInhibitRuleMatcher(ir *inhibitRule, name, value string, matchersV2Allowed bool) *inhibitRule {
+	delete(ir.SourceMatchRE, name)
+	delete(ir.TargetMatchRE, name)
+
+	if !matchersV2Allowed {
+		ir.SourceMatch[name] = value
+		ir.TargetMatch[name] = value
 
 		return ir
 	}
 
-	v2NamespaceMatcher := monitoringv1alpha1.Matcher{
-		Name:      inhibitRuleNamespaceKey,
-		Value:     crKey.Namespace,
+	v2Matcher := monitoringv1alpha1.Matcher{
+		Name:      name,
+		Value:     value,
 		MatchType: monitoringv1alpha1.MatchEqual,
 	}.String()
 
-	if !contains(v2NamespaceMatcher, ir.SourceMatchers) {
-		ir.SourceMatchers = append(ir.SourceMatchers, v2NamespaceMatcher)
+	if !contains(v2Matcher, ir.SourceMatchers) {
+		ir.SourceMatchers = append(ir.SourceMatchers, v2Matcher)
 	}
-	if !contains(v2NamespaceMatcher, ir.TargetMatchers) {
-		ir.TargetMatchers = append(ir.TargetMatchers, v2NamespaceMatcher)
+	if !contains(v2Matcher, ir.TargetMatchers) {
+		ir.TargetMatchers = append(ir.TargetMatchers, v2Matcher)
 	}
 
-	delete(ir.SourceMatch, inhibitRuleNamespaceKey)
-	delete(ir.TargetMatch, inhibitRuleNamespaceKey)
+	delete(ir.SourceMatch, name)
+	delete(ir.TargetMatch, name)
 
 	return ir
 }
 
-// processRoute on namespaceEnforcer modifies the route configuration to match alerts
-// originating only from the given namespace.
-func (ne *namespaceEnforcer) processRoute(crKey types.NamespacedName, r *route) *route {
-	// Routes created from AlertmanagerConfig resources should only match
-	// alerts that come from the same namespace.
-	if ne.matchersV2Allowed {
+// enforceRouteMatcher modifies the route so that it only matches alerts
+// having the given label value.
+func enforceRouteMatcher(r *route, name, value string, matchersV2Allowed bool) *route {
+	if matchersV2Allowed {
 		r.Matchers = append(r.Matchers, monitoringv1alpha1.Matcher{
-			Name:      "namespace",
-			Value:     crKey.Namespace,
+			Name:      name,
+			Value:     value,
 			MatchType: monitoringv1alpha1.MatchEqual,
 		}.String())
 	} else {
-		r.Match["namespace"] = crKey.Namespace
+		r.Match[name] = value
 	}
 
 	return r
@@ -233,6 +273,43 @@ type ConfigBuilder struct {
 	amVersion semver.Version
 	store     *assets.StoreBuilder
 	enforcer  enforcer
+
+	// shared holds the time intervals and receivers of the base
+	// configuration which AlertmanagerConfig routes can reference without
+	// namespace prefix.
+	shared sharedConfig
+
+	// matcherLabel is the name of the label enforced by the OnLabel matcher
+	// strategy.
+	matcherLabel string
+	// matcherLabelValues maps the AlertmanagerConfig objects to the value of
+	// the matcher label.
+	matcherLabelValues map[types.NamespacedName]string
+	// namespaceLabels returns the labels of the given namespace. The
+	// OnLabel matcher strategy falls back to the namespace labels when the
+	// AlertmanagerConfig object doesn't have the matcher label.
+	namespaceLabels func(string) map[string]string
+}
+
+// sharedConfig holds the parts of the base configuration which can be
+// referenced by the AlertmanagerConfig objects.
+type sharedConfig struct {
+	// timeIntervals maps the names of the time intervals defined by the base
+	// configuration to their names in the generated configuration.
+	timeIntervals map[string]string
+	// receivers maps the names of the receivers shared by the global
+	// AlertmanagerConfig object to their definition.
+	receivers map[string]sharedReceiver
+}
+
+// sharedReceiver is a receiver of the global AlertmanagerConfig object which
+// can be referenced by the other AlertmanagerConfig objects.
+type sharedReceiver struct {
+	// name is the name of the receiver in the generated configuration.
+	name string
+	// namespaceSelector selects the namespaces of the AlertmanagerConfig
+	// objects allowed to reference the receiver.
+	namespaceSelector labels.Selector
 }
 
 func NewConfigBuilder(logger *slog.Logger, amVersion semver.Version, store *assets.StoreBuilder, am *monitoringv1.Alertmanager) *ConfigBuilder {
@@ -240,12 +317,23 @@ func NewConfigBuilder(logger *slog.Logger, amVersion semver.Version, store *asse
 		logger:    logger,
 		amVersion: amVersion,
 		store:     store,
-		enforcer:  getEnforcer(am.Spec.AlertmanagerConfigMatcherStrategy, amVersion, am.Namespace),
+
+		shared: sharedConfig{
+			timeIntervals: map[string]string{},
+			receivers:     map[string]sharedReceiver{},
+		},
+		matcherLabelValues: map[types.NamespacedName]string{},
+	}
+
+	if am.Spec.AlertmanagerConfigMatcherStrategy.Type == monitoringv1.OnLabelConfigMatcherStrategyType {
+		cg.matcherLabel = ptr.Deref(am.Spec.AlertmanagerConfigMatcherStrategy.Label, "")
 	}
+	cg.enforcer = getEnforcer(am.Spec.AlertmanagerConfigMatcherStrategy, amVersion, am.Namespace, cg.matcherLabelValues)
+
 	return cg
 }
 
-func getEnforcer(matcherStrategy monitoringv1.AlertmanagerConfigMatcherStrategy, amVersion semver.Version, amNamespace string) enforcer {
+func getEnforcer(matcherStrategy monitoringv1.AlertmanagerConfigMatcherStrategy, amVersion semver.Version, amNamespace string, labelValues map[types.NamespacedName]string) enforcer {
 	var e enforcer
 	switch matcherStrategy.Type {
 	case monitoringv1.NoneConfigMatcherStrategyType:
@@ -257,6 +345,12 @@ func getEnforcer(matcherStrategy monitoringv1.AlertmanagerConfigMatcherStrategy,
 				matchersV2Allowed: amVersion.GTE(semver.MustParse("0.22.0")),
 			},
 		}
+	case monitoringv1.OnLabelConfigMatcherStrategyType:
+		e = &labelEnforcer{
+			label:             ptr.Deref(matcherStrategy.Label, ""),
+			matchersV2Allowed: amVersion.GTE(semver.MustParse("0.22.0")),
+			values:            labelValues,
+		}
 	default:
 		e = &namespaceEnforcer{
 			matchersV2Allowed: amVersion.GTE(semver.MustParse("0.22.0")),
@@ -305,8 +399,14 @@ func (cb *ConfigBuilder) initializeFromAlertmanagerConfig(ctx context.Context, g
 		globalAlertmanagerConfig.InhibitRules = append(globalAlertmanagerConfig.InhibitRules, cb.convertInhibitRule(&inhibitRule))
 	}
 
+	// The routes of the global AlertmanagerConfig can only reference its own
+	// receivers.
+	if len(amConfig.Spec.SharedReceivers) > 0 {
+		return errors.New("the global AlertmanagerConfig can't reference shared receivers")
+	}
+
 	// Add routes to globalAlertmanagerConfig.Route without enforce namespace
-	globalAlertmanagerConfig.Route = cb.convertRoute(amConfig.Spec.Route, crKey)
+	globalAlertmanagerConfig.Route = cb.convertRoute(amConfig.Spec.Route, crKey, nil, sharedTimeIntervalNames(amConfig))
 
 	for _, receiver := range amConfig.Spec.Receivers {
 		receivers, err := cb.convertReceiver(ctx, &receiver, crKey)
@@ -332,10 +432,128 @@ func (cb *ConfigBuilder) initializeFromAlertmanagerConfig(ctx context.Context, g
 		return err
 	}
 
+	// The time intervals of the global AlertmanagerConfig are shared with
+	// the other AlertmanagerConfig objects.
+	for _, muteTimeInterval := range amConfig.Spec.MuteTimeIntervals {
+		cb.shared.timeIntervals[muteTimeInterval.Name] = makeNamespacedString(muteTimeInterval.Name, crKey)
+	}
+
 	cb.cfg = globalAlertmanagerConfig
 	return nil
 }
 
+// initializeSharedReceivers makes the given receivers of the global
+// AlertmanagerConfig object available to the other AlertmanagerConfig
+// objects.
+func (cb *ConfigBuilder) initializeSharedReceivers(sharedReceivers []monitoringv1.SharedReceiver, amConfig *monitoringv1alpha1.AlertmanagerConfig) error {
+	crKey := types.NamespacedName{
+		Namespace: amConfig.Namespace,
+		Name:      amConfig.Name,
+	}
+	receivers := receiverNames(amConfig)
+
+	for i, sr := range sharedReceivers {
+		if _, found := receivers[sr.Name]; !found {
+			return fmt.Errorf("sharedReceivers[%d]: receiver %q not found in AlertmanagerConfig %s", i, sr.Name, crKey.String())
+		}
+
+		selector := labels.Everything()
+		if sr.NamespaceSelector != nil {
+			var err error
+			selector, err = metav1.LabelSelectorAsSelector(sr.NamespaceSelector)
+			if err != nil {
+				return fmt.Errorf("sharedReceivers[%d]: invalid namespaceSelector: %w", i, err)
+			}
+		}
+
+		cb.shared.receivers[sr.Name] = sharedReceiver{
+			name:              makeNamespacedString(sr.Name, crKey),
+			namespaceSelector: selector,
+		}
+	}
+
+	return nil
+}
+
+// initializeTracing adds the tracing configuration defined by the Alertmanager
+// object to the configuration.
+func (cb *ConfigBuilder) initializeTracing(ctx context.Context, in *monitoringv1.TracingConfig, namespace string) error {
+	if in == nil {
+		return nil
+	}
+
+	if err := in.Validate(); err != nil {
+		return fmt.Errorf("tracing: %w", err)
+	}
+
+	if err := cb.store.AddTLSConfig(ctx, namespace, in.TLSConfig); err != nil {
+		return fmt.Errorf("tracing: %w", err)
+	}
+
+	tracing, err := cb.convertTracingConfig(in, namespace)
+	if err != nil {
+		return fmt.Errorf("tracing: %w", err)
+	}
+	cb.cfg.Tracing = tracing
+
+	return nil
+}
+
+func (cb *ConfigBuilder) convertTracingConfig(in *monitoringv1.TracingConfig, namespace string) (*tracingConfig, error) {
+	out := &tracingConfig{
+		Endpoint: in.Endpoint,
+	}
+
+	if in.ClientType != nil {
+		out.ClientType = strings.ToLower(*in.ClientType)
+	}
+
+	if in.SamplingFraction != nil {
+		out.SamplingFraction = ptr.To(in.SamplingFraction.AsApproximateFloat64())
+	}
+
+	if in.Insecure != nil {
+		out.Insecure = *in.Insecure
+	}
+
+	if in.Compression != nil {
+		out.Compression = strings.ToLower(*in.Compression)
+	}
+
+	if in.Timeout != nil {
+		timeout, err := model.ParseDuration(string(*in.Timeout))
+		if err != nil {
+			return nil, err
+		}
+		out.Timeout = &timeout
+	}
+
+	if len(in.Headers) > 0 {
+		out.Headers = make(map[string]tracingHeader, len(in.Headers))
+		for k, v := range in.Headers {
+			out.Headers[k] = tracingHeader{Values: []string{v}}
+		}
+	}
+
+	if in.TLSConfig != nil {
+		out.TLSConfig = cb.convertTLSConfig(&in.TLSConfig.SafeTLSConfig, types.NamespacedName{Namespace: namespace})
+
+		if in.TLSConfig.CAFile != "" {
+			out.TLSConfig.CAFile = in.TLSConfig.CAFile
+		}
+
+		if in.TLSConfig.CertFile != "" {
+			out.TLSConfig.CertFile = in.TLSConfig.CertFile
+		}
+
+		if in.TLSConfig.KeyFile != "" {
+			out.TLSConfig.KeyFile = in.TLSConfig.KeyFile
+		}
+	}
+
+	return out, nil
+}
+
 // InitializeFromRawConfiguration initializes the configuration from raw data.
 func (cb *ConfigBuilder) InitializeFromRawConfiguration(b []byte) error {
 	globalAlertmanagerConfig, err := alertmanagerConfigFromBytes(b)
@@ -343,6 +561,15 @@ func (cb *ConfigBuilder) InitializeFromRawConfiguration(b []byte) error {
 		return err
 	}
 
+	// The time intervals of the raw configuration are shared with the
+	// AlertmanagerConfig objects.
+	for _, ti := range globalAlertmanagerConfig.MuteTimeIntervals {
+		cb.shared.timeIntervals[ti.Name] = ti.Name
+	}
+	for _, ti := range globalAlertmanagerConfig.TimeIntervals {
+		cb.shared.timeIntervals[ti.Name] = ti.Name
+	}
+
 	cb.cfg = globalAlertmanagerConfig
 	return nil
 }
@@ -356,6 +583,19 @@ func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs m
 			Namespace: amConfigs[amConfigIdentifier].Namespace,
 		}
 
+		if cb.matcherLabel != "" {
+			var namespaceLabels map[string]string
+			if cb.namespaceLabels != nil {
+				namespaceLabels = cb.namespaceLabels(crKey.Namespace)
+			}
+
+			value := matcherLabelValue(cb.matcherLabel, amConfigs[amConfigIdentifier], namespaceLabels)
+			if value == "" {
+				return fmt.Errorf("AlertmanagerConfig %s: label %q not found on the object or its namespace", crKey.String(), cb.matcherLabel)
+			}
+			cb.matcherLabelValues[crKey] = value
+		}
+
 		// Add inhibitRules to baseConfig.InhibitRules.
 		for _, inhibitRule := range amConfigs[amConfigIdentifier].Spec.InhibitRules {
 			cb.cfg.InhibitRules = append(cb.cfg.InhibitRules,
@@ -379,6 +619,8 @@ func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs m
 				cb.convertRoute(
 					amConfigs[amConfigIdentifier].Spec.Route,
 					crKey,
+					sharedReceiverNames(amConfigs[amConfigIdentifier]),
+					sharedTimeIntervalNames(amConfigs[amConfigIdentifier]),
 				),
 			),
 		)
@@ -546,7 +788,11 @@ func (cb *ConfigBuilder) convertGlobalConfig(ctx context.Context, in *monitoring
 	return out, nil
 }
 
-func (cb *ConfigBuilder) convertRoute(in *monitoringv1alpha1.Route, crKey types.NamespacedName) *route {
+// convertRoute converts a monitoringv1alpha1.Route to an alertmanager.route.
+// The sharedReceivers and sharedTimeIntervals arguments hold the names of the
+// shared receivers and time intervals declared by the AlertmanagerConfig
+// object.
+func (cb *ConfigBuilder) convertRoute(in *monitoringv1alpha1.Route, crKey types.NamespacedName, sharedReceivers, sharedTimeIntervals map[string]struct{}) *route {
 	if in == nil {
 		return nil
 	}
@@ -564,23 +810,23 @@ func (cb *ConfigBuilder) convertRoute(in *monitoringv1alpha1.Route, crKey types.
 			panic(err)
 		}
 		for i := range children {
-			routes[i] = cb.convertRoute(&children[i], crKey)
+			routes[i] = cb.convertRoute(&children[i], crKey, sharedReceivers, sharedTimeIntervals)
 		}
 	}
 
-	receiver := makeNamespacedString(in.Receiver, crKey)
+	receiver := cb.convertReceiverName(in.Receiver, crKey, sharedReceivers)
 
 	var prefixedMuteTimeIntervals []string
 	if len(in.MuteTimeIntervals) > 0 {
 		for _, mti := range in.MuteTimeIntervals {
-			prefixedMuteTimeIntervals = append(prefixedMuteTimeIntervals, makeNamespacedString(mti, crKey))
+			prefixedMuteTimeIntervals = append(prefixedMuteTimeIntervals, cb.convertTimeIntervalName(mti, crKey, sharedTimeIntervals))
 		}
 	}
 
 	var prefixedActiveTimeIntervals []string
 	if len(in.ActiveTimeIntervals) > 0 {
 		for _, ati := range in.ActiveTimeIntervals {
-			prefixedActiveTimeIntervals = append(prefixedActiveTimeIntervals, makeNamespacedString(ati, crKey))
+			prefixedActiveTimeIntervals = append(prefixedActiveTimeIntervals, cb.convertTimeIntervalName(ati, crKey, sharedTimeIntervals))
 		}
 	}
 
@@ -600,6 +846,78 @@ func (cb *ConfigBuilder) convertRoute(in *monitoringv1alpha1.Route, crKey types.
 	}
 }
 
+// convertTimeIntervalName returns the name of the time interval referenced by
+// a route in the generated configuration. The sharedTimeIntervals argument
+// holds the names of the shared time intervals declared by the
+// AlertmanagerConfig object.
+func (cb *ConfigBuilder) convertTimeIntervalName(name string, crKey types.NamespacedName, sharedTimeIntervals map[string]struct{}) string {
+	if _, found := sharedTimeIntervals[name]; found {
+		if shared, found := cb.shared.timeIntervals[name]; found {
+			return shared
+		}
+	}
+
+	return makeNamespacedString(name, crKey)
+}
+
+// convertReceiverName returns the name of the receiver referenced by a route
+// in the generated configuration. The sharedReceivers argument holds the names
+// of the shared receivers declared by the AlertmanagerConfig object.
+func (cb *ConfigBuilder) convertReceiverName(name string, crKey types.NamespacedName, sharedReceivers map[string]struct{}) string {
+	if _, found := sharedReceivers[name]; found {
+		if shared, found := cb.shared.receivers[name]; found {
+			return shared.name
+		}
+	}
+
+	return makeNamespacedString(name, crKey)
+}
+
+// matcherLabelValue returns the value of the given label for the
+// AlertmanagerConfig object. The labels of the namespace always take
+// precedence: the label of the object is ignored if the namespace has the
+// label, otherwise the object could match the alerts of another team.
+func matcherLabelValue(label string, amc *monitoringv1alpha1.AlertmanagerConfig, namespaceLabels map[string]string) string {
+	if v, found := namespaceLabels[label]; found {
+		return v
+	}
+
+	return amc.Labels[label]
+}
+
+// sharedTimeIntervalNames returns the names of the shared time intervals
+// declared by the AlertmanagerConfig object.
+func sharedTimeIntervalNames(amc *monitoringv1alpha1.AlertmanagerConfig) map[string]struct{} {
+	names := make(map[string]struct{}, len(amc.Spec.SharedTimeIntervals))
+	for _, name := range amc.Spec.SharedTimeIntervals {
+		names[name] = struct{}{}
+	}
+
+	return names
+}
+
+// sharedReceiverNames returns the names of the shared receivers declared by
+// the AlertmanagerConfig object.
+func sharedReceiverNames(amc *monitoringv1alpha1.AlertmanagerConfig) map[string]struct{} {
+	names := make(map[string]struct{}, len(amc.Spec.SharedReceivers))
+	for _, name := range amc.Spec.SharedReceivers {
+		names[name] = struct{}{}
+	}
+
+	return names
+}
+
+// receiverNames returns the names of the receivers defined by the
+// AlertmanagerConfig object.
+func receiverNames(amc *monitoringv1alpha1.AlertmanagerConfig) map[string]struct{} {
+	names := make(map[string]struct{}, len(amc.Spec.Receivers))
+	for _, r := range amc.Spec.Receivers {
+		names[r.Name] = struct{}{}
+	}
+
+	return names
+}
+
 // convertReceiver converts a monitoringv1alpha1.Receiver to an alertmanager.receiver.
 func (cb *ConfigBuilder) convertReceiver(ctx context.Context, in *monitoringv1alpha1.Receiver, crKey types.NamespacedName) (*receiver, error) {
 	var pagerdutyConfigs []*pagerdutyConfig
@@ -782,6 +1100,42 @@ func (cb *ConfigBuilder) convertReceiver(ctx context.Context, in *monitoringv1al
 		}
 	}
 
+	var jiraConfigs []*jiraConfig
+	if l := len(in.JiraConfigs); l > 0 {
+		jiraConfigs = make([]*jiraConfig, l)
+		for i := range in.JiraConfigs {
+			receiver, err := cb.convertJiraConfig(ctx, in.JiraConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("JiraConfig[%d]: %w", i, err)
+			}
+			jiraConfigs[i] = receiver
+		}
+	}
+
+	var mattermostConfigs []*mattermostConfig
+	if l := len(in.MattermostConfigs); l > 0 {
+		mattermostConfigs = make([]*mattermostConfig, l)
+		for i := range in.MattermostConfigs {
+			receiver, err := cb.convertMattermostConfig(ctx, in.MattermostConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("MattermostConfig[%d]: %w", i, err)
+			}
+			mattermostConfigs[i] = receiver
+		}
+	}
+
+	var incidentioConfigs []*incidentioConfig
+	if l := len(in.IncidentioConfigs); l > 0 {
+		incidentioConfigs = make([]*incidentioConfig, l)
+		for i := range in.IncidentioConfigs {
+			receiver, err := cb.convertIncidentioConfig(ctx, in.IncidentioConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("IncidentioConfig[%d]: %w", i, err)
+			}
+			incidentioConfigs[i] = receiver
+		}
+	}
+
 	return &receiver{
 		Name:              makeNamespacedString(in.Name, crKey),
 		OpsgenieConfigs:   opsgenieConfigs,
@@ -799,6 +1153,9 @@ func (cb *ConfigBuilder) convertReceiver(ctx context.Context, in *monitoringv1al
 		MSTeamsConfigs:    msTeamsConfigs,
 		MSTeamsV2Configs:  msTeamsV2Configs,
 		RocketChatConfigs: rocketchatConfigs,
+		JiraConfigs:       jiraConfigs,
+		MattermostConfigs: mattermostConfigs,
+		IncidentioConfigs: incidentioConfigs,
 	}, nil
 }
 
@@ -1642,6 +1999,167 @@ func (cb *ConfigBuilder) convertMSTeamsV2Config(
 	return out, nil
 }
 
+func (cb *ConfigBuilder) convertJiraConfig(
+	ctx context.Context, in monitoringv1alpha1.JiraConfig, crKey types.NamespacedName,
+) (*jiraConfig, error) {
+	out := &jiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            string(ptr.Deref(in.APIURL, "")),
+		APIType:           ptr.Deref(in.APIType, ""),
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           ptr.Deref(in.Summary, ""),
+		Description:       ptr.Deref(in.Description, ""),
+		Labels:            in.Labels,
+		Priority:          ptr.Deref(in.Priority, ""),
+		ReopenTransition:  ptr.Deref(in.ReopenTransition, ""),
+		ResolveTransition: ptr.Deref(in.ResolveTransition, ""),
+		WontFixResolution: ptr.Deref(in.WontFixResolution, ""),
+	}
+
+	if in.ReopenDuration != nil {
+		d, err := model.ParseDuration(string(*in.ReopenDuration))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse reopen duration: %w", err)
+		}
+		out.ReopenDuration = d
+	}
+
+	if len(in.Fields) > 0 {
+		out.Fields = make(map[string]any, len(in.Fields))
+		for _, f := range in.Fields {
+			var v any
+			if err := json.Unmarshal(f.Value.Raw, &v); err != nil {
+				return nil, fmt.Errorf("failed to decode the value of field %q: %w", f.Key, err)
+			}
+			out.Fields[f.Key] = v
+		}
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
+func (cb *ConfigBuilder) convertMattermostConfig(
+	ctx context.Context, in monitoringv1alpha1.MattermostConfig, crKey types.NamespacedName,
+) (*mattermostConfig, error) {
+	out := &mattermostConfig{
+		SendResolved: in.SendResolved,
+		Channel:      ptr.Deref(in.Channel, ""),
+		Username:     ptr.Deref(in.Username, ""),
+		Text:         ptr.Deref(in.Text, ""),
+		IconURL:      string(ptr.Deref(in.IconURL, "")),
+		IconEmoji:    ptr.Deref(in.IconEmoji, ""),
+	}
+
+	if in.WebhookURL != nil {
+		webhookURL, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.WebhookURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Mattermost webhook URL: %w", err)
+		}
+		out.WebhookURL = webhookURL
+	}
+
+	for _, a := range in.Attachments {
+		attachment := &mattermostAttachmentConfig{
+			Fallback:   ptr.Deref(a.Fallback, ""),
+			Color:      ptr.Deref(a.Color, ""),
+			Pretext:    ptr.Deref(a.Pretext, ""),
+			Text:       ptr.Deref(a.Text, ""),
+			AuthorName: ptr.Deref(a.AuthorName, ""),
+			AuthorLink: string(ptr.Deref(a.AuthorLink, "")),
+			AuthorIcon: string(ptr.Deref(a.AuthorIcon, "")),
+			Title:      ptr.Deref(a.Title, ""),
+			TitleLink:  string(ptr.Deref(a.TitleLink, "")),
+			ThumbURL:   string(ptr.Deref(a.ThumbURL, "")),
+			Footer:     ptr.Deref(a.Footer, ""),
+			FooterIcon: string(ptr.Deref(a.FooterIcon, "")),
+			ImageURL:   string(ptr.Deref(a.ImageURL, "")),
+		}
+
+		for _, f := range a.Fields {
+			attachment.Fields = append(attachment.Fields, mattermostField{
+				Title: f.Title,
+				Value: f.Value,
+				Short: ptr.Deref(f.Short, false),
+			})
+		}
+
+		out.Attachments = append(out.Attachments, attachment)
+	}
+
+	if in.Props != nil {
+		out.Props = &mattermostPropsConfig{
+			Card: in.Props.Card,
+		}
+	}
+
+	if in.Priority != nil {
+		out.Priority = &mattermostPriorityConfig{
+			Priority:                in.Priority.Priority,
+			RequestedAck:            in.Priority.RequestedAck,
+			PersistentNotifications: in.Priority.PersistentNotifications,
+		}
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
+func (cb *ConfigBuilder) convertIncidentioConfig(
+	ctx context.Context, in monitoringv1alpha1.IncidentioConfig, crKey types.NamespacedName,
+) (*incidentioConfig, error) {
+	out := &incidentioConfig{
+		VSendResolved: in.SendResolved,
+		MaxAlerts:     in.MaxAlerts,
+	}
+
+	// No need to validate again the URL because it's been done when selecting the AlertmanagerConfig resource.
+	if in.URLSecret != nil {
+		url, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.URLSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get incident.io URL: %w", err)
+		}
+		out.URL = url
+	} else if in.URL != nil {
+		out.URL = string(*in.URL)
+	}
+
+	if in.AlertSourceToken != nil {
+		token, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.AlertSourceToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get incident.io alert source token: %w", err)
+		}
+		out.AlertSourceToken = token
+	}
+
+	if in.Timeout != nil {
+		timeout, err := model.ParseDuration(string(*in.Timeout))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse timeout: %w", err)
+		}
+		out.Timeout = &timeout
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
 func (cb *ConfigBuilder) convertInhibitRule(in *monitoringv1alpha1.InhibitRule) *inhibitRule {
 	sourceMatchers, sourceMatch, sourceMatchRE := cb.convertMatchersV2(in.SourceMatch)
 	targetMatchers, targetMatch, targetMatchRE := cb.convertMatchersV2(in.TargetMatch)
@@ -1754,6 +2272,14 @@ func convertMuteTimeInterval(in *monitoringv1alpha1.MuteTimeInterval, crKey type
 			})
 		}
 
+		if timeInterval.Location != nil {
+			loc, err := time.LoadLocation(*timeInterval.Location)
+			if err != nil {
+				return nil, fmt.Errorf("invalid location %q: %w", *timeInterval.Location, err)
+			}
+			ti.Location = &timeinterval.Location{Location: loc}
+		}
+
 		muteTimeInterval.Name = makeNamespacedString(in.Name, crKey)
 		muteTimeInterval.TimeIntervals = append(muteTimeInterval.TimeIntervals, ti)
 	}
@@ -2180,6 +2706,18 @@ func (c *alertmanagerConfig) sanitize(amVersion semver.Version, logger *slog.Log
 		}
 	}
 
+	if c.Tracing != nil && amVersion.LT(semver.MustParse("0.30.0")) {
+		msg := "'tracing' supported in Alertmanager >= 0.30.0 only - dropping field from provided config"
+		logger.Warn(msg, "current_version", amVersion.String())
+		c.Tracing = nil
+	}
+
+	if c.Tracing != nil {
+		if err := c.Tracing.TLSConfig.sanitize(amVersion, logger); err != nil {
+			return fmt.Errorf("tracing: %w", err)
+		}
+	}
+
 	return c.Route.sanitize(amVersion, logger)
 }
 
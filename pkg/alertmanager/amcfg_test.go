@@ -33,6 +33,7 @@ import (
 	"gotest.tools/v3/golden"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
@@ -2650,6 +2651,92 @@ func TestGenerateConfig(t *testing.T) {
 			},
 			golden: "skeleton_base_simple_CR_with_namespaceMatcher_disabled.golden",
 		},
+		{
+			name:    "skeleton base, CRs with OnLabel matcher strategy",
+			kclient: fake.NewClientset(),
+			baseConfig: alertmanagerConfig{
+				Route:     &route{Receiver: "null"},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			matcherStrategy: monitoringv1.AlertmanagerConfigMatcherStrategy{
+				Type:  "OnLabel",
+				Label: ptr.To("team"),
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"ns1/frontend": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "frontend",
+						Namespace: "ns1",
+						Labels:    map[string]string{"team": "frontend"},
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+							GroupBy:  []string{"job"},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+						InhibitRules: []monitoringv1alpha1.InhibitRule{
+							{
+								SourceMatch: []monitoringv1alpha1.Matcher{
+									{
+										Name:  "alertname",
+										Value: "NodeNotReady",
+									},
+								},
+								TargetMatch: []monitoringv1alpha1.Matcher{
+									{
+										Name:  "alertname",
+										Value: "TargetDown",
+									},
+								},
+								Equal: []string{"node"},
+							},
+						},
+					},
+				},
+				"ns2/backend": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "backend",
+						Namespace: "ns2",
+						Labels:    map[string]string{"team": "backend"},
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+					},
+				},
+			},
+			golden: "skeleton_base_CRs_with_OnLabel_matcher_strategy.golden",
+		},
+		{
+			name:    "skeleton base, CR without label for OnLabel matcher strategy",
+			kclient: fake.NewClientset(),
+			baseConfig: alertmanagerConfig{
+				Route:     &route{Receiver: "null"},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			matcherStrategy: monitoringv1.AlertmanagerConfigMatcherStrategy{
+				Type:  "OnLabel",
+				Label: ptr.To("team"),
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+					},
+				},
+			},
+			expectedError: true,
+		},
 		{
 			name:    "skeleton base in same namespace as alertmanager, simple CR with namespaceMatcher disabled for alertmanager namespace",
 			kclient: fake.NewClientset(),
@@ -3724,6 +3811,95 @@ func TestGenerateConfig(t *testing.T) {
 			},
 			golden: "CR_with_Mute_Time_Intervals.golden",
 		},
+		{
+			name:    "CR with Time Interval Location",
+			kclient: fake.NewClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amVersion: &version26,
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver:            "test",
+							ActiveTimeIntervals: []string{"business-hours"},
+						},
+						MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+							{
+								Name: "business-hours",
+								TimeIntervals: []monitoringv1alpha1.TimeInterval{
+									{
+										Times: []monitoringv1alpha1.TimeRange{
+											{
+												StartTime: "09:00",
+												EndTime:   "17:00",
+											},
+										},
+										Weekdays: []monitoringv1alpha1.WeekdayRange{
+											monitoringv1alpha1.WeekdayRange("Monday:Friday"),
+										},
+										Location: ptr.To("Europe/Paris"),
+									},
+								},
+							},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+					},
+				},
+			},
+			golden: "CR_with_Time_Interval_Location.golden",
+		},
+		{
+			name:    "CR with Time Interval Location Older Version",
+			kclient: fake.NewClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amVersion: &version24,
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver:            "test",
+							ActiveTimeIntervals: []string{"business-hours"},
+						},
+						MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+							{
+								Name: "business-hours",
+								TimeIntervals: []monitoringv1alpha1.TimeInterval{
+									{
+										Times: []monitoringv1alpha1.TimeRange{
+											{
+												StartTime: "09:00",
+												EndTime:   "17:00",
+											},
+										},
+										Location: ptr.To("Europe/Paris"),
+									},
+								},
+							},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+					},
+				},
+			},
+			golden: "CR_with_Time_Interval_Location_Older_Version.golden",
+		},
 		{
 			name:    "CR with Active Time Intervals",
 			kclient: fake.NewClientset(),
@@ -4063,9 +4239,20 @@ func TestGenerateConfig(t *testing.T) {
 			golden: "CR_with_MSTeamsV2_Receiver_Partial_Conf.golden",
 		},
 		{
-			name:      "CR with EmailConfig with Required Fields specified at Receiver level",
-			amVersion: &version26,
-			kclient:   fake.NewClientset(),
+			name:      "CR with Jira Receiver",
+			amVersion: &version31,
+			kclient: fake.NewClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "jira",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"user":  []byte("alertmanager@example.com"),
+						"token": []byte("jira-api-token"),
+					},
+				},
+			),
 			baseConfig: alertmanagerConfig{
 				Route: &route{
 					Receiver: "null",
@@ -4085,11 +4272,47 @@ func TestGenerateConfig(t *testing.T) {
 						Receivers: []monitoringv1alpha1.Receiver{
 							{
 								Name: "test",
-								EmailConfigs: []monitoringv1alpha1.EmailConfig{
+								JiraConfigs: []monitoringv1alpha1.JiraConfig{
 									{
-										Smarthost: ptr.To("example.com:25"),
-										From:      ptr.To("admin@example.com"),
-										To:        ptr.To("customers@example.com"),
+										SendResolved:      ptr.To(true),
+										APIURL:            ptr.To(monitoringv1alpha1.URL("https://example.atlassian.net/rest/api/2")),
+										APIType:           ptr.To("cloud"),
+										Project:           "OPS",
+										IssueType:         "Bug",
+										Summary:           ptr.To(`{{ template "jira.default.summary" . }}`),
+										Description:       ptr.To(`{{ template "jira.default.description" . }}`),
+										Labels:            []string{"alertmanager", "{{ .CommonLabels.severity }}"},
+										Priority:          ptr.To("High"),
+										ReopenTransition:  ptr.To("To Do"),
+										ResolveTransition: ptr.To("Done"),
+										WontFixResolution: ptr.To("Won't Do"),
+										ReopenDuration:    ptr.To(monitoringv1.Duration("1h")),
+										Fields: []monitoringv1alpha1.JiraField{
+											{
+												Key:   "customfield_10001",
+												Value: apiextensionsv1.JSON{Raw: []byte(`"{{ .CommonLabels.team }}"`)},
+											},
+											{
+												Key:   "customfield_10002",
+												Value: apiextensionsv1.JSON{Raw: []byte(`{"value":"red"}`)},
+											},
+										},
+										HTTPConfig: &monitoringv1alpha1.HTTPConfig{
+											BasicAuth: &monitoringv1.BasicAuth{
+												Username: corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "jira",
+													},
+													Key: "user",
+												},
+												Password: corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "jira",
+													},
+													Key: "token",
+												},
+											},
+										},
 									},
 								},
 							},
@@ -4097,11 +4320,11 @@ func TestGenerateConfig(t *testing.T) {
 					},
 				},
 			},
-			golden: "CR_with_EmailConfig_Receiver_Conf.golden",
+			golden: "CR_with_Jira_Receiver.golden",
 		},
 		{
-			name:      "CR with EmailConfig Missing SmartHost Field",
-			amVersion: &version26,
+			name:      "CR with Jira Receiver and unsupported api type",
+			amVersion: &version28,
 			kclient:   fake.NewClientset(),
 			baseConfig: alertmanagerConfig{
 				Route: &route{
@@ -4122,10 +4345,12 @@ func TestGenerateConfig(t *testing.T) {
 						Receivers: []monitoringv1alpha1.Receiver{
 							{
 								Name: "test",
-								EmailConfigs: []monitoringv1alpha1.EmailConfig{
+								JiraConfigs: []monitoringv1alpha1.JiraConfig{
 									{
-										From: ptr.To("admin@example.com"),
-										To:   ptr.To("customers@example.com"),
+										APIURL:    ptr.To(monitoringv1alpha1.URL("https://jira.example.com/rest/api/2")),
+										APIType:   ptr.To("datacenter"),
+										Project:   "OPS",
+										IssueType: "Bug",
 									},
 								},
 							},
@@ -4133,12 +4358,22 @@ func TestGenerateConfig(t *testing.T) {
 					},
 				},
 			},
-			expectedError: true,
+			golden: "CR_with_Jira_Receiver_Unsupported_API_Type.golden",
 		},
 		{
-			name:      "CR with EmailConfig Missing SMTP From Field",
-			amVersion: &version26,
-			kclient:   fake.NewClientset(),
+			name:      "CR with Mattermost Receiver",
+			amVersion: &version31,
+			kclient: fake.NewClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "mattermost",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"url": []byte("https://mattermost.example.com/hooks/xxx"),
+					},
+				},
+			),
 			baseConfig: alertmanagerConfig{
 				Route: &route{
 					Receiver: "null",
@@ -4158,10 +4393,39 @@ func TestGenerateConfig(t *testing.T) {
 						Receivers: []monitoringv1alpha1.Receiver{
 							{
 								Name: "test",
-								EmailConfigs: []monitoringv1alpha1.EmailConfig{
+								MattermostConfigs: []monitoringv1alpha1.MattermostConfig{
 									{
-										From: ptr.To("admin@example.com"),
-										To:   ptr.To("customers@example.com"),
+										WebhookURL: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "mattermost",
+											},
+											Key: "url",
+										},
+										Channel:   ptr.To("alerts"),
+										Username:  ptr.To("alertmanager"),
+										Text:      ptr.To("{{ .CommonAnnotations.summary }}"),
+										IconEmoji: ptr.To(":fire:"),
+										Attachments: []monitoringv1alpha1.MattermostAttachment{
+											{
+												Color:     ptr.To("danger"),
+												Title:     ptr.To("{{ .CommonLabels.alertname }}"),
+												TitleLink: ptr.To(monitoringv1alpha1.URL("https://alertmanager.example.com")),
+												Fields: []monitoringv1alpha1.MattermostField{
+													{
+														Title: "Severity",
+														Value: "{{ .CommonLabels.severity }}",
+														Short: ptr.To(true),
+													},
+												},
+											},
+										},
+										Props: &monitoringv1alpha1.MattermostProps{
+											Card: ptr.To("{{ .CommonAnnotations.description }}"),
+										},
+										Priority: &monitoringv1alpha1.MattermostPriority{
+											Priority:     "urgent",
+											RequestedAck: ptr.To(true),
+										},
 									},
 								},
 							},
@@ -4169,20 +4433,23 @@ func TestGenerateConfig(t *testing.T) {
 					},
 				},
 			},
-			expectedError: true,
+			golden: "CR_with_Mattermost_Receiver.golden",
 		},
 		{
-			name:      "CR with EmailConfig Missing Required Fields from Receiver level but specified at Global level",
-			amVersion: &version26,
-			kclient:   fake.NewClientset(),
-			baseConfig: alertmanagerConfig{
-				Global: &globalConfig{
-					SMTPSmarthost: config.HostPort{
-						Host: "smtp.example.org",
-						Port: "587",
+			name:      "CR with Incidentio Receiver",
+			amVersion: &version31,
+			kclient: fake.NewClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "incidentio",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"token": []byte("alert-source-token"),
 					},
-					SMTPFrom: "admin@globaltest.com",
 				},
+			),
+			baseConfig: alertmanagerConfig{
 				Route: &route{
 					Receiver: "null",
 				},
@@ -4201,9 +4468,18 @@ func TestGenerateConfig(t *testing.T) {
 						Receivers: []monitoringv1alpha1.Receiver{
 							{
 								Name: "test",
-								EmailConfigs: []monitoringv1alpha1.EmailConfig{
+								IncidentioConfigs: []monitoringv1alpha1.IncidentioConfig{
 									{
-										To: ptr.To("customers@example.com"),
+										SendResolved: ptr.To(true),
+										URL:          ptr.To(monitoringv1alpha1.URL("https://api.incident.io/v2/alert_events/http/01ABCDEF")),
+										AlertSourceToken: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: "incidentio",
+											},
+											Key: "token",
+										},
+										MaxAlerts: ptr.To(int32(10)),
+										Timeout:   ptr.To(monitoringv1.Duration("30s")),
 									},
 								},
 							},
@@ -4211,11 +4487,11 @@ func TestGenerateConfig(t *testing.T) {
 					},
 				},
 			},
-			golden: "CR_with_EmailConfig_Receiver_Global_Defaults_Conf.golden",
+			golden: "CR_with_Incidentio_Receiver.golden",
 		},
 		{
-			name:      "CR with EmailConfig with ForceImplicitTLS",
-			amVersion: &version31,
+			name:      "CR with EmailConfig with Required Fields specified at Receiver level",
+			amVersion: &version26,
 			kclient:   fake.NewClientset(),
 			baseConfig: alertmanagerConfig{
 				Route: &route{
@@ -4238,10 +4514,9 @@ func TestGenerateConfig(t *testing.T) {
 								Name: "test",
 								EmailConfigs: []monitoringv1alpha1.EmailConfig{
 									{
-										Smarthost:        ptr.To("example.com:25"),
-										From:             ptr.To("admin@example.com"),
-										To:               ptr.To("customers@example.com"),
-										ForceImplicitTLS: ptr.To(true),
+										Smarthost: ptr.To("example.com:25"),
+										From:      ptr.To("admin@example.com"),
+										To:        ptr.To("customers@example.com"),
 									},
 								},
 							},
@@ -4249,11 +4524,11 @@ func TestGenerateConfig(t *testing.T) {
 					},
 				},
 			},
-			golden: "CR_with_EmailConfig_ForceImplicitTLS.golden",
+			golden: "CR_with_EmailConfig_Receiver_Conf.golden",
 		},
 		{
-			name:      "CR with EmailConfig with Threading",
-			amVersion: &version31,
+			name:      "CR with EmailConfig Missing SmartHost Field",
+			amVersion: &version26,
 			kclient:   fake.NewClientset(),
 			baseConfig: alertmanagerConfig{
 				Route: &route{
@@ -4276,10 +4551,162 @@ func TestGenerateConfig(t *testing.T) {
 								Name: "test",
 								EmailConfigs: []monitoringv1alpha1.EmailConfig{
 									{
-										Smarthost: ptr.To("example.com:25"),
-										From:      ptr.To("admin@example.com"),
-										To:        ptr.To("customers@example.com"),
-										Threading: &monitoringv1alpha1.EmailThreadingConfig{
+										From: ptr.To("admin@example.com"),
+										To:   ptr.To("customers@example.com"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name:      "CR with EmailConfig Missing SMTP From Field",
+			amVersion: &version26,
+			kclient:   fake.NewClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{
+							{
+								Name: "test",
+								EmailConfigs: []monitoringv1alpha1.EmailConfig{
+									{
+										From: ptr.To("admin@example.com"),
+										To:   ptr.To("customers@example.com"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name:      "CR with EmailConfig Missing Required Fields from Receiver level but specified at Global level",
+			amVersion: &version26,
+			kclient:   fake.NewClientset(),
+			baseConfig: alertmanagerConfig{
+				Global: &globalConfig{
+					SMTPSmarthost: config.HostPort{
+						Host: "smtp.example.org",
+						Port: "587",
+					},
+					SMTPFrom: "admin@globaltest.com",
+				},
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{
+							{
+								Name: "test",
+								EmailConfigs: []monitoringv1alpha1.EmailConfig{
+									{
+										To: ptr.To("customers@example.com"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			golden: "CR_with_EmailConfig_Receiver_Global_Defaults_Conf.golden",
+		},
+		{
+			name:      "CR with EmailConfig with ForceImplicitTLS",
+			amVersion: &version31,
+			kclient:   fake.NewClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{
+							{
+								Name: "test",
+								EmailConfigs: []monitoringv1alpha1.EmailConfig{
+									{
+										Smarthost:        ptr.To("example.com:25"),
+										From:             ptr.To("admin@example.com"),
+										To:               ptr.To("customers@example.com"),
+										ForceImplicitTLS: ptr.To(true),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			golden: "CR_with_EmailConfig_ForceImplicitTLS.golden",
+		},
+		{
+			name:      "CR with EmailConfig with Threading",
+			amVersion: &version31,
+			kclient:   fake.NewClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{
+							{
+								Name: "test",
+								EmailConfigs: []monitoringv1alpha1.EmailConfig{
+									{
+										Smarthost: ptr.To("example.com:25"),
+										From:      ptr.To("admin@example.com"),
+										To:        ptr.To("customers@example.com"),
+										Threading: &monitoringv1alpha1.EmailThreadingConfig{
 											ThreadByDate: monitoringv1alpha1.ThreadByDateTypeDaily,
 										},
 									},
@@ -4403,6 +4830,440 @@ func TestGenerateConfig(t *testing.T) {
 	}
 }
 
+func TestMatcherLabelValue(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		labels          map[string]string
+		namespaceLabels map[string]string
+		expected        string
+	}{
+		{
+			name:     "label on the object",
+			labels:   map[string]string{"team": "frontend"},
+			expected: "frontend",
+		},
+		{
+			name:            "label on the namespace",
+			namespaceLabels: map[string]string{"team": "backend"},
+			expected:        "backend",
+		},
+		{
+			name:            "namespace label takes precedence",
+			labels:          map[string]string{"team": "frontend"},
+			namespaceLabels: map[string]string{"team": "backend"},
+			expected:        "backend",
+		},
+		{
+			name:            "object label can't override an empty namespace label",
+			labels:          map[string]string{"team": "frontend"},
+			namespaceLabels: map[string]string{"team": ""},
+			expected:        "",
+		},
+		{
+			name:            "missing label",
+			labels:          map[string]string{"app": "frontend"},
+			namespaceLabels: map[string]string{"app": "backend"},
+			expected:        "",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			amc := &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "myamc",
+					Namespace: "mynamespace",
+					Labels:    tc.labels,
+				},
+			}
+
+			require.Equal(t, tc.expected, matcherLabelValue("team", amc, tc.namespaceLabels))
+		})
+	}
+}
+
+func TestSharedTimeIntervals(t *testing.T) {
+	amConfigs := map[string]*monitoringv1alpha1.AlertmanagerConfig{
+		"mynamespace": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myamc",
+				Namespace: "mynamespace",
+			},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					Receiver:          "test",
+					MuteTimeIntervals: []string{"company-holidays", "weekends"},
+				},
+				SharedTimeIntervals: []string{"company-holidays"},
+				MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+					{
+						// Not declared as shared, the local time interval is
+						// used even though the base configuration defines a
+						// time interval with the same name.
+						Name: "weekends",
+						TimeIntervals: []monitoringv1alpha1.TimeInterval{
+							{
+								Weekdays: []monitoringv1alpha1.WeekdayRange{
+									monitoringv1alpha1.WeekdayRange("Saturday"),
+									monitoringv1alpha1.WeekdayRange("Sunday"),
+								},
+							},
+						},
+					},
+				},
+				Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name           string
+		rawConfig      string
+		globalAmConfig *monitoringv1alpha1.AlertmanagerConfig
+		golden         string
+	}{
+		{
+			name: "time intervals from the raw configuration",
+			rawConfig: `route:
+  receiver: "null"
+receivers:
+- name: "null"
+time_intervals:
+- name: company-holidays
+  time_intervals:
+  - months: ["december"]
+    days_of_month: ["25:26"]
+- name: weekends
+  time_intervals:
+  - weekdays: ["saturday", "sunday"]
+`,
+			golden: "shared_time_intervals_from_raw_configuration.golden",
+		},
+		{
+			name: "time intervals from the global AlertmanagerConfig",
+			globalAmConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "global-config",
+					Namespace: "alertmanager-namespace",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "null",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{Name: "null"}},
+					MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+						{
+							Name: "company-holidays",
+							TimeIntervals: []monitoringv1alpha1.TimeInterval{
+								{
+									Months: []monitoringv1alpha1.MonthRange{"December"},
+									DaysOfMonth: []monitoringv1alpha1.DayOfMonthRange{
+										{Start: 25, End: 26},
+									},
+									Location: ptr.To("Europe/Paris"),
+								},
+							},
+						},
+					},
+				},
+			},
+			golden: "shared_time_intervals_from_global_AlertmanagerConfig.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kclient := fake.NewClientset()
+			store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+
+			cb := NewConfigBuilder(newNopLogger(t), semver.MustParse("0.28.0"), store,
+				&monitoringv1.Alertmanager{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "alertmanager-namespace"},
+				},
+			)
+
+			if tc.globalAmConfig != nil {
+				require.NoError(t, cb.initializeFromAlertmanagerConfig(context.Background(), nil, tc.globalAmConfig))
+			} else {
+				require.NoError(t, cb.InitializeFromRawConfiguration([]byte(tc.rawConfig)))
+			}
+
+			require.NoError(t, cb.AddAlertmanagerConfigs(context.Background(), amConfigs))
+
+			cfgBytes, err := cb.MarshalJSON()
+			require.NoError(t, err)
+
+			golden.Assert(t, string(cfgBytes), tc.golden)
+
+			_, err = alertmanagerConfigFromBytes(cfgBytes)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSharedReceivers(t *testing.T) {
+	globalAmConfig := &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "global-config",
+			Namespace: "alertmanager-namespace",
+		},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Route: &monitoringv1alpha1.Route{
+				Receiver: "null",
+			},
+			Receivers: []monitoringv1alpha1.Receiver{
+				{Name: "null"},
+				{
+					Name: "on-call",
+					WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+						{URL: ptr.To("http://on-call.example.com/")},
+					},
+				},
+				{
+					Name: "test",
+					WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+						{URL: ptr.To("http://test.example.com/")},
+					},
+				},
+			},
+		},
+	}
+
+	amConfigs := map[string]*monitoringv1alpha1.AlertmanagerConfig{
+		"mynamespace": {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myamc",
+				Namespace: "mynamespace",
+			},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					// Not declared as shared, refers to the local receiver.
+					Receiver: "test",
+					Routes: []apiextensionsv1.JSON{
+						{Raw: []byte(`{"receiver": "on-call", "matchers": [{"name": "severity", "value": "critical"}]}`)},
+					},
+				},
+				Receivers:       []monitoringv1alpha1.Receiver{{Name: "test"}},
+				SharedReceivers: []string{"on-call"},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name            string
+		sharedReceivers []monitoringv1.SharedReceiver
+		golden          string
+		err             bool
+	}{
+		{
+			name: "shared receivers",
+			sharedReceivers: []monitoringv1.SharedReceiver{
+				{Name: "on-call"},
+				{
+					Name: "test",
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"team": "test"},
+					},
+				},
+			},
+			golden: "shared_receivers.golden",
+		},
+		{
+			name: "missing receiver",
+			sharedReceivers: []monitoringv1.SharedReceiver{
+				{Name: "awol"},
+			},
+			err: true,
+		},
+		{
+			name: "invalid namespace selector",
+			sharedReceivers: []monitoringv1.SharedReceiver{
+				{
+					Name: "on-call",
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "team", Operator: "Invalid"},
+						},
+					},
+				},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kclient := fake.NewClientset()
+			store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+
+			cb := NewConfigBuilder(newNopLogger(t), semver.MustParse("0.28.0"), store,
+				&monitoringv1.Alertmanager{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "alertmanager-namespace"},
+				},
+			)
+
+			require.NoError(t, cb.initializeFromAlertmanagerConfig(context.Background(), nil, globalAmConfig))
+
+			err := cb.initializeSharedReceivers(tc.sharedReceivers, globalAmConfig)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, cb.AddAlertmanagerConfigs(context.Background(), amConfigs))
+
+			cfgBytes, err := cb.MarshalJSON()
+			require.NoError(t, err)
+
+			golden.Assert(t, string(cfgBytes), tc.golden)
+
+			_, err = alertmanagerConfigFromBytes(cfgBytes)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTracing(t *testing.T) {
+	globalAmConfig := &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "global-config",
+			Namespace: "alertmanager-namespace",
+		},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Route: &monitoringv1alpha1.Route{
+				Receiver: "null",
+			},
+			Receivers: []monitoringv1alpha1.Receiver{{Name: "null"}},
+		},
+	}
+
+	tracing := &monitoringv1.TracingConfig{
+		ClientType:       ptr.To("GRPC"),
+		Endpoint:         "otel-collector:4317",
+		SamplingFraction: ptr.To(resource.MustParse("0.5")),
+		Headers:          map[string]string{"X-Scope-OrgID": "team-a"},
+		Compression:      ptr.To("Gzip"),
+		Timeout:          ptr.To(monitoringv1.Duration("10s")),
+		TLSConfig: &monitoringv1.TLSConfig{
+			SafeTLSConfig: monitoringv1.SafeTLSConfig{
+				CA: monitoringv1.SecretOrConfigMap{
+					Secret: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "otel-tls"},
+						Key:                  "ca.crt",
+					},
+				},
+			},
+			TLSFilesConfig: monitoringv1.TLSFilesConfig{
+				CertFile: "/etc/alertmanager/secrets/otel/tls.crt",
+				KeyFile:  "/etc/alertmanager/secrets/otel/tls.key",
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		amVersion string
+		tracing   *monitoringv1.TracingConfig
+		golden    string
+		err       bool
+	}{
+		{
+			name:      "tracing",
+			amVersion: "0.30.0",
+			tracing:   tracing,
+			golden:    "tracing.golden",
+		},
+		{
+			name:      "unsupported version",
+			amVersion: "0.29.0",
+			tracing:   tracing,
+			golden:    "tracing_unsupported_version.golden",
+		},
+		{
+			name:      "invalid sampling fraction",
+			amVersion: "0.30.0",
+			tracing: &monitoringv1.TracingConfig{
+				Endpoint:         "otel-collector:4317",
+				SamplingFraction: ptr.To(resource.MustParse("2")),
+			},
+			err: true,
+		},
+		{
+			name:      "missing TLS secret",
+			amVersion: "0.30.0",
+			tracing: &monitoringv1.TracingConfig{
+				Endpoint: "otel-collector:4317",
+				TLSConfig: &monitoringv1.TLSConfig{
+					SafeTLSConfig: monitoringv1.SafeTLSConfig{
+						CA: monitoringv1.SecretOrConfigMap{
+							Secret: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "awol"},
+								Key:                  "ca.crt",
+							},
+						},
+					},
+				},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kclient := fake.NewClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "otel-tls",
+						Namespace: "alertmanager-namespace",
+					},
+					Data: map[string][]byte{
+						"ca.crt": []byte(`-----BEGIN CERTIFICATE-----
+MIIDbTCCAlWgAwIBAgIUM7xicCKY+p54CUpjWsTl7KTssbIwDQYJKoZIhvcNAQEL
+BQAwRTELMAkGA1UEBhMCQVUxEzARBgNVBAgMClNvbWUtU3RhdGUxITAfBgNVBAoM
+GEludGVybmV0IFdpZGdpdHMgUHR5IEx0ZDAgFw0yNTExMTExNjU5MTRaGA8yMTI1
+MTAxODE2NTkxNFowRTELMAkGA1UEBhMCQVUxEzARBgNVBAgMClNvbWUtU3RhdGUx
+ITAfBgNVBAoMGEludGVybmV0IFdpZGdpdHMgUHR5IEx0ZDCCASIwDQYJKoZIhvcN
+AQEBBQADggEPADCCAQoCggEBAJZlFSLI4/t27LnnYnv+EsFXoyjryinP4NbaHjjB
+gEEEHMo+GgL8cOu3VbDuzgpC3opJ+AHGsltXc+gZ86YPu8EzwiKB+Ci4p8K5z9+g
+QW8WX9lpZn7z5WRm53llVLDZY/vCSzQ5KFQ8V/0vYJfxOYUapilH9mQqENnaw9dz
+0VckluLgSLKA/A95p8Rp2Zt1tAtwjD3ClRQ1wricymbt+5qVt45zLC6MD32WizyV
+vjCUc2kCZDyjHPZIauLoo0rQAiO/mX8nUJpxGf8yp/Rs7hL1tBAWSj9FFBvdUwz+
+z9qRyE6ojp1HVSDpGyLsRXZwqiP5IL72iZlcoDRr1+zmWTMCAwEAAaNTMFEwHQYD
+VR0OBBYEFC5yBxXPVqkvyq05rr7OzIdS1h2GMB8GA1UdIwQYMBaAFC5yBxXPVqkv
+yq05rr7OzIdS1h2GMA8GA1UdEwEB/wQFMAMBAf8wDQYJKoZIhvcNAQELBQADggEB
+AFO+7n/RSw18NRZ8Z8uFLvZwWCc/PeZ5uo23m4AAVLr5vwWCIeh+DYG4u4xhyKd5
+B3U7zgxU2/pmSS1kPDAIBooVe90C804OxfL3/QOurRC9Eugi441DvpkJ2Uy91PWA
+E5G2s2fZRWUytKl0I7YqyeDlP96V34qi6P8e3GAWGoGExjJzQVomYXeVU/0eQkOC
+Z8Ja2z8jw1xUKxfurno8wsAgFAQLuUZ0sTpwHBtwzFEdIeaAHBbNkkuGq7leIw/u
+83OdaXOYthY8wG5jRwDRQSA0FGayQrKPj1+II2VMgU/ApF5zs7Gid32pi4iVyu1i
+9MFjFOe4ShaqsQ9HgZuAZls=
+-----END CERTIFICATE-----`),
+					},
+				},
+			)
+			store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+
+			cb := NewConfigBuilder(newNopLogger(t), semver.MustParse(tc.amVersion), store,
+				&monitoringv1.Alertmanager{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "alertmanager-namespace"},
+				},
+			)
+
+			require.NoError(t, cb.initializeFromAlertmanagerConfig(context.Background(), nil, globalAmConfig))
+
+			err := cb.initializeTracing(context.Background(), tc.tracing, "alertmanager-namespace")
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, cb.AddAlertmanagerConfigs(context.Background(), nil))
+
+			cfgBytes, err := cb.MarshalJSON()
+			require.NoError(t, err)
+
+			golden.Assert(t, string(cfgBytes), tc.golden)
+
+			_, err = config.Load(string(cfgBytes))
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestSanitizeConfig(t *testing.T) {
 	logger := newNopLogger(t)
 	versionFileURLAllowed := semver.Version{Major: 0, Minor: 22}
@@ -0,0 +1,394 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/alertmanager/config"
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/alertmanager/timeinterval"
+	"github.com/prometheus/common/model"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// RouteExplanation describes how an Alertmanager configuration routes an
+// alert.
+type RouteExplanation struct {
+	// Labels are the labels of the alert.
+	Labels model.LabelSet `json:"labels"`
+	// Time is the time at which the time intervals are evaluated.
+	Time time.Time `json:"time"`
+	// Routes are the routes matching the alert.
+	Routes []MatchedRoute `json:"routes"`
+}
+
+// MatchedRoute describes a route matching an alert.
+type MatchedRoute struct {
+	// Path lists the matchers of the routes, from the root route down to
+	// the matched route.
+	Path []string `json:"path"`
+	// Receiver is the name of the receiver.
+	Receiver string `json:"receiver"`
+	// GroupBy lists the labels used to group the alerts ("..." means all
+	// labels).
+	GroupBy []string `json:"groupBy"`
+	// GroupWait, GroupInterval and RepeatInterval are the notification
+	// timings of the route.
+	GroupWait      model.Duration `json:"groupWait"`
+	GroupInterval  model.Duration `json:"groupInterval"`
+	RepeatInterval model.Duration `json:"repeatInterval"`
+	// MuteTimeIntervals and ActiveTimeIntervals are the time intervals
+	// which apply to the route.
+	MuteTimeIntervals   []TimeIntervalStatus `json:"muteTimeIntervals,omitempty"`
+	ActiveTimeIntervals []TimeIntervalStatus `json:"activeTimeIntervals,omitempty"`
+	// Muted is true when the notifications of the route are muted at the
+	// evaluation time.
+	Muted bool `json:"muted"`
+}
+
+// TimeIntervalStatus describes a time interval at the evaluation time.
+type TimeIntervalStatus struct {
+	// Name is the name of the time interval.
+	Name string `json:"name"`
+	// Contains is true when the evaluation time is within the time interval.
+	Contains bool `json:"contains"`
+}
+
+// ParseAlertLabels parses a list of "name=value" strings into a label set.
+func ParseAlertLabels(in []string) (model.LabelSet, error) {
+	lset := make(model.LabelSet, len(in))
+	for _, s := range in {
+		name, value, found := strings.Cut(s, "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid label %q: expected format is name=value", s)
+		}
+
+		lset[model.LabelName(name)] = model.LabelValue(value)
+	}
+
+	return lset, nil
+}
+
+// routeMatch is a route matching an alert with the names of its time
+// intervals.
+type routeMatch struct {
+	MatchedRoute
+	muteTimeIntervals   []string
+	activeTimeIntervals []string
+}
+
+// defaultRouteOpts are the notification timings of the root route when they
+// aren't set in the configuration (same defaults as the Alertmanager).
+var defaultRouteOpts = routeMatch{
+	MatchedRoute: MatchedRoute{
+		GroupWait:      model.Duration(30 * time.Second),
+		GroupInterval:  model.Duration(5 * time.Minute),
+		RepeatInterval: model.Duration(4 * time.Hour),
+		GroupBy:        []string{},
+	},
+}
+
+// routingTree holds the routing tree and the time intervals of an
+// Alertmanager configuration.
+type routingTree struct {
+	route         *config.Route
+	timeIntervals map[string][]timeinterval.TimeInterval
+}
+
+// loadRoutingTree returns the routing tree of the given Alertmanager
+// configuration.
+func loadRoutingTree(rawConfig []byte) (*routingTree, error) {
+	cfg, err := config.Load(string(rawConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the Alertmanager configuration: %w", err)
+	}
+
+	timeIntervals := make(map[string][]timeinterval.TimeInterval, len(cfg.MuteTimeIntervals)+len(cfg.TimeIntervals))
+	for _, ti := range cfg.MuteTimeIntervals {
+		timeIntervals[ti.Name] = ti.TimeIntervals
+	}
+	for _, ti := range cfg.TimeIntervals {
+		timeIntervals[ti.Name] = ti.TimeIntervals
+	}
+
+	return &routingTree{
+		route:         cfg.Route,
+		timeIntervals: timeIntervals,
+	}, nil
+}
+
+// explain returns the routes which match the given alert labels. The time
+// intervals are evaluated at the given time.
+func (rt *routingTree) explain(lset model.LabelSet, t time.Time) (*RouteExplanation, error) {
+	routes, err := matchRoute(rt.route, defaultRouteOpts, lset)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &RouteExplanation{
+		Labels: lset,
+		Time:   t,
+		Routes: []MatchedRoute{},
+	}
+
+	for _, rm := range routes {
+		mr := rm.MatchedRoute
+		for _, name := range rm.muteTimeIntervals {
+			status := timeIntervalStatus(name, rt.timeIntervals[name], t)
+			mr.MuteTimeIntervals = append(mr.MuteTimeIntervals, status)
+			mr.Muted = mr.Muted || status.Contains
+		}
+
+		if len(rm.activeTimeIntervals) > 0 {
+			active := false
+			for _, name := range rm.activeTimeIntervals {
+				status := timeIntervalStatus(name, rt.timeIntervals[name], t)
+				mr.ActiveTimeIntervals = append(mr.ActiveTimeIntervals, status)
+				active = active || status.Contains
+			}
+			mr.Muted = mr.Muted || !active
+		}
+
+		explanation.Routes = append(explanation.Routes, mr)
+	}
+
+	return explanation, nil
+}
+
+// matchRoute returns the routes of the tree starting at cr which match the
+// label set. It follows the matching logic of the Alertmanager dispatcher:
+// the children are evaluated in order until one of them matches and doesn't
+// have "continue" set. If no child matches, the route itself matches.
+func matchRoute(cr *config.Route, parent routeMatch, lset model.LabelSet) ([]routeMatch, error) {
+	matchers, err := routeMatchers(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !matchers.Matches(lset) {
+		return nil, nil
+	}
+
+	// Child routes inherit the options of their parent except the time
+	// intervals.
+	mr := parent
+	mr.Path = append(slices.Clone(parent.Path), matchers.String())
+	mr.muteTimeIntervals = cr.MuteTimeIntervals
+	mr.activeTimeIntervals = cr.ActiveTimeIntervals
+
+	if cr.Receiver != "" {
+		mr.Receiver = cr.Receiver
+	}
+
+	switch {
+	case cr.GroupBy != nil:
+		mr.GroupBy = make([]string, 0, len(cr.GroupBy))
+		for _, ln := range cr.GroupBy {
+			mr.GroupBy = append(mr.GroupBy, string(ln))
+		}
+		slices.Sort(mr.GroupBy)
+		mr.GroupBy = slices.Compact(mr.GroupBy)
+	case cr.GroupByAll:
+		mr.GroupBy = []string{"..."}
+	}
+
+	if cr.GroupWait != nil {
+		mr.GroupWait = *cr.GroupWait
+	}
+	if cr.GroupInterval != nil {
+		mr.GroupInterval = *cr.GroupInterval
+	}
+	if cr.RepeatInterval != nil {
+		mr.RepeatInterval = *cr.RepeatInterval
+	}
+
+	var all []routeMatch
+	for _, child := range cr.Routes {
+		matches, err := matchRoute(child, mr, lset)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, matches...)
+
+		if matches != nil && !child.Continue {
+			break
+		}
+	}
+
+	if len(all) == 0 {
+		all = append(all, mr)
+	}
+
+	return all, nil
+}
+
+// routeMatchers returns the sorted matchers of the route, including the
+// deprecated match and match_re fields.
+func routeMatchers(cr *config.Route) (amlabels.Matchers, error) {
+	matchers := make(amlabels.Matchers, 0, len(cr.Match)+len(cr.MatchRE)+len(cr.Matchers))
+
+	for ln, lv := range cr.Match {
+		m, err := amlabels.NewMatcher(amlabels.MatchEqual, ln, lv)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+
+	for ln, lv := range cr.MatchRE {
+		m, err := amlabels.NewMatcher(amlabels.MatchRegexp, ln, lv.String())
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+
+	matchers = append(matchers, cr.Matchers...)
+	sort.Sort(matchers)
+
+	return matchers, nil
+}
+
+// ExplainRoute returns the routes of the Alertmanager configuration which
+// match the given alert labels. The time intervals are evaluated at the given
+// time.
+func ExplainRoute(rawConfig []byte, lset model.LabelSet, t time.Time) (*RouteExplanation, error) {
+	rt, err := loadRoutingTree(rawConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return rt.explain(lset, t)
+}
+
+func timeIntervalStatus(name string, tis []timeinterval.TimeInterval, t time.Time) TimeIntervalStatus {
+	status := TimeIntervalStatus{Name: name}
+	for _, ti := range tis {
+		if ti.ContainsTime(t.UTC()) {
+			status.Contains = true
+			break
+		}
+	}
+
+	return status
+}
+
+// updateRoutingTree records the routing tree of the configuration generated
+// for the Alertmanager object. It is a no-op unless the route explain
+// endpoint is enabled.
+func (c *Operator) updateRoutingTree(key string, rawConfig []byte) {
+	if !c.routeExplainEnabled {
+		return
+	}
+
+	rt, err := loadRoutingTree(rawConfig)
+	if err != nil {
+		c.logger.Debug("failed to load the routing tree", "key", key, "err", err)
+		c.forgetRoutingTree(key)
+		return
+	}
+
+	c.routingTreesMtx.Lock()
+	defer c.routingTreesMtx.Unlock()
+
+	c.routingTrees[key] = rt
+}
+
+// forgetRoutingTree removes the routing tree of the Alertmanager object.
+func (c *Operator) forgetRoutingTree(key string) {
+	c.routingTreesMtx.Lock()
+	defer c.routingTreesMtx.Unlock()
+
+	delete(c.routingTrees, key)
+}
+
+// ExplainRoute returns the routes matching the given alert labels for the
+// Alertmanager object identified by key ("<namespace>/<name>").
+//
+// The routes are evaluated against the configuration generated by the last
+// reconciliation of the object: the function doesn't send any request to
+// the Kubernetes API and has no side effect.
+func (c *Operator) ExplainRoute(key string, lset model.LabelSet, t time.Time) (*RouteExplanation, error) {
+	c.routingTreesMtx.RLock()
+	rt, found := c.routingTrees[key]
+	c.routingTreesMtx.RUnlock()
+
+	if !found {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: monitoringv1.SchemeGroupVersion.Group, Resource: monitoringv1.AlertmanagerName}, key)
+	}
+
+	return rt.explain(lset, t)
+}
+
+// RouteExplainHandler returns an HTTP handler explaining how an Alertmanager
+// object routes an alert. The query parameters are:
+//   - alertmanager: the "<namespace>/<name>" key of the Alertmanager object (required).
+//   - label: a "name=value" alert label (repeatable).
+//   - time: the RFC3339 time at which the time intervals are evaluated (default: now).
+//
+// The handler returns a 404 status code if the Alertmanager object doesn't
+// exist or if the controller hasn't been created with WithRouteExplain().
+func (c *Operator) RouteExplainHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+
+		key := q.Get("alertmanager")
+		if key == "" {
+			http.Error(w, "missing 'alertmanager' parameter", http.StatusBadRequest)
+			return
+		}
+
+		lset, err := ParseAlertLabels(q["label"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		t := time.Now()
+		if s := q.Get("time"); s != "" {
+			t, err = time.Parse(time.RFC3339, s)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid 'time' parameter: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+
+		explanation, err := c.ExplainRoute(key, lset, t)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if apierrors.IsNotFound(err) {
+				status = http.StatusNotFound
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(explanation); err != nil {
+			c.logger.Warn("failed to encode the route explanation", "err", err)
+		}
+	})
+}
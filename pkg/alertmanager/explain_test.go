@@ -0,0 +1,372 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func TestParseAlertLabels(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   []string
+		exp  model.LabelSet
+		err  bool
+	}{
+		{
+			name: "empty",
+			exp:  model.LabelSet{},
+		},
+		{
+			name: "valid labels",
+			in:   []string{"severity=critical", "job=", "query=a=b"},
+			exp: model.LabelSet{
+				"severity": "critical",
+				"job":      "",
+				"query":    "a=b",
+			},
+		},
+		{
+			name: "missing separator",
+			in:   []string{"severity"},
+			err:  true,
+		},
+		{
+			name: "empty name",
+			in:   []string{"=critical"},
+			err:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			lset, err := ParseAlertLabels(tc.in)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, lset)
+		})
+	}
+}
+
+func TestExplainRoute(t *testing.T) {
+	const cfg = `
+route:
+  receiver: default
+  group_by: [job]
+  routes:
+  - receiver: team-a
+    matchers: ['team="a"']
+    group_by: ['...']
+    group_wait: 10s
+    mute_time_intervals: [weekend]
+    continue: true
+  - receiver: team-a-office-hours
+    matchers: ['team="a"']
+    active_time_intervals: [office-hours]
+    routes:
+    - receiver: team-a-critical
+      matchers: ['severity="critical"']
+receivers:
+- name: default
+- name: team-a
+- name: team-a-office-hours
+- name: team-a-critical
+time_intervals:
+- name: weekend
+  time_intervals:
+  - weekdays: [saturday, sunday]
+- name: office-hours
+  time_intervals:
+  - times:
+    - start_time: "09:00"
+      end_time: "17:00"
+`
+
+	// Saturday.
+	saturday := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	// Monday.
+	monday := time.Date(2024, 6, 3, 20, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name string
+		lset model.LabelSet
+		t    time.Time
+		exp  []MatchedRoute
+	}{
+		{
+			name: "root route",
+			lset: model.LabelSet{"team": "b"},
+			t:    saturday,
+			exp: []MatchedRoute{
+				{
+					Path:           []string{"{}"},
+					Receiver:       "default",
+					GroupBy:        []string{"job"},
+					GroupWait:      model.Duration(30 * time.Second),
+					GroupInterval:  model.Duration(5 * time.Minute),
+					RepeatInterval: model.Duration(4 * time.Hour),
+				},
+			},
+		},
+		{
+			name: "continue and nested routes on saturday",
+			lset: model.LabelSet{"team": "a", "severity": "critical"},
+			t:    saturday,
+			exp: []MatchedRoute{
+				{
+					Path:              []string{"{}", `{team="a"}`},
+					Receiver:          "team-a",
+					GroupBy:           []string{"..."},
+					GroupWait:         model.Duration(10 * time.Second),
+					GroupInterval:     model.Duration(5 * time.Minute),
+					RepeatInterval:    model.Duration(4 * time.Hour),
+					MuteTimeIntervals: []TimeIntervalStatus{{Name: "weekend", Contains: true}},
+					Muted:             true,
+				},
+				{
+					// Time intervals aren't inherited by child routes.
+					Path:           []string{"{}", `{team="a"}`, `{severity="critical"}`},
+					Receiver:       "team-a-critical",
+					GroupBy:        []string{"job"},
+					GroupWait:      model.Duration(30 * time.Second),
+					GroupInterval:  model.Duration(5 * time.Minute),
+					RepeatInterval: model.Duration(4 * time.Hour),
+				},
+			},
+		},
+		{
+			name: "continue and nested routes on monday evening",
+			lset: model.LabelSet{"team": "a"},
+			t:    monday,
+			exp: []MatchedRoute{
+				{
+					Path:              []string{"{}", `{team="a"}`},
+					Receiver:          "team-a",
+					GroupBy:           []string{"..."},
+					GroupWait:         model.Duration(10 * time.Second),
+					GroupInterval:     model.Duration(5 * time.Minute),
+					RepeatInterval:    model.Duration(4 * time.Hour),
+					MuteTimeIntervals: []TimeIntervalStatus{{Name: "weekend"}},
+				},
+				{
+					Path:                []string{"{}", `{team="a"}`},
+					Receiver:            "team-a-office-hours",
+					GroupBy:             []string{"job"},
+					GroupWait:           model.Duration(30 * time.Second),
+					GroupInterval:       model.Duration(5 * time.Minute),
+					RepeatInterval:      model.Duration(4 * time.Hour),
+					ActiveTimeIntervals: []TimeIntervalStatus{{Name: "office-hours"}},
+					Muted:               true,
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			explanation, err := ExplainRoute([]byte(cfg), tc.lset, tc.t)
+			require.NoError(t, err)
+			require.Equal(t, tc.lset, explanation.Labels)
+			require.Equal(t, tc.exp, explanation.Routes)
+		})
+	}
+}
+
+func TestExplainRouteInvalidConfig(t *testing.T) {
+	_, err := ExplainRoute([]byte("route: {}"), model.LabelSet{}, time.Now())
+	require.Error(t, err)
+}
+
+func TestRouteExplainHandler(t *testing.T) {
+	const cfg = `
+route:
+  receiver: default
+  routes:
+  - receiver: team-a
+    matchers: ['team="a"']
+receivers:
+- name: default
+- name: team-a
+`
+
+	for _, tc := range []struct {
+		name         string
+		enabled      bool
+		query        string
+		expectedCode int
+		expected     string
+	}{
+		{
+			name:         "disabled",
+			query:        "alertmanager=monitoring/main&label=team=a",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "missing alertmanager parameter",
+			enabled:      true,
+			query:        "label=team=a",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid label",
+			enabled:      true,
+			query:        "alertmanager=monitoring/main&label=team",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "unknown alertmanager",
+			enabled:      true,
+			query:        "alertmanager=monitoring/other&label=team=a",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "matching route",
+			enabled:      true,
+			query:        "alertmanager=monitoring/main&label=team=a",
+			expectedCode: http.StatusOK,
+			expected:     "team-a",
+		},
+		{
+			name:         "default route",
+			enabled:      true,
+			query:        "alertmanager=monitoring/main&label=team=b",
+			expectedCode: http.StatusOK,
+			expected:     "default",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &Operator{logger: newNopLogger(t)}
+			if tc.enabled {
+				WithRouteExplain()(o)
+			}
+			o.updateRoutingTree("monitoring/main", []byte(cfg))
+
+			rec := httptest.NewRecorder()
+			o.RouteExplainHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/alertmanager/routes?"+tc.query, nil))
+			require.Equal(t, tc.expectedCode, rec.Code, rec.Body.String())
+
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			var explanation RouteExplanation
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&explanation))
+			require.Len(t, explanation.Routes, 1)
+			require.Equal(t, tc.expected, explanation.Routes[0].Receiver)
+		})
+	}
+}
+
+func TestForgetRoutingTree(t *testing.T) {
+	o := &Operator{logger: newNopLogger(t)}
+	WithRouteExplain()(o)
+
+	o.updateRoutingTree("monitoring/main", []byte("route:\n  receiver: default\nreceivers:\n- name: default\n"))
+	_, err := o.ExplainRoute("monitoring/main", model.LabelSet{}, time.Now())
+	require.NoError(t, err)
+
+	o.forgetRoutingTree("monitoring/main")
+	_, err = o.ExplainRoute("monitoring/main", model.LabelSet{}, time.Now())
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func TestGenerateConfiguration(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: "monitoring",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfigSelector: &metav1.LabelSelector{},
+			AlertmanagerConfigNamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"alerting": "enabled"},
+			},
+		},
+	}
+
+	res := Resources{
+		Client: fake.NewClientset(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "alertmanager-main",
+					Namespace: "monitoring",
+				},
+				Data: map[string][]byte{
+					"alertmanager.yaml": []byte(`route:
+  receiver: "null"
+receivers:
+- name: "null"
+`),
+				},
+			},
+		).CoreV1(),
+		Namespaces: []*corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"alerting": "enabled"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+		},
+		AlertmanagerConfigs: []*monitoringv1alpha1.AlertmanagerConfig{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "team-a"},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route:     &monitoringv1alpha1.Route{Receiver: "web"},
+					Receivers: []monitoringv1alpha1.Receiver{{Name: "web"}},
+				},
+			},
+			{
+				// Invalid because the receiver doesn't exist.
+				ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "team-a"},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{Receiver: "missing"},
+				},
+			},
+			{
+				// Not selected by the namespace selector.
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "team-b"},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route:     &monitoringv1alpha1.Route{Receiver: "db"},
+					Receivers: []monitoringv1alpha1.Receiver{{Name: "db"}},
+				},
+			},
+		},
+	}
+
+	cfg, err := GenerateConfiguration(context.Background(), newNopLogger(t), am, res)
+	require.NoError(t, err)
+
+	explanation, err := ExplainRoute(cfg, model.LabelSet{"namespace": "team-a"}, time.Now())
+	require.NoError(t, err)
+	require.Len(t, explanation.Routes, 1)
+	require.Equal(t, "team-a/web/web", explanation.Routes[0].Receiver)
+
+	explanation, err = ExplainRoute(cfg, model.LabelSet{"namespace": "team-b"}, time.Now())
+	require.NoError(t, err)
+	require.Len(t, explanation.Routes, 1)
+	require.Equal(t, "null", explanation.Routes[0].Receiver)
+}
@@ -0,0 +1,261 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+
+	"github.com/blang/semver/v4"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// configSource provides the resources needed to generate the configuration
+// of an Alertmanager object.
+type configSource interface {
+	// loadConfigurationFromSecret returns the raw configuration and the
+	// additional keys from the configuration secret.
+	loadConfigurationFromSecret(context.Context, *monitoringv1.Alertmanager) ([]byte, map[string][]byte, error)
+	// getGlobalAlertmanagerConfig returns the AlertmanagerConfig object
+	// referenced by spec.alertmanagerConfiguration.
+	getGlobalAlertmanagerConfig(context.Context, *monitoringv1.Alertmanager) (*monitoringv1alpha1.AlertmanagerConfig, error)
+	// selectAlertmanagerConfigs returns the valid AlertmanagerConfig objects
+	// selected by the Alertmanager object.
+	selectAlertmanagerConfigs(context.Context, *monitoringv1.Alertmanager, semver.Version, *assets.StoreBuilder, sharedConfig) (map[string]*monitoringv1alpha1.AlertmanagerConfig, error)
+	// namespaceLabels returns the labels of the given namespace.
+	namespaceLabels(string) map[string]string
+}
+
+var _ configSource = &Operator{}
+
+// generateConfiguration returns the Alertmanager configuration and the
+// additional data of the configuration secret for the given Alertmanager
+// object.
+func generateConfiguration(ctx context.Context, logger *slog.Logger, src configSource, am *monitoringv1.Alertmanager, store *assets.StoreBuilder) ([]byte, map[string][]byte, error) {
+	amVersion := operator.StringValOrDefault(am.Spec.Version, operator.DefaultAlertmanagerVersion)
+	version, err := semver.ParseTolerant(amVersion)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse alertmanager version: %w", err)
+	}
+
+	if version.LT(semver.MustParse("0.15.0")) || version.Major > 0 {
+		return nil, nil, fmt.Errorf("unsupported Alertmanager version %q", amVersion)
+	}
+
+	// If no AlertmanagerConfig selectors and AlertmanagerConfiguration are
+	// configured, the user wants to manage configuration themselves.
+	if am.Spec.AlertmanagerConfigSelector == nil && am.Spec.AlertmanagerConfiguration == nil {
+		logger.Debug("AlertmanagerConfigSelector and AlertmanagerConfiguration not specified, using the configuration from secret as-is",
+			"secret", defaultConfigSecretName(am))
+
+		amRawConfiguration, additionalData, err := src.loadConfigurationFromSecret(ctx, am)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to retrieve configuration from secret: %w", err)
+		}
+
+		return amRawConfiguration, additionalData, nil
+	}
+
+	var (
+		additionalData map[string][]byte
+		cfgBuilder     = NewConfigBuilder(logger, version, store, am)
+	)
+	cfgBuilder.namespaceLabels = src.namespaceLabels
+
+	if am.Spec.AlertmanagerConfiguration != nil {
+		// Load the base configuration from the referenced AlertmanagerConfig.
+		globalAmConfig, err := src.getGlobalAlertmanagerConfig(ctx, am)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get global AlertmanagerConfig: %w", err)
+		}
+
+		err = cfgBuilder.initializeFromAlertmanagerConfig(ctx, am.Spec.AlertmanagerConfiguration.Global, globalAmConfig)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to initialize from global AlertmanagerConfig: %w", err)
+		}
+
+		err = cfgBuilder.initializeSharedReceivers(am.Spec.AlertmanagerConfiguration.SharedReceivers, globalAmConfig)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to initialize shared receivers: %w", err)
+		}
+
+		err = cfgBuilder.initializeTracing(ctx, am.Spec.AlertmanagerConfiguration.Tracing, am.Namespace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to initialize tracing: %w", err)
+		}
+
+		for _, v := range am.Spec.AlertmanagerConfiguration.Templates {
+			if v.ConfigMap != nil {
+				cfgBuilder.cfg.Templates = append(cfgBuilder.cfg.Templates, path.Join(alertmanagerTemplatesDir, v.ConfigMap.Key))
+			}
+			if v.Secret != nil {
+				cfgBuilder.cfg.Templates = append(cfgBuilder.cfg.Templates, path.Join(alertmanagerTemplatesDir, v.Secret.Key))
+			}
+		}
+	} else {
+		// Load the base configuration from the referenced secret.
+		var amRawConfiguration []byte
+
+		amRawConfiguration, additionalData, err = src.loadConfigurationFromSecret(ctx, am)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to retrieve configuration from secret: %w", err)
+		}
+
+		err = cfgBuilder.InitializeFromRawConfiguration(amRawConfiguration)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to initialize from secret: %w", err)
+		}
+	}
+
+	// The base configuration needs to be loaded first because
+	// AlertmanagerConfig objects can reference its time intervals and
+	// receivers.
+	amConfigs, err := src.selectAlertmanagerConfigs(ctx, am, version, store, cfgBuilder.shared)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to select AlertmanagerConfig objects: %w", err)
+	}
+
+	if err := cfgBuilder.AddAlertmanagerConfigs(ctx, amConfigs); err != nil {
+		return nil, nil, fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
+	}
+
+	generatedConfig, err := cfgBuilder.MarshalJSON()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+
+	return generatedConfig, additionalData, nil
+}
+
+// Resources holds the Kubernetes resources used to generate the
+// configuration of an Alertmanager object without access to the Kubernetes
+// API.
+type Resources struct {
+	// Client returns the Secrets and ConfigMaps referenced by the resources.
+	Client typedcorev1.CoreV1Interface
+	// Namespaces is the list of namespaces (used for the namespace
+	// selector and the OnLabel matcher strategy).
+	Namespaces []*corev1.Namespace
+	// AlertmanagerConfigs is the list of AlertmanagerConfig objects.
+	AlertmanagerConfigs []*monitoringv1alpha1.AlertmanagerConfig
+}
+
+// GenerateConfiguration returns the configuration that the operator would
+// generate for the Alertmanager object from the given resources.
+// AlertmanagerConfig objects which are invalid are skipped with a warning.
+func GenerateConfiguration(ctx context.Context, logger *slog.Logger, am *monitoringv1.Alertmanager, res Resources) ([]byte, error) {
+	store := assets.NewStoreBuilder(res.Client, res.Client)
+
+	cfg, _, err := generateConfiguration(ctx, logger, &resourcesSource{logger: logger, res: res}, am, store)
+	return cfg, err
+}
+
+// resourcesSource implements configSource from a static list of resources.
+type resourcesSource struct {
+	logger *slog.Logger
+	res    Resources
+}
+
+var _ configSource = &resourcesSource{}
+
+func (rs *resourcesSource) loadConfigurationFromSecret(ctx context.Context, am *monitoringv1.Alertmanager) ([]byte, map[string][]byte, error) {
+	return loadConfigurationFromSecret(ctx, rs.logger, rs.res.Client, am)
+}
+
+func (rs *resourcesSource) getGlobalAlertmanagerConfig(_ context.Context, am *monitoringv1.Alertmanager) (*monitoringv1alpha1.AlertmanagerConfig, error) {
+	for _, amc := range rs.res.AlertmanagerConfigs {
+		if amc.Namespace == am.Namespace && amc.Name == am.Spec.AlertmanagerConfiguration.Name {
+			return amc, nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(
+		schema.GroupResource{Group: monitoringv1alpha1.SchemeGroupVersion.Group, Resource: monitoringv1alpha1.AlertmanagerConfigName},
+		am.Spec.AlertmanagerConfiguration.Name,
+	)
+}
+
+func (rs *resourcesSource) selectAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.StoreBuilder, shared sharedConfig) (map[string]*monitoringv1alpha1.AlertmanagerConfig, error) {
+	namespaces := map[string]struct{}{}
+
+	// If 'AlertmanagerConfigNamespaceSelector' is nil, only check own namespace.
+	if am.Spec.AlertmanagerConfigNamespaceSelector == nil {
+		namespaces[am.Namespace] = struct{}{}
+	} else {
+		amConfigNSSelector, err := metav1.LabelSelectorAsSelector(am.Spec.AlertmanagerConfigNamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ns := range rs.res.Namespaces {
+			if amConfigNSSelector.Matches(labels.Set(ns.Labels)) {
+				namespaces[ns.Name] = struct{}{}
+			}
+		}
+	}
+
+	amConfigSelector, err := metav1.LabelSelectorAsSelector(am.Spec.AlertmanagerConfigSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*monitoringv1alpha1.AlertmanagerConfig)
+	for _, amc := range rs.res.AlertmanagerConfigs {
+		if _, found := namespaces[amc.Namespace]; !found {
+			continue
+		}
+
+		if !amConfigSelector.Matches(labels.Set(amc.Labels)) {
+			continue
+		}
+
+		if am.Spec.AlertmanagerConfiguration != nil && amc.Namespace == am.Namespace && amc.Name == am.Spec.AlertmanagerConfiguration.Name {
+			// Skip the global AlertmanagerConfig object.
+			continue
+		}
+
+		namespaceAndName := amc.Namespace + "/" + amc.Name
+		if err := checkSelectedAlertmanagerConfig(ctx, am, amc, amVersion, store, shared, rs.namespaceLabels(amc.Namespace)); err != nil {
+			rs.logger.Warn("skipping alertmanagerconfig", "error", err.Error(), "alertmanagerconfig", namespaceAndName)
+			continue
+		}
+
+		res[namespaceAndName] = amc
+	}
+
+	return res, nil
+}
+
+func (rs *resourcesSource) namespaceLabels(name string) map[string]string {
+	for _, ns := range rs.res.Namespaces {
+		if ns.Name == name {
+			return ns.Labels
+		}
+	}
+
+	return nil
+}
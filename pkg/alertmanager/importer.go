@@ -0,0 +1,1395 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"path"
+	"regexp"
+	"strings"
+
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+)
+
+var (
+	invalidNameChars      = regexp.MustCompile(`[^a-z0-9-]+`)
+	invalidSecretKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+)
+
+// ImportOptions defines the parameters of the conversion of an Alertmanager
+// configuration into custom resources.
+type ImportOptions struct {
+	// Namespace is the namespace of the generated objects. It should be the
+	// namespace of the Alertmanager object.
+	Namespace string
+	// Name is the name of the AlertmanagerConfig object holding the root
+	// route of the configuration.
+	Name string
+}
+
+// ImportResult holds the objects converted from an Alertmanager
+// configuration.
+type ImportResult struct {
+	// AlertmanagerConfiguration should be assigned to
+	// `spec.alertmanagerConfiguration` of the Alertmanager object.
+	AlertmanagerConfiguration *monitoringv1.AlertmanagerConfiguration
+	// AlertmanagerConfigs contains the AlertmanagerConfig object referenced
+	// by `spec.alertmanagerConfiguration.name` followed by one object per
+	// first-level route.
+	AlertmanagerConfigs []*monitoringv1beta1.AlertmanagerConfig
+	// Secrets holds the credentials extracted from the configuration.
+	Secrets []*corev1.Secret
+	// Warnings lists the parts of the configuration which couldn't be
+	// converted or whose behavior changes after the conversion.
+	Warnings []string
+}
+
+// ImportConfiguration converts a raw Alertmanager configuration into an
+// AlertmanagerConfig object for the root route and the global parts plus one
+// AlertmanagerConfig object per first-level route. Inline credentials are
+// moved to Secret objects.
+//
+// To keep the routing tree unchanged, the Alertmanager object should disable
+// the namespace matcher of the AlertmanagerConfig routes (e.g.
+// `spec.alertmanagerConfigMatcherStrategy.type: None`).
+func ImportConfiguration(b []byte, opts ImportOptions) (*ImportResult, error) {
+	if errs := validation.IsDNS1123Subdomain(opts.Name); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid name %q: %s", opts.Name, strings.Join(errs, ", "))
+	}
+
+	cfg, err := alertmanagerConfigFromBytes(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the configuration: %w", err)
+	}
+
+	im := &importer{
+		namespace: opts.Namespace,
+		seen:      map[string]struct{}{},
+	}
+
+	return im.convert(cfg, opts)
+}
+
+type importer struct {
+	namespace string
+
+	warnings []string
+	seen     map[string]struct{}
+}
+
+func (im *importer) warn(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	if _, found := im.seen[msg]; found {
+		return
+	}
+
+	im.seen[msg] = struct{}{}
+	im.warnings = append(im.warnings, msg)
+}
+
+// importedConfig is an AlertmanagerConfig object being built with the
+// Secret holding its credentials.
+type importedConfig struct {
+	amc    *monitoringv1beta1.AlertmanagerConfig
+	secret map[string]string
+}
+
+func (ic *importedConfig) secretRef(key, value string) *corev1.SecretKeySelector {
+	if value == "" {
+		return nil
+	}
+
+	key = strings.Trim(invalidSecretKeyChars.ReplaceAllString(key, "_"), "_")
+	ic.secret[key] = value
+
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: ic.amc.Name},
+		Key:                  key,
+	}
+}
+
+func (im *importer) newImportedConfig(name string) *importedConfig {
+	return &importedConfig{
+		amc: &monitoringv1beta1.AlertmanagerConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: monitoringv1beta1.SchemeGroupVersion.String(),
+				Kind:       monitoringv1beta1.AlertmanagerConfigKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: im.namespace,
+			},
+		},
+		secret: map[string]string{},
+	}
+}
+
+func (im *importer) convert(cfg *alertmanagerConfig, opts ImportOptions) (*ImportResult, error) {
+	root := im.newImportedConfig(opts.Name)
+	names := map[string]struct{}{opts.Name: {}}
+
+	// Assign each receiver to the AlertmanagerConfig objects using it.
+	// Receivers used by the root route or by several first-level routes
+	// stay in the root object and are shared.
+	owners := map[string]map[int]struct{}{}
+	addOwner := func(rcv string, i int) {
+		if rcv == "" {
+			return
+		}
+		if owners[rcv] == nil {
+			owners[rcv] = map[int]struct{}{}
+		}
+		owners[rcv][i] = struct{}{}
+	}
+	addOwner(cfg.Route.Receiver, -1)
+	for i, r := range cfg.Route.Routes {
+		walkRoutes(r, func(r *route) { addOwner(r.Receiver, i) })
+	}
+
+	receivers := map[string]*receiver{}
+	for _, r := range cfg.Receivers {
+		receivers[r.Name] = r
+	}
+
+	routeReceiver := func(name string) (int, bool) {
+		if len(owners[name]) != 1 {
+			return -1, false
+		}
+		for i := range owners[name] {
+			return i, i >= 0
+		}
+		return -1, false
+	}
+
+	rootRoute, err := im.convertRoute(&route{
+		Receiver:       cfg.Route.Receiver,
+		GroupByStr:     cfg.Route.GroupByStr,
+		GroupWait:      cfg.Route.GroupWait,
+		GroupInterval:  cfg.Route.GroupInterval,
+		RepeatInterval: cfg.Route.RepeatInterval,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("root route: %w", err)
+	}
+	root.amc.Spec.Route = rootRoute
+
+	configs := []*importedConfig{root}
+	for i, r := range cfg.Route.Routes {
+		ic := im.newImportedConfig(uniqueName(routeName(r, opts.Name, i), names))
+		ic.amc.Spec.Receivers = []monitoringv1beta1.Receiver{}
+
+		rt, err := im.convertRoute(r)
+		if err != nil {
+			return nil, fmt.Errorf("route[%d]: %w", i, err)
+		}
+		rt.Continue = false
+		ic.amc.Spec.Route = rt
+
+		if !r.Continue && i < len(cfg.Route.Routes)-1 {
+			im.warn("route[%d] (AlertmanagerConfig %q): the operator always sets 'continue: true' on the first-level routes of AlertmanagerConfig resources, alerts matching this route will also be evaluated against the next routes", i, ic.amc.Name)
+		}
+
+		for _, name := range sortutil.SortedKeys(owners) {
+			if j, ok := routeReceiver(name); !ok || j != i {
+				continue
+			}
+
+			rcv, found := receivers[name]
+			if !found {
+				return nil, fmt.Errorf("receiver %q not found", name)
+			}
+			ic.amc.Spec.Receivers = append(ic.amc.Spec.Receivers, im.convertReceiver(rcv, ic))
+		}
+
+		configs = append(configs, ic)
+	}
+
+	var shared []monitoringv1.SharedReceiver
+	for _, rcv := range cfg.Receivers {
+		if _, ok := routeReceiver(rcv.Name); ok {
+			continue
+		}
+
+		root.amc.Spec.Receivers = append(root.amc.Spec.Receivers, im.convertReceiver(rcv, root))
+
+		if len(owners[rcv.Name]) > 1 {
+			shared = append(shared, monitoringv1.SharedReceiver{Name: rcv.Name})
+		}
+	}
+
+	for _, ir := range cfg.InhibitRules {
+		rule, err := convertInhibitRuleForImport(ir)
+		if err != nil {
+			return nil, fmt.Errorf("inhibit rule: %w", err)
+		}
+		root.amc.Spec.InhibitRules = append(root.amc.Spec.InhibitRules, rule)
+	}
+
+	if len(cfg.MuteTimeIntervals) > 0 {
+		im.warn("mute_time_intervals are converted to time intervals")
+	}
+	for _, ti := range append(cfg.MuteTimeIntervals, cfg.TimeIntervals...) {
+		root.amc.Spec.TimeIntervals = append(root.amc.Spec.TimeIntervals, convertTimeIntervalForImport(ti))
+	}
+
+	if cfg.Tracing != nil {
+		im.warn("tracing: the configuration isn't converted, use spec.alertmanagerConfiguration.tracing instead")
+	}
+
+	res := &ImportResult{
+		AlertmanagerConfiguration: &monitoringv1.AlertmanagerConfiguration{
+			Name:            opts.Name,
+			Global:          im.convertGlobalConfig(cfg.Global, root),
+			Templates:       im.convertTemplates(cfg.Templates, opts.Name),
+			SharedReceivers: shared,
+		},
+	}
+
+	for _, ic := range configs {
+		res.AlertmanagerConfigs = append(res.AlertmanagerConfigs, ic.amc)
+
+		if len(ic.secret) == 0 {
+			continue
+		}
+
+		res.Secrets = append(res.Secrets, &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "Secret",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ic.amc.Name,
+				Namespace: im.namespace,
+			},
+			StringData: ic.secret,
+		})
+	}
+	res.Warnings = im.warnings
+
+	return res, nil
+}
+
+func walkRoutes(r *route, fn func(*route)) {
+	fn(r)
+	for _, child := range r.Routes {
+		walkRoutes(child, fn)
+	}
+}
+
+// routeName returns the name of the AlertmanagerConfig object for a
+// first-level route.
+func routeName(r *route, prefix string, i int) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(r.Receiver), "-"), "-")
+	if name == "" {
+		return fmt.Sprintf("%s-route-%d", prefix, i)
+	}
+
+	if len(name) > validation.DNS1123SubdomainMaxLength-4 {
+		name = strings.TrimRight(name[:validation.DNS1123SubdomainMaxLength-4], "-")
+	}
+
+	return name
+}
+
+func uniqueName(name string, names map[string]struct{}) string {
+	candidate := name
+	for i := 1; ; i++ {
+		if _, found := names[candidate]; !found {
+			names[candidate] = struct{}{}
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s-%d", name, i)
+	}
+}
+
+func (im *importer) convertRoute(in *route) (*monitoringv1beta1.Route, error) {
+	out := &monitoringv1beta1.Route{
+		Receiver:            in.Receiver,
+		GroupBy:             in.GroupByStr,
+		GroupWait:           (*monitoringv1.NonEmptyDuration)(stringOrNil(in.GroupWait)),
+		GroupInterval:       (*monitoringv1.NonEmptyDuration)(stringOrNil(in.GroupInterval)),
+		RepeatInterval:      (*monitoringv1.NonEmptyDuration)(stringOrNil(in.RepeatInterval)),
+		Continue:            in.Continue,
+		MuteTimeIntervals:   in.MuteTimeIntervals,
+		ActiveTimeIntervals: in.ActiveTimeIntervals,
+	}
+
+	matchers, err := convertMatchersForImport(in.Match, in.MatchRE, in.Matchers)
+	if err != nil {
+		return nil, err
+	}
+	out.Matchers = matchers
+
+	for i, child := range in.Routes {
+		r, err := im.convertRoute(child)
+		if err != nil {
+			return nil, fmt.Errorf("route[%d]: %w", i, err)
+		}
+
+		b, err := json.Marshal(r)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Routes = append(out.Routes, apiextensionsv1.JSON{Raw: b})
+	}
+
+	return out, nil
+}
+
+func convertMatchersForImport(match, matchRE map[string]string, matchers []string) ([]monitoringv1beta1.Matcher, error) {
+	var out []monitoringv1beta1.Matcher
+
+	for _, k := range sortutil.SortedKeys(match) {
+		out = append(out, monitoringv1beta1.Matcher{Name: k, Value: match[k], MatchType: monitoringv1beta1.MatchEqual})
+	}
+
+	for _, k := range sortutil.SortedKeys(matchRE) {
+		out = append(out, monitoringv1beta1.Matcher{Name: k, Value: matchRE[k], MatchType: monitoringv1beta1.MatchRegexp})
+	}
+
+	for _, s := range matchers {
+		m, err := amlabels.ParseMatcher(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid matcher %q: %w", s, err)
+		}
+
+		out = append(out, monitoringv1beta1.Matcher{Name: m.Name, Value: m.Value, MatchType: monitoringv1beta1.MatchType(m.Type.String())})
+	}
+
+	return out, nil
+}
+
+func convertInhibitRuleForImport(in *inhibitRule) (monitoringv1beta1.InhibitRule, error) {
+	target, err := convertMatchersForImport(in.TargetMatch, in.TargetMatchRE, in.TargetMatchers)
+	if err != nil {
+		return monitoringv1beta1.InhibitRule{}, err
+	}
+
+	source, err := convertMatchersForImport(in.SourceMatch, in.SourceMatchRE, in.SourceMatchers)
+	if err != nil {
+		return monitoringv1beta1.InhibitRule{}, err
+	}
+
+	return monitoringv1beta1.InhibitRule{
+		TargetMatch: target,
+		SourceMatch: source,
+		Equal:       in.Equal,
+	}, nil
+}
+
+func convertTimeIntervalForImport(in *timeInterval) monitoringv1beta1.TimeInterval {
+	out := monitoringv1beta1.TimeInterval{Name: in.Name}
+
+	for _, ti := range in.TimeIntervals {
+		var tp monitoringv1beta1.TimePeriod
+
+		for _, t := range ti.Times {
+			tp.Times = append(tp.Times, monitoringv1beta1.TimeRange{
+				StartTime: monitoringv1beta1.Time(fmt.Sprintf("%02d:%02d", t.StartMinute/60, t.StartMinute%60)),
+				EndTime:   monitoringv1beta1.Time(fmt.Sprintf("%02d:%02d", t.EndMinute/60, t.EndMinute%60)),
+			})
+		}
+
+		for _, wd := range ti.Weekdays {
+			s, _ := wd.MarshalText()
+			tp.Weekdays = append(tp.Weekdays, monitoringv1beta1.WeekdayRange(s))
+		}
+
+		for _, dom := range ti.DaysOfMonth {
+			tp.DaysOfMonth = append(tp.DaysOfMonth, monitoringv1beta1.DayOfMonthRange{Start: dom.Begin, End: dom.End})
+		}
+
+		for _, m := range ti.Months {
+			s, _ := m.MarshalText()
+			tp.Months = append(tp.Months, monitoringv1beta1.MonthRange(s))
+		}
+
+		for _, y := range ti.Years {
+			s, _ := y.MarshalText()
+			tp.Years = append(tp.Years, monitoringv1beta1.YearRange(s))
+		}
+
+		if ti.Location != nil && ti.Location.Location != nil {
+			tp.Location = ptr.To(ti.Location.String())
+		}
+
+		out.TimeIntervals = append(out.TimeIntervals, tp)
+	}
+
+	return out
+}
+
+func (im *importer) convertTemplates(templates []string, name string) []monitoringv1.SecretOrConfigMap {
+	var out []monitoringv1.SecretOrConfigMap
+	cmName := name + "-templates"
+
+	for _, t := range templates {
+		if strings.ContainsAny(path.Base(t), "*?[") {
+			im.warn("templates: %q is a glob pattern, store the matching files in the %q ConfigMap and reference them in spec.alertmanagerConfiguration.templates", t, cmName)
+			continue
+		}
+
+		out = append(out, monitoringv1.SecretOrConfigMap{
+			ConfigMap: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: cmName},
+				Key:                  path.Base(t),
+			},
+		})
+	}
+
+	if len(out) > 0 {
+		im.warn("templates: create the %q ConfigMap from the template files", cmName)
+	}
+
+	return out
+}
+
+func (im *importer) convertGlobalConfig(in *globalConfig, ic *importedConfig) *monitoringv1.AlertmanagerGlobalConfig {
+	if in == nil {
+		return nil
+	}
+
+	f := &fieldConverter{im: im, ic: ic, path: "global", key: "global"}
+	out := &monitoringv1.AlertmanagerGlobalConfig{}
+
+	if in.ResolveTimeout != nil {
+		out.ResolveTimeout = monitoringv1.Duration(in.ResolveTimeout.String())
+	}
+
+	if hc := f.httpConfig(in.HTTPConfig); hc != nil {
+		out.HTTPConfigWithProxy = &monitoringv1.HTTPConfigWithProxy{
+			HTTPConfig: monitoringv1.HTTPConfig{
+				HTTPConfigWithoutTLS: monitoringv1.HTTPConfigWithoutTLS{
+					Authorization:   hc.Authorization,
+					BasicAuth:       hc.BasicAuth,
+					OAuth2:          hc.OAuth2,
+					FollowRedirects: hc.FollowRedirects,
+					EnableHTTP2:     hc.EnableHTTP2,
+				},
+				TLSConfig: hc.TLSConfig,
+			},
+			ProxyConfig: hc.ProxyConfig,
+		}
+	}
+
+	if in.SMTPFrom != "" || in.SMTPSmarthost.Host != "" || in.SMTPHello != "" || in.SMTPAuthUsername != "" || in.SMTPAuthPassword != "" || in.SMTPAuthSecret != "" || in.SMTPAuthIdentity != "" || in.SMTPRequireTLS != nil || in.SMTPTLSConfig != nil || in.SMTPForceImplicitTLS != nil {
+		out.SMTPConfig = &monitoringv1.GlobalSMTPConfig{
+			From:             stringOrNil(in.SMTPFrom),
+			Hello:            stringOrNil(in.SMTPHello),
+			AuthUsername:     stringOrNil(in.SMTPAuthUsername),
+			AuthPassword:     f.secret("smtp_auth_password", in.SMTPAuthPassword),
+			AuthSecret:       f.secret("smtp_auth_secret", in.SMTPAuthSecret),
+			AuthIdentity:     stringOrNil(in.SMTPAuthIdentity),
+			RequireTLS:       in.SMTPRequireTLS,
+			TLSConfig:        f.tlsConfig("smtp_tls_config", in.SMTPTLSConfig),
+			ForceImplicitTLS: in.SMTPForceImplicitTLS,
+		}
+		if in.SMTPSmarthost.Host != "" {
+			out.SMTPConfig.SmartHost = &monitoringv1.HostPort{Host: in.SMTPSmarthost.Host, Port: in.SMTPSmarthost.Port}
+		}
+	}
+	f.file("smtp_auth_password_file", in.SMTPAuthPasswordFile)
+	f.file("smtp_auth_secret_file", in.SMTPAuthSecretFile)
+
+	if in.SlackAPIURL != nil {
+		out.SlackAPIURL = f.secret("slack_api_url", in.SlackAPIURL.String())
+	}
+	f.file("slack_api_url_file", in.SlackAPIURLFile)
+	f.unsupported("slack_app_token", in.SlackAppToken != "" || in.SlackAppTokenFile != "")
+	f.unsupported("slack_app_url", in.SlackAppURL != nil)
+
+	if in.PagerdutyURL != nil {
+		out.PagerdutyURL = ptr.To(monitoringv1.URL(in.PagerdutyURL.String()))
+	}
+
+	if in.OpsGenieAPIURL != nil {
+		out.OpsGenieAPIURL = f.secret("opsgenie_api_url", in.OpsGenieAPIURL.String())
+	}
+	out.OpsGenieAPIKey = f.secret("opsgenie_api_key", in.OpsGenieAPIKey)
+	f.file("opsgenie_api_key_file", in.OpsGenieAPIKeyFile)
+
+	if in.TelegramAPIURL != nil {
+		out.TelegramConfig = &monitoringv1.GlobalTelegramConfig{APIURL: ptr.To(monitoringv1.URL(in.TelegramAPIURL.String()))}
+	}
+	f.unsupported("telegram_bot_token", in.TelegramBotToken != "" || in.TelegramBotTokenFile != "")
+
+	if in.JiraAPIURL != nil {
+		out.JiraConfig = &monitoringv1.GlobalJiraConfig{APIURL: ptr.To(monitoringv1.URL(in.JiraAPIURL.String()))}
+	}
+
+	if in.WebexAPIURL != nil {
+		out.WebexConfig = &monitoringv1.GlobalWebexConfig{APIURL: ptr.To(monitoringv1.URL(in.WebexAPIURL.String()))}
+	}
+
+	if in.VictorOpsAPIURL != nil || in.VictorOpsAPIKey != "" {
+		out.VictorOpsConfig = &monitoringv1.GlobalVictorOpsConfig{
+			APIKey: f.secret("victorops_api_key", in.VictorOpsAPIKey),
+		}
+		if in.VictorOpsAPIURL != nil {
+			out.VictorOpsConfig.APIURL = ptr.To(monitoringv1.URL(in.VictorOpsAPIURL.String()))
+		}
+	}
+	f.file("victorops_api_key_file", in.VictorOpsAPIKeyFile)
+
+	if in.RocketChatAPIURL != nil || in.RocketChatToken != "" || in.RocketChatTokenID != "" {
+		out.RocketChatConfig = &monitoringv1.GlobalRocketChatConfig{
+			Token:   f.secret("rocketchat_token", in.RocketChatToken),
+			TokenID: f.secret("rocketchat_token_id", in.RocketChatTokenID),
+		}
+		if in.RocketChatAPIURL != nil {
+			out.RocketChatConfig.APIURL = ptr.To(monitoringv1.URL(in.RocketChatAPIURL.String()))
+		}
+	}
+	f.file("rocketchat_token_file", in.RocketChatTokenFile)
+	f.file("rocketchat_token_id_file", in.RocketChatTokenIDFile)
+
+	if in.WeChatAPIURL != nil || in.WeChatAPISecret != "" || in.WeChatAPICorpID != "" {
+		out.WeChatConfig = &monitoringv1.GlobalWeChatConfig{
+			APISecret: f.secret("wechat_api_secret", in.WeChatAPISecret),
+			APICorpID: stringOrNil(in.WeChatAPICorpID),
+		}
+		if in.WeChatAPIURL != nil {
+			out.WeChatConfig.APIURL = ptr.To(monitoringv1.URL(in.WeChatAPIURL.String()))
+		}
+	}
+	f.file("wechat_api_secret_file", in.WeChatAPISecretFile)
+
+	if in.MattermostWebhookURL != nil {
+		out.MattermostConfig = &monitoringv1.GlobalMattermostConfig{
+			WebhookURL: f.secret("mattermost_webhook_url", in.MattermostWebhookURL.String()),
+		}
+	}
+	f.file("mattermost_webhook_url_file", in.MattermostWebhookURLFile)
+
+	f.unsupported("hipchat_api_url", in.HipchatAPIURL != nil)
+	f.unsupported("hipchat_auth_token", in.HipchatAuthToken != "")
+
+	return out
+}
+
+// fieldConverter converts the fields of a configuration block. The
+// credentials are stored in the Secret of the AlertmanagerConfig object
+// under keys prefixed by key.
+type fieldConverter struct {
+	im   *importer
+	ic   *importedConfig
+	path string
+	key  string
+}
+
+func (f *fieldConverter) secret(field, value string) *corev1.SecretKeySelector {
+	return f.ic.secretRef(f.key+"-"+field, value)
+}
+
+func (f *fieldConverter) secretV1beta1(field, value string) *monitoringv1beta1.SecretKeySelector {
+	s := f.secret(field, value)
+	if s == nil {
+		return nil
+	}
+
+	return &monitoringv1beta1.SecretKeySelector{Name: s.Name, Key: s.Key}
+}
+
+func (f *fieldConverter) file(field, value string) {
+	if value == "" {
+		return
+	}
+
+	f.im.warn("%s: %q references the %q file which can't be converted, store its content in a Secret and update the AlertmanagerConfig resource", f.path, field, value)
+}
+
+func (f *fieldConverter) unsupported(field string, set bool) {
+	if !set {
+		return
+	}
+
+	f.im.warn("%s: %q isn't supported by the AlertmanagerConfig CRD and has been dropped", f.path, field)
+}
+
+func (f *fieldConverter) tlsConfig(field string, in *tlsConfig) *monitoringv1.SafeTLSConfig {
+	if in == nil {
+		return nil
+	}
+
+	f.file(field+".ca_file", in.CAFile)
+	f.file(field+".cert_file", in.CertFile)
+	f.file(field+".key_file", in.KeyFile)
+
+	out := &monitoringv1.SafeTLSConfig{
+		ServerName: stringOrNil(in.ServerName),
+	}
+
+	if in.InsecureSkipVerify {
+		out.InsecureSkipVerify = ptr.To(true)
+	}
+
+	if in.MinVersion != "" {
+		out.MinVersion = ptr.To(monitoringv1.TLSVersion(in.MinVersion))
+	}
+
+	if in.MaxVersion != "" {
+		out.MaxVersion = ptr.To(monitoringv1.TLSVersion(in.MaxVersion))
+	}
+
+	return out
+}
+
+func (f *fieldConverter) proxyConfig(field string, in proxyConfig) monitoringv1.ProxyConfig {
+	out := monitoringv1.ProxyConfig{
+		ProxyURL: stringOrNil(in.ProxyURL),
+		NoProxy:  stringOrNil(in.NoProxy),
+	}
+
+	if in.ProxyFromEnvironment {
+		out.ProxyFromEnvironment = ptr.To(true)
+	}
+
+	for _, k := range sortutil.SortedKeys(in.ProxyConnectHeader) {
+		if out.ProxyConnectHeader == nil {
+			out.ProxyConnectHeader = map[string][]corev1.SecretKeySelector{}
+		}
+
+		for i, v := range in.ProxyConnectHeader[k] {
+			out.ProxyConnectHeader[k] = append(out.ProxyConnectHeader[k], *f.secret(fmt.Sprintf("%sproxy_connect_header-%s-%d", field, k, i), v))
+		}
+	}
+
+	return out
+}
+
+func (f *fieldConverter) httpConfig(in *httpClientConfig) *monitoringv1beta1.HTTPConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := &monitoringv1beta1.HTTPConfig{
+		TLSConfig:       f.tlsConfig("http_config.tls_config", in.TLSConfig),
+		FollowRedirects: in.FollowRedirects,
+		EnableHTTP2:     in.EnableHTTP2,
+		ProxyConfig:     f.proxyConfig("http_config.", in.proxyConfig),
+	}
+
+	if a := in.Authorization; a != nil {
+		f.file("http_config.authorization.credentials_file", a.CredentialsFile)
+		out.Authorization = &monitoringv1.SafeAuthorization{
+			Type:        a.Type,
+			Credentials: f.secret("http_config.authorization.credentials", a.Credentials),
+		}
+	}
+
+	if in.BearerToken != "" {
+		out.Authorization = &monitoringv1.SafeAuthorization{
+			Type:        "Bearer",
+			Credentials: f.secret("http_config.bearer_token", in.BearerToken),
+		}
+	}
+	f.file("http_config.bearer_token_file", in.BearerTokenFile)
+
+	if ba := in.BasicAuth; ba != nil {
+		f.file("http_config.basic_auth.password_file", ba.PasswordFile)
+		out.BasicAuth = &monitoringv1.BasicAuth{}
+		if s := f.secret("http_config.basic_auth.username", ba.Username); s != nil {
+			out.BasicAuth.Username = *s
+		}
+		if s := f.secret("http_config.basic_auth.password", ba.Password); s != nil {
+			out.BasicAuth.Password = *s
+		}
+	}
+
+	if o := in.OAuth2; o != nil {
+		f.file("http_config.oauth2.client_secret_file", o.ClientSecretFile)
+		out.OAuth2 = &monitoringv1.OAuth2{
+			ClientID:       monitoringv1.SecretOrConfigMap{Secret: f.secret("http_config.oauth2.client_id", o.ClientID)},
+			TokenURL:       o.TokenURL,
+			Scopes:         o.Scopes,
+			EndpointParams: o.EndpointParams,
+			TLSConfig:      f.tlsConfig("http_config.oauth2.tls_config", o.TLSConfig),
+			ProxyConfig:    f.proxyConfig("http_config.oauth2.", o.proxyConfig),
+		}
+		if s := f.secret("http_config.oauth2.client_secret", o.ClientSecret); s != nil {
+			out.OAuth2.ClientSecret = *s
+		}
+	}
+
+	f.unsupported("http_config.http_headers", in.HTTPHeaders != nil)
+
+	return out
+}
+
+func (im *importer) convertReceiver(in *receiver, ic *importedConfig) monitoringv1beta1.Receiver {
+	out := monitoringv1beta1.Receiver{Name: in.Name}
+
+	field := func(integration string, i int) *fieldConverter {
+		return &fieldConverter{
+			im:   im,
+			ic:   ic,
+			path: fmt.Sprintf("receiver %q: %s[%d]", in.Name, integration, i),
+			key:  fmt.Sprintf("%s-%s-%d", in.Name, strings.TrimSuffix(integration, "_configs"), i),
+		}
+	}
+
+	for i, c := range in.WebhookConfigs {
+		f := field("webhook_configs", i)
+		f.file("url_file", c.URLFile)
+		f.unsupported("payload", len(c.Payload) > 0)
+		out.WebhookConfigs = append(out.WebhookConfigs, monitoringv1beta1.WebhookConfig{
+			SendResolved: c.VSendResolved,
+			URLSecret:    f.secretV1beta1("url", c.URL),
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+			MaxAlerts:    c.MaxAlerts,
+			Timeout:      durationOrNil(c.Timeout),
+		})
+	}
+
+	for i, c := range in.SlackConfigs {
+		f := field("slack_configs", i)
+		f.file("api_url_file", c.APIURLFile)
+		f.unsupported("app_token", c.AppToken != "" || c.AppTokenFile != "")
+		f.unsupported("app_url", c.AppURL != "")
+		f.unsupported("update_message", c.UpdateMessage != nil)
+
+		sc := monitoringv1beta1.SlackConfig{
+			SendResolved: c.VSendResolved,
+			APIURL:       f.secretV1beta1("api_url", c.APIURL),
+			Channel:      stringOrNil(c.Channel),
+			Username:     stringOrNil(c.Username),
+			Color:        stringOrNil(c.Color),
+			Title:        stringOrNil(c.Title),
+			TitleLink:    c.TitleLink,
+			Pretext:      stringOrNil(c.Pretext),
+			Text:         stringOrNil(c.Text),
+			ShortFields:  boolOrNil(c.ShortFields),
+			Footer:       stringOrNil(c.Footer),
+			Fallback:     stringOrNil(c.Fallback),
+			CallbackID:   stringOrNil(c.CallbackID),
+			IconEmoji:    stringOrNil(c.IconEmoji),
+			IconURL:      c.IconURL,
+			ImageURL:     c.ImageURL,
+			ThumbURL:     c.ThumbURL,
+			LinkNames:    boolOrNil(c.LinkNames),
+			MrkdwnIn:     c.MrkdwnIn,
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+			Timeout:      durationOrNil(c.Timeout),
+			MessageText:  stringOrNil(c.MessageText),
+		}
+
+		for _, sf := range c.Fields {
+			sc.Fields = append(sc.Fields, monitoringv1beta1.SlackField{
+				Title: sf.Title,
+				Value: sf.Value,
+				Short: boolOrNil(sf.Short),
+			})
+		}
+
+		for _, a := range c.Actions {
+			action := monitoringv1beta1.SlackAction{
+				Type:  a.Type,
+				Text:  a.Text,
+				URL:   a.URL,
+				Style: stringOrNil(a.Style),
+				Name:  stringOrNil(a.Name),
+				Value: stringOrNil(a.Value),
+			}
+			if a.ConfirmField != nil {
+				action.ConfirmField = &monitoringv1beta1.SlackConfirmationField{
+					Text:        a.ConfirmField.Text,
+					Title:       stringOrNil(a.ConfirmField.Title),
+					OkText:      stringOrNil(a.ConfirmField.OkText),
+					DismissText: stringOrNil(a.ConfirmField.DismissText),
+				}
+			}
+			sc.Actions = append(sc.Actions, action)
+		}
+
+		out.SlackConfigs = append(out.SlackConfigs, sc)
+	}
+
+	for i, c := range in.PagerdutyConfigs {
+		f := field("pagerduty_configs", i)
+		f.file("service_key_file", c.ServiceKeyFile)
+		f.file("routing_key_file", c.RoutingKeyFile)
+
+		pc := monitoringv1beta1.PagerDutyConfig{
+			SendResolved: c.VSendResolved,
+			RoutingKey:   f.secretV1beta1("routing_key", c.RoutingKey),
+			ServiceKey:   f.secretV1beta1("service_key", c.ServiceKey),
+			Client:       stringOrNil(c.Client),
+			ClientURL:    stringOrNil(c.ClientURL),
+			Description:  stringOrNil(c.Description),
+			Severity:     stringOrNil(c.Severity),
+			Class:        stringOrNil(c.Class),
+			Group:        stringOrNil(c.Group),
+			Component:    stringOrNil(c.Component),
+			Source:       stringOrNil(c.Source),
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+			Timeout:      durationOrNil(c.Timeout),
+		}
+
+		if c.URL != "" {
+			pc.URL = ptr.To(monitoringv1beta1.URL(c.URL))
+		}
+
+		for _, k := range sortutil.SortedKeys(c.Details) {
+			v, ok := c.Details[k].(string)
+			if !ok {
+				f.im.warn("%s: the value of the %q detail isn't a string and has been dropped", f.path, k)
+				continue
+			}
+			pc.Details = append(pc.Details, monitoringv1beta1.KeyValue{Key: k, Value: v})
+		}
+
+		for _, img := range c.Images {
+			pc.PagerDutyImageConfigs = append(pc.PagerDutyImageConfigs, monitoringv1beta1.PagerDutyImageConfig{
+				Src:  stringOrNil(img.Src),
+				Href: stringOrNil(img.Href),
+				Alt:  stringOrNil(img.Alt),
+			})
+		}
+
+		for _, l := range c.Links {
+			pc.PagerDutyLinkConfigs = append(pc.PagerDutyLinkConfigs, monitoringv1beta1.PagerDutyLinkConfig{
+				Href: stringOrNil(l.Href),
+				Text: stringOrNil(l.Text),
+			})
+		}
+
+		out.PagerDutyConfigs = append(out.PagerDutyConfigs, pc)
+	}
+
+	for i, c := range in.OpsgenieConfigs {
+		f := field("opsgenie_configs", i)
+		f.file("api_key_file", c.APIKeyFile)
+		f.unsupported("update_alerts", c.UpdateAlerts != nil)
+
+		oc := monitoringv1beta1.OpsGenieConfig{
+			SendResolved: c.VSendResolved,
+			APIKey:       f.secretV1beta1("api_key", c.APIKey),
+			Message:      stringOrNil(c.Message),
+			Description:  stringOrNil(c.Description),
+			Source:       stringOrNil(c.Source),
+			Tags:         stringOrNil(c.Tags),
+			Note:         stringOrNil(c.Note),
+			Priority:     stringOrNil(c.Priority),
+			Entity:       stringOrNil(c.Entity),
+			Actions:      stringOrNil(c.Actions),
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+		}
+
+		if c.APIURL != "" {
+			oc.APIURL = ptr.To(monitoringv1beta1.URL(c.APIURL))
+		}
+
+		for _, k := range sortutil.SortedKeys(c.Details) {
+			oc.Details = append(oc.Details, monitoringv1beta1.KeyValue{Key: k, Value: c.Details[k]})
+		}
+
+		for _, r := range c.Responders {
+			oc.Responders = append(oc.Responders, monitoringv1beta1.OpsGenieConfigResponder{
+				ID:       stringOrNil(r.ID),
+				Name:     stringOrNil(r.Name),
+				Username: stringOrNil(r.Username),
+				Type:     r.Type,
+			})
+		}
+
+		out.OpsGenieConfigs = append(out.OpsGenieConfigs, oc)
+	}
+
+	for i, c := range in.EmailConfigs {
+		f := field("email_configs", i)
+		f.file("auth_password_file", c.AuthPasswordFile)
+		f.file("auth_secret_file", c.AuthSecretFile)
+
+		ec := monitoringv1beta1.EmailConfig{
+			SendResolved:     c.VSendResolved,
+			To:               stringOrNil(c.To),
+			From:             stringOrNil(c.From),
+			Hello:            stringOrNil(c.Hello),
+			AuthUsername:     stringOrNil(c.AuthUsername),
+			AuthPassword:     f.secretV1beta1("auth_password", c.AuthPassword),
+			AuthSecret:       f.secretV1beta1("auth_secret", c.AuthSecret),
+			AuthIdentity:     stringOrNil(c.AuthIdentity),
+			HTML:             c.HTML,
+			Text:             c.Text,
+			RequireTLS:       c.RequireTLS,
+			TLSConfig:        f.tlsConfig("tls_config", c.TLSConfig),
+			ForceImplicitTLS: c.ForceImplicitTLS,
+		}
+
+		if c.Smarthost.Host != "" {
+			ec.Smarthost = ptr.To(net.JoinHostPort(c.Smarthost.Host, c.Smarthost.Port))
+		}
+
+		for _, k := range sortutil.SortedKeys(c.Headers) {
+			ec.Headers = append(ec.Headers, monitoringv1beta1.KeyValue{Key: k, Value: c.Headers[k]})
+		}
+
+		if t := c.Threading; t != nil && ptr.Deref(t.Enabled, false) {
+			ec.Threading = &monitoringv1beta1.EmailThreadingConfig{}
+			switch t.ThreadByDate {
+			case "daily":
+				ec.Threading.ThreadByDate = monitoringv1beta1.ThreadByDateTypeDaily
+			case "none":
+				ec.Threading.ThreadByDate = monitoringv1beta1.ThreadByDateTypeNone
+			}
+		}
+
+		out.EmailConfigs = append(out.EmailConfigs, ec)
+	}
+
+	for i, c := range in.PushoverConfigs {
+		f := field("pushover_configs", i)
+
+		pc := monitoringv1beta1.PushoverConfig{
+			SendResolved: c.VSendResolved,
+			UserKey:      f.secretV1beta1("user_key", c.UserKey),
+			UserKeyFile:  stringOrNil(c.UserKeyFile),
+			Token:        f.secretV1beta1("token", c.Token),
+			TokenFile:    stringOrNil(c.TokenFile),
+			Title:        stringOrNil(c.Title),
+			Message:      stringOrNil(c.Message),
+			URL:          c.URL,
+			URLTitle:     stringOrNil(c.URLTitle),
+			Device:       stringOrNil(c.Device),
+			Sound:        stringOrNil(c.Sound),
+			Priority:     stringOrNil(c.Priority),
+			HTML:         c.HTML,
+			Monospace:    c.Monospace,
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+		}
+
+		if c.TTL != "" {
+			pc.TTL = ptr.To(monitoringv1.Duration(c.TTL))
+		}
+
+		if c.Retry != nil {
+			pc.Retry = ptr.To(c.Retry.String())
+		}
+
+		if c.Expire != nil {
+			pc.Expire = ptr.To(c.Expire.String())
+		}
+
+		if c.UserKeyFile != "" || c.TokenFile != "" {
+			f.im.warn("%s: the files referenced by 'user_key_file' and 'token_file' must be mounted in the Alertmanager pods", f.path)
+		}
+
+		out.PushoverConfigs = append(out.PushoverConfigs, pc)
+	}
+
+	for i, c := range in.VictorOpsConfigs {
+		f := field("victorops_configs", i)
+		f.file("api_key_file", c.APIKeyFile)
+
+		vc := monitoringv1beta1.VictorOpsConfig{
+			SendResolved:      c.VSendResolved,
+			APIKey:            f.secretV1beta1("api_key", c.APIKey),
+			RoutingKey:        c.RoutingKey,
+			MessageType:       stringOrNil(c.MessageType),
+			EntityDisplayName: stringOrNil(c.EntityDisplayName),
+			StateMessage:      stringOrNil(c.StateMessage),
+			MonitoringTool:    stringOrNil(c.MonitoringTool),
+			HTTPConfig:        f.httpConfig(c.HTTPConfig),
+		}
+
+		if c.APIURL != "" {
+			vc.APIURL = ptr.To(monitoringv1beta1.URL(c.APIURL))
+		}
+
+		for _, k := range sortutil.SortedKeys(c.CustomFields) {
+			vc.CustomFields = append(vc.CustomFields, monitoringv1beta1.KeyValue{Key: k, Value: c.CustomFields[k]})
+		}
+
+		out.VictorOpsConfigs = append(out.VictorOpsConfigs, vc)
+	}
+
+	for i, c := range in.WeChatConfigs {
+		f := field("wechat_configs", i)
+		f.file("api_secret_file", c.APISecretFile)
+
+		wc := monitoringv1beta1.WeChatConfig{
+			SendResolved: c.VSendResolved,
+			APISecret:    f.secretV1beta1("api_secret", c.APISecret),
+			CorpID:       stringOrNil(c.CorpID),
+			AgentID:      stringOrNil(c.AgentID),
+			ToUser:       stringOrNil(c.ToUser),
+			ToParty:      stringOrNil(c.ToParty),
+			ToTag:        stringOrNil(c.ToTag),
+			Message:      stringOrNil(c.Message),
+			MessageType:  stringOrNil(c.MessageType),
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+		}
+
+		if c.APIURL != "" {
+			wc.APIURL = ptr.To(monitoringv1beta1.URL(c.APIURL))
+		}
+
+		out.WeChatConfigs = append(out.WeChatConfigs, wc)
+	}
+
+	for i, c := range in.SNSConfigs {
+		f := field("sns_configs", i)
+
+		out.SNSConfigs = append(out.SNSConfigs, monitoringv1beta1.SNSConfig{
+			SendResolved: c.VSendResolved,
+			ApiURL:       stringOrNil(c.APIUrl),
+			Sigv4: &monitoringv1.Sigv4{
+				Region:     c.Sigv4.Region,
+				AccessKey:  f.secret("sigv4.access_key", c.Sigv4.AccessKey),
+				SecretKey:  f.secret("sigv4.secret_key", c.Sigv4.SecretKey),
+				Profile:    c.Sigv4.Profile,
+				RoleArn:    c.Sigv4.RoleARN,
+				ExternalID: c.Sigv4.ExternalID,
+			},
+			TopicARN:    stringOrNil(c.TopicARN),
+			Subject:     stringOrNil(c.Subject),
+			PhoneNumber: stringOrNil(c.PhoneNumber),
+			TargetARN:   stringOrNil(c.TargetARN),
+			Message:     stringOrNil(c.Message),
+			Attributes:  c.Attributes,
+			HTTPConfig:  f.httpConfig(c.HTTPConfig),
+		})
+	}
+
+	for i, c := range in.TelegramConfigs {
+		f := field("telegram_configs", i)
+		f.file("chat_id_file", c.ChatIDFile)
+
+		tc := monitoringv1beta1.TelegramConfig{
+			SendResolved:         c.VSendResolved,
+			BotToken:             f.secretV1beta1("bot_token", c.BotToken),
+			BotTokenFile:         stringOrNil(c.BotTokenFile),
+			ChatID:               c.ChatID,
+			Message:              c.Message,
+			DisableNotifications: boolOrNil(c.DisableNotifications),
+			ParseMode:            c.ParseMode,
+			HTTPConfig:           f.httpConfig(c.HTTPConfig),
+		}
+
+		if c.APIUrl != "" {
+			tc.APIURL = ptr.To(monitoringv1beta1.URL(c.APIUrl))
+		}
+
+		if c.MessageThreadID != 0 {
+			tc.MessageThreadID = ptr.To(int64(c.MessageThreadID))
+		}
+
+		if c.BotTokenFile != "" {
+			f.im.warn("%s: the file referenced by 'bot_token_file' must be mounted in the Alertmanager pods", f.path)
+		}
+
+		out.TelegramConfigs = append(out.TelegramConfigs, tc)
+	}
+
+	for i, c := range in.DiscordConfigs {
+		f := field("discord_configs", i)
+
+		dc := monitoringv1beta1.DiscordConfig{
+			SendResolved: c.VSendResolved,
+			Title:        stringOrNil(c.Title),
+			Message:      stringOrNil(c.Message),
+			Content:      stringOrNil(c.Content),
+			Username:     stringOrNil(c.Username),
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+		}
+
+		if s := f.secret("webhook_url", c.WebhookURL); s != nil {
+			dc.APIURL = *s
+		}
+
+		if c.AvatarURL != "" {
+			dc.AvatarURL = ptr.To(monitoringv1beta1.URL(c.AvatarURL))
+		}
+
+		out.DiscordConfigs = append(out.DiscordConfigs, dc)
+	}
+
+	for i, c := range in.WebexConfigs {
+		f := field("webex_configs", i)
+
+		wc := monitoringv1beta1.WebexConfig{
+			SendResolved: c.VSendResolved,
+			Message:      stringOrNil(c.Message),
+			RoomID:       c.RoomID,
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+		}
+
+		if c.APIURL != "" {
+			wc.APIURL = ptr.To(monitoringv1beta1.URL(c.APIURL))
+		}
+
+		out.WebexConfigs = append(out.WebexConfigs, wc)
+	}
+
+	for i, c := range in.MSTeamsConfigs {
+		f := field("msteams_configs", i)
+
+		mc := monitoringv1beta1.MSTeamsConfig{
+			SendResolved: c.SendResolved,
+			Title:        stringOrNil(c.Title),
+			Summary:      stringOrNil(c.Summary),
+			Text:         stringOrNil(c.Text),
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+		}
+
+		if s := f.secret("webhook_url", c.WebhookURL); s != nil {
+			mc.WebhookURL = *s
+		}
+
+		out.MSTeamsConfigs = append(out.MSTeamsConfigs, mc)
+	}
+
+	for i, c := range in.MSTeamsV2Configs {
+		f := field("msteamsv2_configs", i)
+		f.file("webhook_url_file", c.WebhookURLFile)
+
+		out.MSTeamsV2Configs = append(out.MSTeamsV2Configs, monitoringv1beta1.MSTeamsV2Config{
+			SendResolved: c.SendResolved,
+			WebhookURL:   f.secret("webhook_url", c.WebhookURL),
+			Title:        stringOrNil(c.Title),
+			Text:         stringOrNil(c.Text),
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+		})
+	}
+
+	for i, c := range in.JiraConfigs {
+		f := field("jira_configs", i)
+
+		jc := monitoringv1beta1.JiraConfig{
+			SendResolved:      c.SendResolved,
+			APIType:           stringOrNil(c.APIType),
+			Project:           c.Project,
+			IssueType:         c.IssueType,
+			Summary:           stringOrNil(c.Summary),
+			Description:       stringOrNil(c.Description),
+			Labels:            c.Labels,
+			Priority:          stringOrNil(c.Priority),
+			ReopenTransition:  stringOrNil(c.ReopenTransition),
+			ResolveTransition: stringOrNil(c.ResolveTransition),
+			WontFixResolution: stringOrNil(c.WontFixResolution),
+			HTTPConfig:        f.httpConfig(c.HTTPConfig),
+		}
+
+		if c.APIURL != "" {
+			jc.APIURL = ptr.To(monitoringv1beta1.URL(c.APIURL))
+		}
+
+		if c.ReopenDuration != 0 {
+			jc.ReopenDuration = durationOrNil(&c.ReopenDuration)
+		}
+
+		for _, k := range sortutil.SortedKeys(c.Fields) {
+			b, err := json.Marshal(c.Fields[k])
+			if err != nil {
+				f.im.warn("%s: the %q field can't be converted: %s", f.path, k, err)
+				continue
+			}
+			jc.Fields = append(jc.Fields, monitoringv1beta1.JiraField{Key: k, Value: apiextensionsv1.JSON{Raw: b}})
+		}
+
+		out.JiraConfigs = append(out.JiraConfigs, jc)
+	}
+
+	for i, c := range in.RocketChatConfigs {
+		f := field("rocketchat_configs", i)
+		f.file("token_file", c.TokenFile)
+		f.file("token_id_file", c.TokenIDFile)
+
+		rc := monitoringv1beta1.RocketChatConfig{
+			SendResolved: c.SendResolved,
+			Channel:      stringOrNil(c.Channel),
+			Color:        stringOrNil(c.Color),
+			Emoji:        stringOrNil(c.Emoji),
+			IconURL:      stringOrNil(c.IconURL),
+			Text:         stringOrNil(c.Text),
+			Title:        stringOrNil(c.Title),
+			TitleLink:    stringOrNil(c.TitleLink),
+			ShortFields:  boolOrNil(c.ShortFields),
+			ImageURL:     stringOrNil(c.ImageURL),
+			ThumbURL:     stringOrNil(c.ThumbURL),
+			LinkNames:    boolOrNil(c.LinkNames),
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+		}
+
+		if c.APIURL != "" {
+			rc.APIURL = ptr.To(monitoringv1beta1.URL(c.APIURL))
+		}
+
+		if s := f.secret("token", ptr.Deref(c.Token, "")); s != nil {
+			rc.Token = *s
+		}
+
+		if s := f.secret("token_id", ptr.Deref(c.TokenID, "")); s != nil {
+			rc.TokenID = *s
+		}
+
+		for _, fld := range c.Fields {
+			rc.Fields = append(rc.Fields, monitoringv1beta1.RocketChatFieldConfig{
+				Title: stringOrNil(fld.Title),
+				Value: stringOrNil(fld.Value),
+				Short: fld.Short,
+			})
+		}
+
+		for _, a := range c.Actions {
+			f.unsupported("actions[].image_url", a.ImageURL != "")
+			f.unsupported("actions[].is_webview", a.IsWebView)
+			f.unsupported("actions[].webview_height_ratio", a.WebviewHeightRatio != "")
+			f.unsupported("actions[].msg_in_chat_window", a.MsgInChatWindow)
+			f.unsupported("actions[].msg_processing_type", a.MsgProcessingType != "")
+			rc.Actions = append(rc.Actions, monitoringv1beta1.RocketChatActionConfig{
+				Text: stringOrNil(a.Text),
+				URL:  stringOrNil(a.URL),
+				Msg:  stringOrNil(a.Msg),
+			})
+		}
+
+		out.RocketChatConfigs = append(out.RocketChatConfigs, rc)
+	}
+
+	for i, c := range in.MattermostConfigs {
+		f := field("mattermost_configs", i)
+		f.file("webhook_url_file", c.WebhookURLFile)
+		f.unsupported("fallback", c.Fallback != "")
+		f.unsupported("color", c.Color != "")
+		f.unsupported("pretext", c.Pretext != "")
+		f.unsupported("author_name", c.AuthorName != "")
+		f.unsupported("author_link", c.AuthorLink != "")
+		f.unsupported("author_icon", c.AuthorIcon != "")
+		f.unsupported("title", c.Title != "")
+		f.unsupported("title_link", c.TitleLink != "")
+		f.unsupported("fields", len(c.Fields) > 0)
+		f.unsupported("thumb_url", c.ThumbURL != "")
+		f.unsupported("footer", c.Footer != "")
+		f.unsupported("footer_icon", c.FooterIcon != "")
+		f.unsupported("image_url", c.ImageURL != "")
+
+		mc := monitoringv1beta1.MattermostConfig{
+			SendResolved: c.SendResolved,
+			WebhookURL:   f.secret("webhook_url", c.WebhookURL),
+			Channel:      stringOrNil(c.Channel),
+			Username:     stringOrNil(c.Username),
+			Text:         stringOrNil(c.Text),
+			IconEmoji:    stringOrNil(c.IconEmoji),
+			HTTPConfig:   f.httpConfig(c.HTTPConfig),
+		}
+
+		if c.IconURL != "" {
+			mc.IconURL = ptr.To(monitoringv1beta1.URL(c.IconURL))
+		}
+
+		for _, a := range c.Attachments {
+			ma := monitoringv1beta1.MattermostAttachment{
+				Fallback:   stringOrNil(a.Fallback),
+				Color:      stringOrNil(a.Color),
+				Pretext:    stringOrNil(a.Pretext),
+				Text:       stringOrNil(a.Text),
+				AuthorName: stringOrNil(a.AuthorName),
+				AuthorLink: urlOrNil(a.AuthorLink),
+				AuthorIcon: urlOrNil(a.AuthorIcon),
+				Title:      stringOrNil(a.Title),
+				TitleLink:  urlOrNil(a.TitleLink),
+				ThumbURL:   urlOrNil(a.ThumbURL),
+				Footer:     stringOrNil(a.Footer),
+				FooterIcon: urlOrNil(a.FooterIcon),
+				ImageURL:   urlOrNil(a.ImageURL),
+			}
+			for _, fld := range a.Fields {
+				ma.Fields = append(ma.Fields, monitoringv1beta1.MattermostField{
+					Title: fld.Title,
+					Value: fld.Value,
+					Short: boolOrNil(fld.Short),
+				})
+			}
+			mc.Attachments = append(mc.Attachments, ma)
+		}
+
+		if c.Props != nil {
+			mc.Props = &monitoringv1beta1.MattermostProps{Card: c.Props.Card}
+		}
+
+		if c.Priority != nil {
+			mc.Priority = &monitoringv1beta1.MattermostPriority{
+				Priority:                c.Priority.Priority,
+				RequestedAck:            c.Priority.RequestedAck,
+				PersistentNotifications: c.Priority.PersistentNotifications,
+			}
+		}
+
+		out.MattermostConfigs = append(out.MattermostConfigs, mc)
+	}
+
+	for i, c := range in.IncidentioConfigs {
+		f := field("incidentio_configs", i)
+		f.file("url_file", c.URLFile)
+		f.file("alert_source_token_file", c.AlertSourceTokenFile)
+
+		out.IncidentioConfigs = append(out.IncidentioConfigs, monitoringv1beta1.IncidentioConfig{
+			SendResolved:     c.VSendResolved,
+			URLSecret:        f.secret("url", c.URL),
+			AlertSourceToken: f.secret("alert_source_token", c.AlertSourceToken),
+			MaxAlerts:        c.MaxAlerts,
+			Timeout:          durationOrNil(c.Timeout),
+			HTTPConfig:       f.httpConfig(c.HTTPConfig),
+		})
+	}
+
+	return out
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func boolOrNil(b bool) *bool {
+	if !b {
+		return nil
+	}
+
+	return &b
+}
+
+func urlOrNil(s string) *monitoringv1beta1.URL {
+	if s == "" {
+		return nil
+	}
+
+	return ptr.To(monitoringv1beta1.URL(s))
+}
+
+func durationOrNil(d *model.Duration) *monitoringv1.Duration {
+	if d == nil {
+		return nil
+	}
+
+	return ptr.To(monitoringv1.Duration(d.String()))
+}
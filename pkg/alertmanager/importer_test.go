@@ -0,0 +1,177 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+	"sigs.k8s.io/yaml"
+)
+
+func TestImportConfiguration(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		config   string
+		golden   string
+		warnings []string
+		err      bool
+	}{
+		{
+			name: "minimal configuration",
+			config: `route:
+  receiver: "null"
+receivers:
+- name: "null"
+`,
+			golden: "import_minimal.golden",
+		},
+		{
+			name: "routing tree",
+			config: `global:
+  resolve_timeout: 10m
+  slack_api_url: https://slack.example.com/hooks/xyz
+  opsgenie_api_key: opsgenie-key
+route:
+  receiver: default
+  group_by: [alertname, namespace]
+  group_wait: 30s
+  routes:
+  - receiver: Team A
+    matchers:
+    - team="a"
+    - severity=~"critical|warning"
+    routes:
+    - receiver: team-a-pager
+      match:
+        severity: critical
+      mute_time_intervals: [weekends]
+  - receiver: default
+    match_re:
+      team: b|c
+    continue: true
+  - matchers:
+    - team="d"
+    receiver: team-d
+inhibit_rules:
+- source_matchers: [severity="critical"]
+  target_matchers: [severity="warning"]
+  equal: [alertname]
+receivers:
+- name: default
+  email_configs:
+  - to: oncall@example.com
+    from: alertmanager@example.com
+    smarthost: smtp.example.com:587
+    auth_password: smtp-password
+- name: Team A
+  slack_configs:
+  - channel: '#team-a'
+    send_resolved: true
+- name: team-a-pager
+  opsgenie_configs:
+  - responders:
+    - name: team-a
+      type: team
+- name: team-d
+  webhook_configs:
+  - url: https://webhook.example.com/team-d
+    http_config:
+      bearer_token: webhook-token
+- name: unused
+mute_time_intervals:
+- name: weekends
+  time_intervals:
+  - weekdays: [saturday, sunday]
+    times:
+    - start_time: "00:00"
+      end_time: "24:00"
+templates:
+- /etc/alertmanager/templates/custom.tmpl
+`,
+			golden: "import_routing_tree.golden",
+			warnings: []string{
+				`route[0] (AlertmanagerConfig "team-a"): the operator always sets 'continue: true' on the first-level routes of AlertmanagerConfig resources, alerts matching this route will also be evaluated against the next routes`,
+				"mute_time_intervals are converted to time intervals",
+				`templates: create the "global-templates" ConfigMap from the template files`,
+			},
+		},
+		{
+			name: "unsupported fields",
+			config: `route:
+  receiver: webhook
+receivers:
+- name: webhook
+  webhook_configs:
+  - url_file: /etc/alertmanager/secrets/url
+    http_config:
+      basic_auth:
+        username: user
+        password_file: /etc/alertmanager/secrets/password
+templates:
+- /etc/alertmanager/templates/*.tmpl
+`,
+			golden: "import_unsupported_fields.golden",
+			warnings: []string{
+				`receiver "webhook": webhook_configs[0]: "url_file" references the "/etc/alertmanager/secrets/url" file which can't be converted, store its content in a Secret and update the AlertmanagerConfig resource`,
+				`receiver "webhook": webhook_configs[0]: "http_config.basic_auth.password_file" references the "/etc/alertmanager/secrets/password" file which can't be converted, store its content in a Secret and update the AlertmanagerConfig resource`,
+				`templates: "/etc/alertmanager/templates/*.tmpl" is a glob pattern, store the matching files in the "global-templates" ConfigMap and reference them in spec.alertmanagerConfiguration.templates`,
+			},
+		},
+		{
+			name:   "invalid configuration",
+			config: `route: {}`,
+			err:    true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := ImportConfiguration([]byte(tc.config), ImportOptions{
+				Namespace: "monitoring",
+				Name:      "global",
+			})
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.warnings, res.Warnings)
+
+			var out []byte
+			for _, obj := range append(append([]any{res.AlertmanagerConfiguration}, toAny(res.AlertmanagerConfigs)...), toAny(res.Secrets)...) {
+				b, err := yaml.Marshal(obj)
+				require.NoError(t, err)
+				out = append(out, []byte("---\n")...)
+				out = append(out, b...)
+			}
+
+			golden.Assert(t, string(out), tc.golden)
+		})
+	}
+}
+
+func TestImportConfigurationInvalidName(t *testing.T) {
+	_, err := ImportConfiguration([]byte("route: {receiver: a}\nreceivers: [{name: a}]\n"), ImportOptions{Name: "Invalid_Name"})
+	require.Error(t, err)
+}
+
+func toAny[T any](objs []T) []any {
+	ret := make([]any, 0, len(objs))
+	for _, o := range objs {
+		ret = append(ret, o)
+	}
+
+	return ret
+}
@@ -17,12 +17,14 @@ package alertmanager
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log/slog"
 	"maps"
-	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -36,9 +38,11 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	typedauthv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/ptr"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/clustertlsconfig"
@@ -62,6 +66,15 @@ const (
 	applicationNameLabelValue = "alertmanager"
 
 	selectingAlertmanagerConfigResourcesAction = "SelectingAlertmanagerConfigResources"
+
+	// configFilePartSize is the maximum size of a part of the compressed
+	// configuration file.
+	configFilePartSize = operator.MaxSecretDataSizeBytes - 1024
+
+	// configSizeWarningThreshold is the size of the generated configuration
+	// above which the operator reports that it's close to the maximum size of
+	// a Secret.
+	configSizeWarningThreshold = operator.MaxSecretDataSizeBytes * 8 / 10
 )
 
 // Config defines the operator's parameters for the Alertmanager controller.
@@ -95,6 +108,7 @@ type Operator struct {
 
 	alrtInfs    *informers.ForResource
 	alrtCfgInfs *informers.ForResource
+	rcvTestInfs *informers.ForResource
 	cmapInfs    *informers.ForResource
 	secrInfs    *informers.ForResource
 	ssetInfs    *informers.ForResource
@@ -111,6 +125,23 @@ type Operator struct {
 	config Config
 
 	configResourcesStatusEnabled bool
+	receiverTestSupported        bool
+
+	// receiverTestQueue holds the keys of the ReceiverTest objects to be
+	// processed. The inputs are the last configurations generated for the
+	// Alertmanager objects and the results are the outcomes of the last
+	// tests for the ReceiverTest objects.
+	receiverTestQueue   workqueue.TypedRateLimitingInterface[string]
+	receiverTestMtx     sync.Mutex
+	receiverTestInputs  map[string]*receiverTestInput
+	receiverTestResults map[string]receiverTestResult
+
+	// routingTrees holds the routing tree of the last configuration
+	// generated for each Alertmanager object when the route explain
+	// endpoint is enabled.
+	routeExplainEnabled bool
+	routingTreesMtx     sync.RWMutex
+	routingTrees        map[string]*routingTree
 }
 
 type ControllerOption func(*Operator)
@@ -131,6 +162,32 @@ func WithConfigResourceStatus() ControllerOption {
 	}
 }
 
+// WithReceiverTest tells that the controller should process the ReceiverTest
+// resources. Processing a ReceiverTest resource sends notifications to the
+// endpoints of the referenced receiver.
+func WithReceiverTest() ControllerOption {
+	return func(o *Operator) {
+		o.receiverTestSupported = true
+		o.receiverTestQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "alertmanager_receivertest",
+			},
+		)
+		o.receiverTestInputs = map[string]*receiverTestInput{}
+		o.receiverTestResults = map[string]receiverTestResult{}
+	}
+}
+
+// WithRouteExplain tells that the controller should keep the routing tree of
+// the generated configurations in memory for the route explain endpoint.
+func WithRouteExplain() ControllerOption {
+	return func(o *Operator) {
+		o.routeExplainEnabled = true
+		o.routingTrees = map[string]*routingTree{}
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -239,6 +296,22 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 		return fmt.Errorf("error creating alertmanagerconfig informers: %w", err)
 	}
 
+	if c.receiverTestSupported {
+		c.rcvTestInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				config.Namespaces.AlertmanagerConfigAllowList,
+				config.Namespaces.DenyList,
+				c.mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ReceiverTestName),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating receivertest informers: %w", err)
+		}
+	}
+
 	allowList := config.Namespaces.AlertmanagerConfigAllowList
 	if config.WatchObjectRefsInAllNamespaces {
 		allowList = operator.MergeAllowLists(
@@ -349,7 +422,12 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"Secret", c.secrInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"StatefulSet", c.ssetInfs},
+		{"ReceiverTest", c.rcvTestInfs},
 	} {
+		if infs.informersForResource == nil {
+			continue
+		}
+
 		for _, inf := range infs.informersForResource.GetInformers() {
 			if !operator.WaitForNamedCacheSync(ctx, "alertmanager", c.logger.With("informer", infs.name), inf.Informer()) {
 				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
@@ -393,6 +471,16 @@ func (c *Operator) addHandlers() {
 		),
 	))
 
+	if c.rcvTestInfs != nil {
+		c.rcvTestInfs.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: c.handleReceiverTest,
+			UpdateFunc: func(_, cur any) {
+				c.handleReceiverTest(cur)
+			},
+			DeleteFunc: c.handleReceiverTestDelete,
+		})
+	}
+
 	hasRefFunc := operator.HasReferenceFunc(
 		c.alrtInfs,
 		c.reconciliations,
@@ -483,6 +571,9 @@ func (c *Operator) Run(ctx context.Context) error {
 
 	go c.alrtInfs.Start(ctx.Done())
 	go c.alrtCfgInfs.Start(ctx.Done())
+	if c.rcvTestInfs != nil {
+		go c.rcvTestInfs.Start(ctx.Done())
+	}
 	go c.secrInfs.Start(ctx.Done())
 	go c.cmapInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
@@ -502,6 +593,10 @@ func (c *Operator) Run(ctx context.Context) error {
 
 	c.addHandlers()
 
+	if c.rcvTestInfs != nil {
+		go c.runReceiverTests(ctx)
+	}
+
 	// TODO(simonpasquier): watch for Alertmanager pods instead of polling.
 	go operator.StatusPoller(ctx, c)
 
@@ -588,6 +683,8 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if am == nil {
 		c.reconciliations.ForgetObject(key)
+		c.forgetRoutingTree(key)
+		c.forgetReceiverTestInput(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
@@ -595,6 +692,8 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	// Check if the Alertmanager instance is marked for deletion.
 	if c.rr.DeletionInProgress(am) {
 		c.reconciliations.ForgetObject(key)
+		c.forgetRoutingTree(key)
+		c.forgetReceiverTestInput(key)
 		return nil
 	}
 
@@ -614,7 +713,8 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	assetStore := assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
 
-	if err := c.provisionAlertmanagerConfiguration(ctx, am, assetStore); err != nil {
+	configShardedSecret, err := c.provisionAlertmanagerConfiguration(ctx, key, am, assetStore)
+	if err != nil {
 		return fmt.Errorf("provision alertmanager configuration: %w", err)
 	}
 	c.reconciliations.UpdateReferenceTracker(key, assetStore.RefTracker())
@@ -663,12 +763,12 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
-	newSSetInputHash, err := createSSetInputHash(*am, c.config, tlsShardedSecret, existingStatefulSet.Spec)
+	newSSetInputHash, err := createSSetInputHash(*am, c.config, configShardedSecret, tlsShardedSecret, existingStatefulSet.Spec)
 	if err != nil {
 		return err
 	}
 
-	sset, err := makeStatefulSet(logger, am, c.config, newSSetInputHash, tlsShardedSecret)
+	sset, err := makeStatefulSet(logger, am, c.config, newSSetInputHash, configShardedSecret, tlsShardedSecret)
 	if err != nil {
 		return fmt.Errorf("failed to generate statefulset: %w", err)
 	}
@@ -797,7 +897,7 @@ func labelSelectorForStatefulSets() string {
 	)
 }
 
-func createSSetInputHash(a monitoringv1.Alertmanager, c Config, tlsAssets *operator.ShardedSecret, s appsv1.StatefulSetSpec) (string, error) {
+func createSSetInputHash(a monitoringv1.Alertmanager, c Config, configSecrets, tlsAssets *operator.ShardedSecret, s appsv1.StatefulSetSpec) (string, error) {
 	var http2 *bool
 	if a.Spec.Web != nil && a.Spec.Web.HTTPConfig != nil {
 		http2 = a.Spec.Web.HTTPConfig.HTTP2
@@ -815,6 +915,7 @@ func createSSetInputHash(a monitoringv1.Alertmanager, c Config, tlsAssets *opera
 		AlertmanagerWebHTTP2    *bool
 		Config                  Config
 		StatefulSetSpec         appsv1.StatefulSetSpec
+		ConfigShardedSecret     *operator.ShardedSecret
 		ShardedSecret           *operator.ShardedSecret
 	}{
 		AlertmanagerLabels:      a.Labels,
@@ -823,6 +924,7 @@ func createSSetInputHash(a monitoringv1.Alertmanager, c Config, tlsAssets *opera
 		AlertmanagerWebHTTP2:    http2,
 		Config:                  c,
 		StatefulSetSpec:         s,
+		ConfigShardedSecret:     configSecrets,
 		ShardedSecret:           tlsAssets,
 	},
 		nil,
@@ -845,15 +947,18 @@ receivers:
 // additional keys from the configured secret. If the secret doesn't exist or
 // the key isn't found, it will return a working minimal data.
 func (c *Operator) loadConfigurationFromSecret(ctx context.Context, am *monitoringv1.Alertmanager) ([]byte, map[string][]byte, error) {
-	namespacedLogger := c.logger.With("alertmanager", am.Name, "namespace", am.Namespace)
+	return loadConfigurationFromSecret(ctx, c.logger.With("alertmanager", am.Name, "namespace", am.Namespace), c.kclient.CoreV1(), am)
+}
+
+func loadConfigurationFromSecret(ctx context.Context, logger *slog.Logger, sClient typedcorev1.SecretsGetter, am *monitoringv1.Alertmanager) ([]byte, map[string][]byte, error) {
 	name := defaultConfigSecretName(am)
 
 	// Tentatively retrieve the secret containing the user-provided Alertmanager
 	// configuration.
-	secret, err := c.kclient.CoreV1().Secrets(am.Namespace).Get(ctx, name, metav1.GetOptions{})
+	secret, err := sClient.Secrets(am.Namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			namespacedLogger.Info("config secret not found, using default Alertmanager configuration", "secret", name)
+			logger.Info("config secret not found, using default Alertmanager configuration", "secret", name)
 			return defaultAlertmanagerConfiguration(), nil, nil
 		}
 
@@ -861,7 +966,7 @@ func (c *Operator) loadConfigurationFromSecret(ctx context.Context, am *monitori
 	}
 
 	if _, ok := secret.Data[alertmanagerConfigFile]; !ok {
-		namespacedLogger.Info("key not found in the config secret, using default Alertmanager configuration", "secret", name, "key", alertmanagerConfigFile)
+		logger.Info("key not found in the config secret, using default Alertmanager configuration", "secret", name, "key", alertmanagerConfigFile)
 		return defaultAlertmanagerConfiguration(), secret.Data, nil
 	}
 
@@ -869,141 +974,126 @@ func (c *Operator) loadConfigurationFromSecret(ctx context.Context, am *monitori
 	delete(secret.Data, alertmanagerConfigFile)
 
 	if len(rawAlertmanagerConfig) == 0 {
-		namespacedLogger.Info("empty configuration in the config secret, using default Alertmanager configuration", "secret", name, "key", alertmanagerConfigFile)
+		logger.Info("empty configuration in the config secret, using default Alertmanager configuration", "secret", name, "key", alertmanagerConfigFile)
 		rawAlertmanagerConfig = defaultAlertmanagerConfiguration()
 	}
 
 	return rawAlertmanagerConfig, secret.Data, nil
 }
 
-func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.StoreBuilder) error {
-	amVersion := operator.StringValOrDefault(am.Spec.Version, operator.DefaultAlertmanagerVersion)
-	version, err := semver.ParseTolerant(amVersion)
-	if err != nil {
-		return fmt.Errorf("failed to parse alertmanager version: %w", err)
-	}
-
-	if version.LT(semver.MustParse("0.15.0")) || version.Major > 0 {
-		return fmt.Errorf("unsupported Alertmanager version %q", amVersion)
-	}
-
+func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, key string, am *monitoringv1.Alertmanager, store *assets.StoreBuilder) (*operator.ShardedSecret, error) {
 	namespacedLogger := c.logger.With("alertmanager", am.Name, "namespace", am.Namespace)
-	// If no AlertmanagerConfig selectors and AlertmanagerConfiguration are
-	// configured, the user wants to manage configuration themselves.
-	if am.Spec.AlertmanagerConfigSelector == nil && am.Spec.AlertmanagerConfiguration == nil {
-		namespacedLogger.Debug("AlertmanagerConfigSelector and AlertmanagerConfiguration not specified, using the configuration from secret as-is",
-			"secret", defaultConfigSecretName(am))
-
-		amRawConfiguration, additionalData, err := c.loadConfigurationFromSecret(ctx, am)
-		if err != nil {
-			return fmt.Errorf("failed to retrieve configuration from secret: %w", err)
-		}
-
-		err = c.createOrUpdateGeneratedConfigSecret(ctx, am, amRawConfiguration, additionalData)
-		if err != nil {
-			return fmt.Errorf("create or update generated config secret failed: %w", err)
-		}
-
-		return nil
-	}
 
-	amConfigs, err := c.selectAlertmanagerConfigs(ctx, am, version, store)
+	generatedConfig, additionalData, err := generateConfiguration(ctx, namespacedLogger, c, am, store)
 	if err != nil {
-		return fmt.Errorf("failed to select AlertmanagerConfig objects: %w", err)
+		c.updateReceiverTestInput(key, &receiverTestInput{err: err})
+		return nil, err
 	}
+	c.updateRoutingTree(key, generatedConfig)
+	c.updateReceiverTestInput(key, newReceiverTestInput(ctx, am, store, generatedConfig, additionalData))
 
-	var (
-		additionalData map[string][]byte
-		cfgBuilder     = NewConfigBuilder(namespacedLogger, version, store, am)
-	)
-
-	if am.Spec.AlertmanagerConfiguration != nil {
-		// Load the base configuration from the referenced AlertmanagerConfig.
-		globalAmConfig, err := c.mclient.MonitoringV1alpha1().AlertmanagerConfigs(am.Namespace).
-			Get(ctx, am.Spec.AlertmanagerConfiguration.Name, metav1.GetOptions{})
-		if err != nil {
-			return fmt.Errorf("failed to get global AlertmanagerConfig: %w", err)
-		}
-
-		err = cfgBuilder.initializeFromAlertmanagerConfig(ctx, am.Spec.AlertmanagerConfiguration.Global, globalAmConfig)
-		if err != nil {
-			return fmt.Errorf("failed to initialize from global AlertmanagerConfig: %w", err)
-		}
-
-		for _, v := range am.Spec.AlertmanagerConfiguration.Templates {
-			if v.ConfigMap != nil {
-				cfgBuilder.cfg.Templates = append(cfgBuilder.cfg.Templates, path.Join(alertmanagerTemplatesDir, v.ConfigMap.Key))
-			}
-			if v.Secret != nil {
-				cfgBuilder.cfg.Templates = append(cfgBuilder.cfg.Templates, path.Join(alertmanagerTemplatesDir, v.Secret.Key))
-			}
-		}
-	} else {
-		// Load the base configuration from the referenced secret.
-		var (
-			amRawConfiguration []byte
-			err                error
-		)
-
-		amRawConfiguration, additionalData, err = c.loadConfigurationFromSecret(ctx, am)
-		if err != nil {
-			return fmt.Errorf("failed to retrieve configuration from secret: %w", err)
-		}
-
-		err = cfgBuilder.InitializeFromRawConfiguration(amRawConfiguration)
-		if err != nil {
-			return fmt.Errorf("failed to initialize from secret: %w", err)
-		}
+	configSecrets, err := c.createOrUpdateGeneratedConfigSecrets(ctx, am, generatedConfig, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create or update the generated configuration secrets: %w", err)
 	}
 
-	if err := cfgBuilder.AddAlertmanagerConfigs(ctx, amConfigs); err != nil {
-		return fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
-	}
+	return configSecrets, nil
+}
 
-	generatedConfig, err := cfgBuilder.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("failed to marshal configuration: %w", err)
-	}
+// getGlobalAlertmanagerConfig returns the AlertmanagerConfig object
+// referenced by the Alertmanager's configuration.
+func (c *Operator) getGlobalAlertmanagerConfig(ctx context.Context, am *monitoringv1.Alertmanager) (*monitoringv1alpha1.AlertmanagerConfig, error) {
+	return c.mclient.MonitoringV1alpha1().AlertmanagerConfigs(am.Namespace).
+		Get(ctx, am.Spec.AlertmanagerConfiguration.Name, metav1.GetOptions{})
+}
 
-	err = c.createOrUpdateGeneratedConfigSecret(ctx, am, generatedConfig, additionalData)
+// createOrUpdateGeneratedConfigSecrets stores the generated configuration
+// and the additional data into numbered Secrets.
+func (c *Operator) createOrUpdateGeneratedConfigSecrets(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte, additionalData map[string][]byte) (*operator.ShardedSecret, error) {
+	data, err := makeGeneratedConfigData(conf, additionalData)
 	if err != nil {
-		return fmt.Errorf("failed to create or update the generated configuration secret: %w", err)
+		return nil, err
 	}
 
-	return nil
-}
-
-func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte, additionalData map[string][]byte) error {
-	generatedConfigSecret := &corev1.Secret{
+	template := &corev1.Secret{
 		Data: map[string][]byte{},
 	}
 
 	operator.UpdateObject(
-		generatedConfigSecret,
+		template,
 		operator.WithLabels(c.config.Labels),
 		operator.WithAnnotations(c.config.Annotations),
 		operator.WithManagingOwner(am),
 		operator.WithName(generatedConfigSecretName(am.Name)),
+		operator.WithNamespace(am.Namespace),
 	)
 
-	maps.Copy(generatedConfigSecret.Data, additionalData)
+	// The first Secret keeps the name used before the configuration was
+	// sharded so that running pods aren't affected by the upgrade.
+	configSecrets, err := operator.ReconcileShardedSecret(ctx, data, c.kclient, template, operator.WithUnsuffixedFirstShard())
+	if err != nil {
+		return nil, err
+	}
+
+	if size := dataSize(data); size >= configSizeWarningThreshold {
+		// Don't discard the reasons which may have been reported before
+		// (e.g. deprecated fields).
+		c.reconciliations.AddReasonAndMessage(
+			operator.KeyForObject(am),
+			operator.ConfigurationSizeNearLimitReason,
+			fmt.Sprintf("the generated configuration uses %d bytes (the maximum size of a Secret is %d bytes) and is stored in %d Secret(s)", size, operator.MaxSecretDataSizeBytes, configSecrets.Len()),
+		)
+	}
+
+	return configSecrets, nil
+}
+
+// makeGeneratedConfigData returns the data of the generated configuration
+// Secrets. The configuration is compressed and, if it is too large for a
+// single Secret, split into parts which the config reloader concatenates and
+// verifies against the checksum file.
+func makeGeneratedConfigData(conf []byte, additionalData map[string][]byte) (map[string][]byte, error) {
+	data := make(map[string][]byte, len(additionalData)+1)
+	maps.Copy(data, additionalData)
+
 	// Compress config to avoid 1mb secret limit for a while
 	var buf bytes.Buffer
 	if err := operator.GzipConfig(&buf, conf); err != nil {
-		return fmt.Errorf("couldnt gzip config: %w", err)
+		return nil, fmt.Errorf("couldnt gzip config: %w", err)
 	}
-	generatedConfigSecret.Data[alertmanagerConfigFileCompressed] = buf.Bytes()
 
-	sClient := c.kclient.CoreV1().Secrets(am.Namespace)
-	err := k8s.CreateOrUpdateSecret(ctx, sClient, generatedConfigSecret)
-	if err != nil {
-		return fmt.Errorf("failed to update generated config secret: %w", err)
+	compressed := buf.Bytes()
+
+	// The config reloader verifies the checksum before loading the
+	// configuration because the Secrets holding the parts aren't updated
+	// atomically.
+	checksum := sha256.Sum256(compressed)
+	data[alertmanagerConfigChecksumFile] = []byte(hex.EncodeToString(checksum[:]))
+
+	if len(compressed) <= configFilePartSize {
+		data[alertmanagerConfigFileCompressed] = compressed
+		return data, nil
 	}
 
-	return nil
+	for i := 0; len(compressed) > 0; i++ {
+		n := min(len(compressed), configFilePartSize)
+		data[fmt.Sprintf("%s.part-%03d", alertmanagerConfigFileCompressed, i)] = compressed[:n]
+		compressed = compressed[n:]
+	}
+
+	return data, nil
+}
+
+func dataSize(data map[string][]byte) int {
+	var size int
+	for k, v := range data {
+		size += len(k) + len(v)
+	}
+
+	return size
 }
 
-func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.StoreBuilder) (map[string]*monitoringv1alpha1.AlertmanagerConfig, error) {
+func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.StoreBuilder, shared sharedConfig) (map[string]*monitoringv1alpha1.AlertmanagerConfig, error) {
 	namespaces := []string{}
 
 	// If 'AlertmanagerConfigNamespaceSelector' is nil, only check own namespace.
@@ -1060,7 +1150,7 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 
 	eventRecorder := c.newEventRecorder(am)
 	for namespaceAndName, amc := range amConfigs {
-		if err := checkAlertmanagerConfigResource(ctx, amc, amVersion, store); err != nil {
+		if err := checkSelectedAlertmanagerConfig(ctx, am, amc, amVersion, store, shared, c.namespaceLabels(amc.Namespace)); err != nil {
 			rejected++
 			c.logger.Warn(
 				"skipping alertmanagerconfig",
@@ -1090,6 +1180,62 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 	return res, nil
 }
 
+// checkSelectedAlertmanagerConfig verifies that an AlertmanagerConfig object
+// selected by the Alertmanager object can be added to its configuration.
+func checkSelectedAlertmanagerConfig(
+	ctx context.Context,
+	am *monitoringv1.Alertmanager,
+	amc *monitoringv1alpha1.AlertmanagerConfig,
+	amVersion semver.Version,
+	store *assets.StoreBuilder,
+	shared sharedConfig,
+	namespaceLabels map[string]string,
+) error {
+	if err := checkAlertmanagerConfigResource(ctx, amc, amVersion, store); err != nil {
+		return err
+	}
+
+	if err := checkSharedReceivers(amc.Spec.SharedReceivers, shared.receivers, namespaceLabels); err != nil {
+		return err
+	}
+
+	if err := checkSharedTimeIntervals(amc.Spec.SharedTimeIntervals, shared.timeIntervals); err != nil {
+		return err
+	}
+
+	return checkMatcherLabel(am, amc, namespaceLabels)
+}
+
+// checkMatcherLabel verifies that a value of the matcher label can be found
+// for the AlertmanagerConfig object when the matcher strategy is OnLabel.
+func checkMatcherLabel(am *monitoringv1.Alertmanager, amc *monitoringv1alpha1.AlertmanagerConfig, namespaceLabels map[string]string) error {
+	strategy := am.Spec.AlertmanagerConfigMatcherStrategy
+	if strategy.Type != monitoringv1.OnLabelConfigMatcherStrategyType {
+		return nil
+	}
+
+	label := ptr.Deref(strategy.Label, "")
+	if label == "" {
+		return errors.New("the OnLabel matcher strategy requires a label")
+	}
+
+	if matcherLabelValue(label, amc, namespaceLabels) == "" {
+		return fmt.Errorf("label %q not found on the AlertmanagerConfig object or its namespace", label)
+	}
+
+	return nil
+}
+
+// namespaceLabels returns the labels of the given namespace.
+func (c *Operator) namespaceLabels(name string) map[string]string {
+	obj, exists, err := c.nsAlrtCfgInf.GetStore().GetByKey(name)
+	if err != nil || !exists {
+		return nil
+	}
+
+	return obj.(*corev1.Namespace).Labels
+}
+
 // checkAlertmanagerConfigResource verifies that an AlertmanagerConfig object is valid
 // for the given Alertmanager version and has no missing references to other objects.
 func checkAlertmanagerConfigResource(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, amVersion semver.Version, store *assets.StoreBuilder) error {
@@ -1112,6 +1258,37 @@ func checkAlertmanagerConfigResource(ctx context.Context, amc *monitoringv1alpha
 	return checkInhibitRules(amc, amVersion)
 }
 
+// checkSharedReceivers verifies that the shared receivers declared by the
+// AlertmanagerConfig object are shared by the global AlertmanagerConfig and
+// allowed for its namespace.
+func checkSharedReceivers(names []string, sharedReceivers map[string]sharedReceiver, namespaceLabels map[string]string) error {
+	for _, name := range names {
+		shared, found := sharedReceivers[name]
+		if !found {
+			return fmt.Errorf("shared receiver %q not found in the base configuration", name)
+		}
+
+		if !shared.namespaceSelector.Matches(labels.Set(namespaceLabels)) {
+			return fmt.Errorf("shared receiver %q isn't allowed for this namespace", name)
+		}
+	}
+
+	return nil
+}
+
+// checkSharedTimeIntervals verifies that the shared time intervals declared
+// by the AlertmanagerConfig object are defined by the base configuration of
+// the Alertmanager.
+func checkSharedTimeIntervals(names []string, sharedTimeIntervals map[string]string) error {
+	for _, name := range names {
+		if _, found := sharedTimeIntervals[name]; !found {
+			return fmt.Errorf("shared time interval %q not found in the base configuration", name)
+		}
+	}
+
+	return nil
+}
+
 func checkRoute(ctx context.Context, route *monitoringv1alpha1.Route, amVersion semver.Version) error {
 	if route == nil {
 		return nil
@@ -1246,6 +1423,21 @@ func checkReceivers(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerCon
 		if err != nil {
 			return err
 		}
+
+		err = checkJiraConfigs(ctx, receiver.JiraConfigs, amc.GetNamespace(), store, amVersion)
+		if err != nil {
+			return err
+		}
+
+		err = checkMattermostConfigs(ctx, receiver.MattermostConfigs, amc.GetNamespace(), store, amVersion)
+		if err != nil {
+			return err
+		}
+
+		err = checkIncidentioConfigs(ctx, receiver.IncidentioConfigs, amc.GetNamespace(), store, amVersion)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -1394,6 +1586,121 @@ func checkRocketChatConfigs(
 	return nil
 }
 
+func checkJiraConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.JiraConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.28.0")) {
+		return fmt.Errorf(`'jiraConfigs' is available in Alertmanager >= 0.28.0 only - current %s`, amVersion)
+	}
+
+	for _, config := range configs {
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkMattermostConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.MattermostConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.30.0")) {
+		return fmt.Errorf(`'mattermostConfigs' is available in Alertmanager >= 0.30.0 only - current %s`, amVersion)
+	}
+
+	for _, config := range configs {
+		if config.WebhookURL == nil {
+			// The global Mattermost webhook URL is only supported since v0.32.0.
+			if amVersion.LT(semver.MustParse("0.32.0")) {
+				return fmt.Errorf(`'webhookURL' is required for Alertmanager < 0.32.0 - current %s`, amVersion)
+			}
+		} else if _, err := store.GetSecretKey(ctx, namespace, *config.WebhookURL); err != nil {
+			return fmt.Errorf("failed to retrieve Mattermost webhook URL: %w", err)
+		}
+
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkIncidentioConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.IncidentioConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.29.0")) {
+		return fmt.Errorf(`'incidentioConfigs' is available in Alertmanager >= 0.29.0 only - current %s`, amVersion)
+	}
+
+	for _, config := range configs {
+		hasAuthorization := config.HTTPConfig != nil && config.HTTPConfig.Authorization != nil
+		if err := validation.ValidateIncidentioConfig((*string)(config.URL), config.URLSecret, config.AlertSourceToken, hasAuthorization); err != nil {
+			return err
+		}
+
+		if config.URLSecret != nil {
+			url, err := store.GetSecretKey(ctx, namespace, *config.URLSecret)
+			if err != nil {
+				return fmt.Errorf("failed to retrieve incident.io URL: %w", err)
+			}
+
+			if err := validation.ValidateSecretURL(strings.TrimSpace(url)); err != nil {
+				return fmt.Errorf("failed to validate incident.io URL: %w", err)
+			}
+		}
+
+		if config.AlertSourceToken != nil {
+			if _, err := store.GetSecretKey(ctx, namespace, *config.AlertSourceToken); err != nil {
+				return fmt.Errorf("failed to retrieve incident.io alert source token: %w", err)
+			}
+		}
+
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func checkSlackConfigs(
 	ctx context.Context,
 	configs []monitoringv1alpha1.SlackConfig,
@@ -15,7 +15,14 @@
 package alertmanager
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"testing"
@@ -29,6 +36,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/ptr"
@@ -168,10 +176,10 @@ func TestCreateStatefulSetInputHash(t *testing.T) {
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			a1Hash, err := createSSetInputHash(tc.a, Config{}, &operator.ShardedSecret{}, appsv1.StatefulSetSpec{})
+			a1Hash, err := createSSetInputHash(tc.a, Config{}, &operator.ShardedSecret{}, &operator.ShardedSecret{}, appsv1.StatefulSetSpec{})
 			require.NoError(t, err)
 
-			a2Hash, err := createSSetInputHash(tc.b, Config{}, &operator.ShardedSecret{}, appsv1.StatefulSetSpec{})
+			a2Hash, err := createSSetInputHash(tc.b, Config{}, &operator.ShardedSecret{}, &operator.ShardedSecret{}, appsv1.StatefulSetSpec{})
 			require.NoError(t, err)
 
 			if !tc.equal {
@@ -181,7 +189,7 @@ func TestCreateStatefulSetInputHash(t *testing.T) {
 
 			require.Equal(t, a1Hash, a2Hash, "expected two Alertmanager CRDs to produce the same hash but got different hash")
 
-			a2Hash, err = createSSetInputHash(tc.a, Config{}, &operator.ShardedSecret{}, appsv1.StatefulSetSpec{Replicas: ptr.To(int32(2))})
+			a2Hash, err = createSSetInputHash(tc.a, Config{}, &operator.ShardedSecret{}, &operator.ShardedSecret{}, appsv1.StatefulSetSpec{Replicas: ptr.To(int32(2))})
 			require.NoError(t, err)
 
 			require.NotEqual(t, a1Hash, a2Hash, "expected same Alertmanager CRDs with different statefulset specs to produce different hashes but got equal hash")
@@ -1533,6 +1541,143 @@ func TestCheckAlertmanagerConfig(t *testing.T) {
 			version: &version29,
 			ok:      false,
 		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mattermost-config",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						MattermostConfigs: []monitoringv1alpha1.MattermostConfig{
+							{
+								WebhookURL: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			version: &version30,
+			ok:      true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mattermost-config-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						MattermostConfigs: []monitoringv1alpha1.MattermostConfig{
+							{
+								WebhookURL: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			version: &version29,
+			ok:      false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mattermost-config-missing-webhook-url",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						MattermostConfigs: []monitoringv1alpha1.MattermostConfig{
+							{
+								Channel: ptr.To("alerts"),
+							},
+						},
+					}},
+				},
+			},
+			version: &version31,
+			ok:      false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "incidentio-config",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						IncidentioConfigs: []monitoringv1alpha1.IncidentioConfig{
+							{
+								URL: ptr.To(monitoringv1alpha1.URL("https://api.incident.io/v2/alert_events/http/01ABCDEF")),
+								AlertSourceToken: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "token",
+								},
+							},
+						},
+					}},
+				},
+			},
+			version: &version29,
+			ok:      true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "incidentio-config-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						IncidentioConfigs: []monitoringv1alpha1.IncidentioConfig{
+							{
+								URL: ptr.To(monitoringv1alpha1.URL("https://api.incident.io/v2/alert_events/http/01ABCDEF")),
+								AlertSourceToken: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "token",
+								},
+							},
+						},
+					}},
+				},
+			},
+			version: &version26,
+			ok:      false,
+		},
 	} {
 		t.Run(tc.amConfig.Name, func(t *testing.T) {
 			store := assets.NewStoreBuilder(c.CoreV1(), c.CoreV1())
@@ -1553,6 +1698,178 @@ func TestCheckAlertmanagerConfig(t *testing.T) {
 	}
 }
 
+func TestCheckIncidentioConfigs(t *testing.T) {
+	c := fake.NewClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "incidentio", Namespace: "ns1"},
+			Data: map[string][]byte{
+				"url":     []byte("https://api.incident.io/v2/alert_events/http/01ABCDEF"),
+				"invalid": []byte("api.incident.io"),
+				"token":   []byte("token"),
+			},
+		},
+	)
+
+	secretKey := func(key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "incidentio"},
+			Key:                  key,
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		config monitoringv1alpha1.IncidentioConfig
+		ok     bool
+	}{
+		{
+			name: "url secret",
+			config: monitoringv1alpha1.IncidentioConfig{
+				URLSecret:        secretKey("url"),
+				AlertSourceToken: secretKey("token"),
+			},
+			ok: true,
+		},
+		{
+			name: "url and url secret",
+			config: monitoringv1alpha1.IncidentioConfig{
+				URL:              ptr.To(monitoringv1alpha1.URL("https://api.incident.io/v2/alert_events/http/01ABCDEF")),
+				URLSecret:        secretKey("url"),
+				AlertSourceToken: secretKey("token"),
+			},
+		},
+		{
+			name: "invalid url in secret",
+			config: monitoringv1alpha1.IncidentioConfig{
+				URLSecret:        secretKey("invalid"),
+				AlertSourceToken: secretKey("token"),
+			},
+		},
+		{
+			name: "missing token",
+			config: monitoringv1alpha1.IncidentioConfig{
+				URLSecret: secretKey("url"),
+			},
+		},
+		{
+			name: "token and authorization",
+			config: monitoringv1alpha1.IncidentioConfig{
+				URLSecret:        secretKey("url"),
+				AlertSourceToken: secretKey("token"),
+				HTTPConfig: &monitoringv1alpha1.HTTPConfig{
+					Authorization: &monitoringv1.SafeAuthorization{Credentials: secretKey("token")},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewStoreBuilder(c.CoreV1(), c.CoreV1())
+
+			err := checkIncidentioConfigs(context.Background(), []monitoringv1alpha1.IncidentioConfig{tc.config}, "ns1", store, semver.MustParse("0.29.0"))
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCheckSharedTimeIntervals(t *testing.T) {
+	sharedTimeIntervals := map[string]string{
+		"company-holidays": "company-holidays",
+	}
+
+	for _, tc := range []struct {
+		name  string
+		names []string
+		ok    bool
+	}{
+		{
+			name: "no shared time interval",
+			ok:   true,
+		},
+		{
+			name:  "shared time interval",
+			names: []string{"company-holidays"},
+			ok:    true,
+		},
+		{
+			name:  "missing shared time interval",
+			names: []string{"company-holidays", "awol"},
+			ok:    false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkSharedTimeIntervals(tc.names, sharedTimeIntervals)
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCheckSharedReceivers(t *testing.T) {
+	sharedReceivers := map[string]sharedReceiver{
+		"on-call": {
+			name:              "monitoring/global/on-call",
+			namespaceSelector: labels.Everything(),
+		},
+		"security": {
+			name:              "monitoring/global/security",
+			namespaceSelector: labels.SelectorFromSet(labels.Set{"team": "security"}),
+		},
+	}
+
+	for _, tc := range []struct {
+		name            string
+		names           []string
+		namespaceLabels map[string]string
+		ok              bool
+	}{
+		{
+			name: "no shared receiver",
+			ok:   true,
+		},
+		{
+			name:  "shared receiver",
+			names: []string{"on-call"},
+			ok:    true,
+		},
+		{
+			name:            "shared receiver allowed for the namespace",
+			names:           []string{"on-call", "security"},
+			namespaceLabels: map[string]string{"team": "security"},
+			ok:              true,
+		},
+		{
+			name:            "shared receiver not allowed for the namespace",
+			names:           []string{"security"},
+			namespaceLabels: map[string]string{"team": "frontend"},
+			ok:              false,
+		},
+		{
+			name:  "missing shared receiver",
+			names: []string{"on-call", "awol"},
+			ok:    false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkSharedReceivers(tc.names, sharedReceivers, tc.namespaceLabels)
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+		})
+	}
+}
+
 // Test to exercise the function provisionAlertmanagerConfiguration
 // and validate that the operator is able to generate an Alertmanager
 // configuration depending on the method chosen by the user.
@@ -1812,7 +2129,7 @@ func TestProvisionAlertmanagerConfiguration(t *testing.T) {
 			require.NoError(t, err)
 
 			store := assets.NewStoreBuilder(c.CoreV1(), c.CoreV1())
-			err = o.provisionAlertmanagerConfiguration(context.Background(), tc.am, store)
+			_, err = o.provisionAlertmanagerConfiguration(context.Background(), "test/test", tc.am, store)
 
 			if !tc.ok {
 				require.Error(t, err)
@@ -1824,7 +2141,7 @@ func TestProvisionAlertmanagerConfiguration(t *testing.T) {
 			secret, err := c.CoreV1().Secrets(tc.am.Namespace).Get(context.Background(), generatedConfigSecretName(tc.am.Name), metav1.GetOptions{})
 			require.NoError(t, err)
 
-			expected := append(tc.expectedKeys, alertmanagerConfigFileCompressed)
+			expected := append(tc.expectedKeys, alertmanagerConfigFileCompressed, alertmanagerConfigChecksumFile)
 			require.Equal(t, len(secret.Data), len(expected), "expecting %d items to be present in the generated secret but got %d", len(expected), len(secret.Data))
 
 			for _, k := range expected {
@@ -1835,6 +2152,112 @@ func TestProvisionAlertmanagerConfiguration(t *testing.T) {
 	}
 }
 
+func TestMakeGeneratedConfigData(t *testing.T) {
+	// Random data doesn't compress well which makes it possible to exceed
+	// the maximum size of a Secret.
+	b := make([]byte, operator.MaxSecretDataSizeBytes)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	largeConfig := []byte(hex.EncodeToString(b))
+
+	for _, tc := range []struct {
+		name          string
+		conf          []byte
+		expectedParts int
+	}{
+		{
+			name: "small configuration",
+			conf: []byte("route:\n  receiver: null\n"),
+		},
+		{
+			name:          "large configuration",
+			conf:          largeConfig,
+			expectedParts: 2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := makeGeneratedConfigData(tc.conf, map[string][]byte{"tls.crt": []byte("foo")})
+			require.NoError(t, err)
+			require.Equal(t, []byte("foo"), data["tls.crt"])
+
+			var compressed []byte
+			if tc.expectedParts == 0 {
+				require.Len(t, data, 3)
+				compressed = data[alertmanagerConfigFileCompressed]
+			} else {
+				require.Len(t, data, tc.expectedParts+2)
+				for i := range tc.expectedParts {
+					part, found := data[fmt.Sprintf("%s.part-%03d", alertmanagerConfigFileCompressed, i)]
+					require.True(t, found)
+					require.LessOrEqual(t, len(part), configFilePartSize)
+					compressed = append(compressed, part...)
+				}
+			}
+
+			checksum := sha256.Sum256(compressed)
+			require.Equal(t, hex.EncodeToString(checksum[:]), string(data[alertmanagerConfigChecksumFile]))
+
+			r, err := gzip.NewReader(bytes.NewReader(compressed))
+			require.NoError(t, err)
+			conf, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, tc.conf, conf)
+		})
+	}
+}
+
+func TestCreateOrUpdateGeneratedConfigSecrets(t *testing.T) {
+	// Random data doesn't compress well which makes it possible to exceed
+	// the maximum size of a Secret.
+	b := make([]byte, operator.MaxSecretDataSizeBytes)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: "monitoring",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			BaseImage: "quay.io/prometheus/alertmanager",
+		},
+	}
+	key := operator.KeyForObject(am)
+
+	c := fake.NewClientset()
+	o := &Operator{
+		kclient:         c,
+		logger:          newNopLogger(t),
+		reconciliations: &operator.ReconciliationTracker{},
+	}
+
+	o.recordDeprecatedFields(key, o.logger, am)
+	configSecrets, err := o.createOrUpdateGeneratedConfigSecrets(context.Background(), am, []byte(hex.EncodeToString(b)), nil)
+	require.NoError(t, err)
+
+	// The first Secret keeps the name of the non-sharded Secret.
+	var names []string
+	for _, src := range configSecrets.Volume(alertmanagerConfigVolumeName).Projected.Sources {
+		names = append(names, src.Secret.Name)
+	}
+	require.Equal(t, []string{"alertmanager-main-generated", "alertmanager-main-generated-1"}, names)
+
+	// The size warning doesn't discard the deprecation warning.
+	condition := o.reconciliations.GetCondition(key, 0)
+	require.Equal(t, operator.ConfigurationSizeNearLimitReason, condition.Reason)
+	require.Contains(t, condition.Message, "the generated configuration uses")
+	require.Contains(t, condition.Message, "spec.baseImage")
+
+	// The excess Secret is removed when the configuration shrinks.
+	_, err = o.createOrUpdateGeneratedConfigSecrets(context.Background(), am, []byte("route:\n  receiver: null\n"), nil)
+	require.NoError(t, err)
+
+	secrets, err := c.CoreV1().Secrets(am.Namespace).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, secrets.Items, 1)
+	require.Equal(t, "alertmanager-main-generated", secrets.Items[0].Name)
+}
+
 // alwaysAllowed implements SelfSubjectAccessReviewInterface.
 type alwaysAllowed struct{}
 
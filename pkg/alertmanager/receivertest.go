@@ -0,0 +1,552 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/url"
+	"path"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/prometheus/alertmanager/config"
+	amreceiver "github.com/prometheus/alertmanager/config/receiver"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+	"github.com/prometheus/common/model"
+	"gopkg.in/yaml.v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+const (
+	// receiverTestTimeout is the maximum time allowed to send the
+	// notifications of a ReceiverTest object.
+	receiverTestTimeout = 30 * time.Second
+
+	receiverTestAlertName = "ReceiverTest"
+)
+
+// statusCodeRe matches the status code in the errors returned by the
+// notifiers.
+var statusCodeRe = regexp.MustCompile(`unexpected status code \d+`)
+
+// receiverTestInput holds the configuration generated for an Alertmanager
+// object and the files it references in the Alertmanager container.
+type receiverTestInput struct {
+	config []byte
+	// files maps the path of the files in the Alertmanager container to
+	// their content.
+	files       map[string][]byte
+	externalURL *url.URL
+	// err is set when the configuration couldn't be generated.
+	err error
+}
+
+// receiverTestResult is the status computed for a given generation of a
+// ReceiverTest object.
+type receiverTestResult struct {
+	generation int64
+	status     monitoringv1alpha1.ReceiverTestStatus
+}
+
+// newReceiverTestInput returns the input of the receiver tests from the
+// configuration generated for the Alertmanager object.
+func newReceiverTestInput(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.StoreBuilder, cfg []byte, additionalData map[string][]byte) *receiverTestInput {
+	files := make(map[string][]byte)
+	for k, v := range additionalData {
+		files[path.Join(alertmanagerConfigDir, k)] = v
+	}
+
+	for k, v := range store.TLSAssets() {
+		files[path.Join(tlsAssetsDir, k)] = v
+	}
+
+	if am.Spec.AlertmanagerConfiguration != nil {
+		for _, tmpl := range am.Spec.AlertmanagerConfiguration.Templates {
+			content, err := store.GetKey(ctx, am.Namespace, tmpl)
+			if err != nil {
+				return &receiverTestInput{err: fmt.Errorf("failed to get template: %w", err)}
+			}
+
+			key := ""
+			switch {
+			case tmpl.ConfigMap != nil:
+				key = tmpl.ConfigMap.Key
+			case tmpl.Secret != nil:
+				key = tmpl.Secret.Key
+			}
+			files[path.Join(alertmanagerTemplatesDir, key)] = []byte(content)
+		}
+	}
+
+	externalURL := am.Spec.ExternalURL
+	if externalURL == "" {
+		externalURL = fmt.Sprintf("http://%s.%s.svc:9093", ptr.Deref(am.Spec.ServiceName, defaultOperatedServiceName), am.Namespace)
+	}
+
+	u, err := url.Parse(externalURL)
+	if err != nil {
+		return &receiverTestInput{err: fmt.Errorf("invalid external URL: %w", err)}
+	}
+
+	return &receiverTestInput{
+		config:      cfg,
+		files:       files,
+		externalURL: u,
+	}
+}
+
+// updateReceiverTestInput records the input of the receiver tests for the
+// Alertmanager object identified by key and enqueues the ReceiverTest
+// objects waiting for it. It is a no-op unless the receiver tests are
+// enabled.
+func (c *Operator) updateReceiverTestInput(key string, input *receiverTestInput) {
+	if c.rcvTestInfs == nil {
+		return
+	}
+
+	c.receiverTestMtx.Lock()
+	c.receiverTestInputs[key] = input
+	c.receiverTestMtx.Unlock()
+
+	c.enqueueReceiverTestsFor(key)
+}
+
+// forgetReceiverTestInput removes the input of the receiver tests for the
+// Alertmanager object identified by key.
+func (c *Operator) forgetReceiverTestInput(key string) {
+	if c.rcvTestInfs == nil {
+		return
+	}
+
+	c.receiverTestMtx.Lock()
+	delete(c.receiverTestInputs, key)
+	c.receiverTestMtx.Unlock()
+
+	c.enqueueReceiverTestsFor(key)
+}
+
+// enqueueReceiverTestsFor enqueues the pending ReceiverTest objects which
+// reference the Alertmanager object identified by key.
+func (c *Operator) enqueueReceiverTestsFor(key string) {
+	err := c.rcvTestInfs.ListAll(labels.Everything(), func(obj any) {
+		rt := obj.(*monitoringv1alpha1.ReceiverTest)
+		if isReceiverTestPending(rt) && receiverTestAlertmanagerKey(rt) == key {
+			c.enqueueReceiverTest(rt)
+		}
+	})
+	if err != nil {
+		c.logger.Error("failed to list ReceiverTest objects", "err", err)
+	}
+}
+
+// handleReceiverTest enqueues a ReceiverTest object which hasn't been tested
+// yet.
+func (c *Operator) handleReceiverTest(obj any) {
+	rt, ok := obj.(*monitoringv1alpha1.ReceiverTest)
+	if !ok || !isReceiverTestPending(rt) {
+		return
+	}
+
+	c.enqueueReceiverTest(rt)
+}
+
+// handleReceiverTestDelete forgets the result of a deleted ReceiverTest
+// object.
+func (c *Operator) handleReceiverTestDelete(obj any) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+
+	c.receiverTestMtx.Lock()
+	defer c.receiverTestMtx.Unlock()
+
+	delete(c.receiverTestResults, key)
+}
+
+func (c *Operator) enqueueReceiverTest(rt *monitoringv1alpha1.ReceiverTest) {
+	key, err := cache.MetaNamespaceKeyFunc(rt)
+	if err != nil {
+		return
+	}
+
+	c.receiverTestQueue.Add(key)
+}
+
+// runReceiverTests processes the ReceiverTest objects until the context is
+// canceled. The notifications are sent independently of the reconciliation
+// of the Alertmanager objects.
+func (c *Operator) runReceiverTests(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		c.receiverTestQueue.ShutDown()
+	}()
+
+	for c.processNextReceiverTest(ctx) {
+	}
+}
+
+func (c *Operator) processNextReceiverTest(ctx context.Context) bool {
+	key, quit := c.receiverTestQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.receiverTestQueue.Done(key)
+
+	if err := c.syncReceiverTest(ctx, key); err != nil {
+		c.logger.Warn("failed to process ReceiverTest", "receivertest", key, "err", err)
+		c.receiverTestQueue.AddRateLimited(key)
+		return true
+	}
+
+	c.receiverTestQueue.Forget(key)
+	return true
+}
+
+// syncReceiverTest sends the test notification for the ReceiverTest object
+// identified by key and records the outcome in its status.
+//
+// The notification is sent at most once per generation of the object: if
+// the status can't be updated, the next attempt reuses the outcome of the
+// first one.
+func (c *Operator) syncReceiverTest(ctx context.Context, key string) error {
+	obj, err := c.rcvTestInfs.Get(key)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	rt := obj.(*monitoringv1alpha1.ReceiverTest).DeepCopy()
+	if !isReceiverTestPending(rt) {
+		return nil
+	}
+
+	c.receiverTestMtx.Lock()
+	result, found := c.receiverTestResults[key]
+	input := c.receiverTestInputs[receiverTestAlertmanagerKey(rt)]
+	c.receiverTestMtx.Unlock()
+
+	if !found || result.generation != rt.Generation {
+		var status monitoringv1alpha1.ReceiverTestStatus
+		switch {
+		case input == nil:
+			amKey := receiverTestAlertmanagerKey(rt)
+			_, err := c.alrtInfs.Get(amKey)
+			if err == nil {
+				// The configuration of the Alertmanager object hasn't been
+				// generated yet, the object will be enqueued again once it
+				// is.
+				return nil
+			}
+
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			status = monitoringv1alpha1.ReceiverTestStatus{
+				Outcome: monitoringv1alpha1.ReceiverTestFailed,
+				Message: fmt.Sprintf("Alertmanager %q not found", amKey),
+			}
+		case input.err != nil:
+			status = monitoringv1alpha1.ReceiverTestStatus{
+				Outcome: monitoringv1alpha1.ReceiverTestFailed,
+				Message: fmt.Sprintf("failed to generate the configuration: %s", input.err),
+			}
+		default:
+			tctx, cancel := context.WithTimeout(ctx, receiverTestTimeout)
+			status = testReceiver(tctx, c.logger.With("receivertest", key), input, rt)
+			cancel()
+		}
+		status.ObservedGeneration = rt.Generation
+
+		result = receiverTestResult{generation: rt.Generation, status: status}
+		c.receiverTestMtx.Lock()
+		c.receiverTestResults[key] = result
+		c.receiverTestMtx.Unlock()
+	}
+
+	rt.Status = result.status
+	if _, err := c.mclient.MonitoringV1alpha1().ReceiverTests(rt.Namespace).UpdateStatus(ctx, rt, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update the status: %w", err)
+	}
+
+	return nil
+}
+
+func isReceiverTestPending(rt *monitoringv1alpha1.ReceiverTest) bool {
+	return rt.Status.ObservedGeneration != rt.Generation
+}
+
+// receiverTestAlertmanagerKey returns the key of the Alertmanager object
+// referenced by the ReceiverTest object.
+func receiverTestAlertmanagerKey(rt *monitoringv1alpha1.ReceiverTest) string {
+	return ptr.Deref(rt.Spec.Alertmanager.Namespace, rt.Namespace) + "/" + rt.Spec.Alertmanager.Name
+}
+
+// testReceiver sends a synthetic notification through all the integrations
+// of the receiver referenced by the ReceiverTest object. The context's
+// deadline applies to all the integrations.
+func testReceiver(ctx context.Context, logger *slog.Logger, input *receiverTestInput, rt *monitoringv1alpha1.ReceiverTest) monitoringv1alpha1.ReceiverTestStatus {
+	now := time.Now()
+	status := monitoringv1alpha1.ReceiverTestStatus{
+		TestTime: ptr.To(metav1.NewTime(now)),
+		Outcome:  monitoringv1alpha1.ReceiverTestFailed,
+	}
+
+	integrations, err := buildReceiverIntegrations(logger, input, rt)
+	if err != nil {
+		status.Message = err.Error()
+		return status
+	}
+
+	lset := model.LabelSet{model.AlertNameLabel: receiverTestAlertName}
+	for k, v := range rt.Spec.Labels {
+		lset[model.LabelName(k)] = model.LabelValue(v)
+	}
+	lset["namespace"] = model.LabelValue(rt.Namespace)
+
+	annotations := model.LabelSet{}
+	for k, v := range rt.Spec.Annotations {
+		annotations[model.LabelName(k)] = model.LabelValue(v)
+	}
+
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:       lset,
+			Annotations:  annotations,
+			StartsAt:     now,
+			GeneratorURL: input.externalURL.String(),
+		},
+		UpdatedAt: now,
+	}
+
+	ctx = notify.WithGroupKey(ctx, fmt.Sprintf("%s/%s", rt.Namespace, rt.Name))
+	ctx = notify.WithReceiverName(ctx, receiverNameFor(rt))
+	ctx = notify.WithGroupLabels(ctx, lset)
+	ctx = notify.WithNow(ctx, now)
+	ctx = notify.WithRepeatInterval(ctx, time.Hour)
+
+	status.Outcome = monitoringv1alpha1.ReceiverTestSucceeded
+	for _, integration := range integrations {
+		result := monitoringv1alpha1.ReceiverTestResult{
+			Integration: integration.Name(),
+			Index:       int32(integration.Index()),
+			Outcome:     monitoringv1alpha1.ReceiverTestSucceeded,
+		}
+
+		start := time.Now()
+		_, err := integration.Notify(ctx, alert)
+		result.Latency = metav1.Duration{Duration: time.Since(start)}
+
+		if err != nil {
+			logger.Debug("test notification failed", "integration", integration.String(), "err", err)
+			result.Outcome = monitoringv1alpha1.ReceiverTestFailed
+			result.Error = notificationError(err)
+			status.Outcome = monitoringv1alpha1.ReceiverTestFailed
+		}
+
+		status.Results = append(status.Results, result)
+	}
+
+	return status
+}
+
+// notificationError returns a description of the notification error which
+// doesn't include the response of the endpoint nor the details of network
+// errors: the tenant shouldn't be able to use the test to read from
+// endpoints reachable from the operator.
+func notificationError(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "notification timed out"
+	}
+
+	if m := statusCodeRe.FindString(err.Error()); m != "" {
+		return m
+	}
+
+	var errWithReason *notify.ErrorWithReason
+	if errors.As(err, &errWithReason) {
+		return fmt.Sprintf("notification failed (reason: %s)", errWithReason.Reason)
+	}
+
+	return "notification failed"
+}
+
+// receiverNameFor returns the name of the receiver in the generated
+// configuration.
+func receiverNameFor(rt *monitoringv1alpha1.ReceiverTest) string {
+	return makeNamespacedString(
+		rt.Spec.Receiver,
+		k8stypes.NamespacedName{Namespace: rt.Namespace, Name: rt.Spec.AlertmanagerConfig},
+	)
+}
+
+// buildReceiverIntegrations returns the Alertmanager integrations of the
+// receiver referenced by the ReceiverTest object. The files referenced by
+// the receiver and the templates are read from memory.
+func buildReceiverIntegrations(logger *slog.Logger, input *receiverTestInput, rt *monitoringv1alpha1.ReceiverTest) ([]notify.Integration, error) {
+	cfg, err := config.Load(string(input.config))
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	name := receiverNameFor(rt)
+	var rcv *config.Receiver
+	for i := range cfg.Receivers {
+		if cfg.Receivers[i].Name == name {
+			rcv = &cfg.Receivers[i]
+			break
+		}
+	}
+
+	if rcv == nil {
+		return nil, fmt.Errorf("receiver %q not found in the configuration of Alertmanager (the AlertmanagerConfig resource may not be selected or be invalid)", name)
+	}
+
+	if err := inlineFiles(reflect.ValueOf(rcv).Elem(), input.files); err != nil {
+		return nil, err
+	}
+
+	tmpl, err := loadTemplates(cfg.Templates, input.files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the templates: %w", err)
+	}
+	tmpl.ExternalURL = input.externalURL
+
+	integrations, err := amreceiver.BuildReceiverIntegrations(*rcv, tmpl, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the integrations: %w", err)
+	}
+
+	if len(integrations) == 0 {
+		return nil, fmt.Errorf("receiver %q has no integration", name)
+	}
+
+	return integrations, nil
+}
+
+// loadTemplates returns the templates matching the glob patterns from the
+// in-memory files.
+func loadTemplates(patterns []string, files map[string][]byte) (*template.Template, error) {
+	tmpl, err := template.New()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := slices.Sorted(maps.Keys(files))
+	for _, pattern := range patterns {
+		for _, p := range paths {
+			matched, err := path.Match(pattern, p)
+			if err != nil {
+				return nil, err
+			}
+
+			if !matched {
+				continue
+			}
+
+			if err := tmpl.Parse(bytes.NewReader(files[p])); err != nil {
+				return nil, fmt.Errorf("%s: %w", p, err)
+			}
+		}
+	}
+
+	return tmpl, nil
+}
+
+// inlineFiles replaces the "<Name>File" fields of the configuration by the
+// content of the file in the "<Name>" field (e.g. "CAFile" and "CA" for the
+// TLS configuration) or by the value parsed from the file for non-string
+// fields (e.g. "URLFile" and "URL" for the webhook configuration). It fails
+// if the file isn't known or if there's no field for the content.
+func inlineFiles(v reflect.Value, files map[string][]byte) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		return inlineFiles(v.Elem(), files)
+
+	case reflect.Slice:
+		for i := range v.Len() {
+			if err := inlineFiles(v.Index(i), files); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			fv := v.Field(i)
+			if f.Type.Kind() != reflect.String || !strings.HasSuffix(f.Name, "File") || len(f.Name) == len("File") {
+				if err := inlineFiles(fv, files); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if fv.String() == "" {
+				continue
+			}
+
+			content, found := files[fv.String()]
+			if !found {
+				return fmt.Errorf("file %q (%s) isn't available to the operator", fv.String(), f.Name)
+			}
+
+			target := v.FieldByName(strings.TrimSuffix(f.Name, "File"))
+			if !target.IsValid() || !target.CanSet() {
+				return fmt.Errorf("file references aren't supported for the %s field", f.Name)
+			}
+
+			// Like Alertmanager, ignore the leading and trailing whitespaces.
+			value := strings.TrimSpace(string(content))
+			if target.Kind() == reflect.String {
+				target.SetString(value)
+			} else if err := yaml.Unmarshal([]byte(value), target.Addr().Interface()); err != nil {
+				return fmt.Errorf("invalid content for file %q (%s): %w", fv.String(), f.Name, err)
+			}
+			fv.SetString("")
+		}
+	}
+
+	return nil
+}
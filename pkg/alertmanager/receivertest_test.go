@@ -0,0 +1,327 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/common/promslog"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+)
+
+func TestTestReceiver(t *testing.T) {
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.URL.Path+" "+r.Header.Get("Authorization"))
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte("internal response"))
+			return
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := fmt.Sprintf(`route:
+  receiver: "null"
+receivers:
+- name: "null"
+- name: ns/amc/ok
+  webhook_configs:
+  - url: %[1]s/ok
+    send_resolved: false
+    http_config:
+      authorization:
+        credentials_file: /etc/alertmanager/config/token
+- name: ns/amc/fail
+  webhook_configs:
+  - url: %[1]s/ok
+    send_resolved: false
+  - url: %[1]s/fail
+    send_resolved: false
+    max_alerts: 0
+- name: ns/amc/empty
+`, srv.URL)
+
+	externalURL, err := url.Parse("http://alertmanager.example.com")
+	require.NoError(t, err)
+
+	input := &receiverTestInput{
+		config: []byte(cfg),
+		files: map[string][]byte{
+			"/etc/alertmanager/config/token": []byte("secret"),
+		},
+		externalURL: externalURL,
+	}
+
+	for _, tc := range []struct {
+		name     string
+		receiver string
+		outcome  monitoringv1alpha1.ReceiverTestOutcome
+		message  string
+		results  []monitoringv1alpha1.ReceiverTestOutcome
+		errors   []string
+		received []string
+	}{
+		{
+			name:     "success",
+			receiver: "ok",
+			outcome:  monitoringv1alpha1.ReceiverTestSucceeded,
+			results:  []monitoringv1alpha1.ReceiverTestOutcome{monitoringv1alpha1.ReceiverTestSucceeded},
+			received: []string{"/ok Bearer secret"},
+		},
+		{
+			name:     "partial failure",
+			receiver: "fail",
+			outcome:  monitoringv1alpha1.ReceiverTestFailed,
+			results: []monitoringv1alpha1.ReceiverTestOutcome{
+				monitoringv1alpha1.ReceiverTestSucceeded,
+				monitoringv1alpha1.ReceiverTestFailed,
+			},
+			// The response body mustn't be exposed.
+			errors:   []string{"", "unexpected status code 500"},
+			received: []string{"/ok ", "/fail "},
+		},
+		{
+			name:     "receiver not found",
+			receiver: "missing",
+			outcome:  monitoringv1alpha1.ReceiverTestFailed,
+			message:  `receiver "ns/amc/missing" not found`,
+		},
+		{
+			name:     "receiver without integration",
+			receiver: "empty",
+			outcome:  monitoringv1alpha1.ReceiverTestFailed,
+			message:  `receiver "ns/amc/empty" has no integration`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			received = nil
+
+			rt := &monitoringv1alpha1.ReceiverTest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "ns",
+				},
+				Spec: monitoringv1alpha1.ReceiverTestSpec{
+					Alertmanager:       monitoringv1alpha1.AlertmanagerReference{Name: "am"},
+					AlertmanagerConfig: "amc",
+					Receiver:           tc.receiver,
+				},
+			}
+
+			status := testReceiver(context.Background(), promslog.NewNopLogger(), input, rt)
+
+			require.Equal(t, tc.outcome, status.Outcome)
+			require.Contains(t, status.Message, tc.message)
+			require.NotNil(t, status.TestTime)
+			require.Len(t, status.Results, len(tc.results))
+			for i, res := range status.Results {
+				require.Equal(t, "webhook", res.Integration)
+				require.Equal(t, int32(i), res.Index)
+				require.Equal(t, tc.results[i], res.Outcome, res.Error)
+				if tc.errors != nil {
+					require.Equal(t, tc.errors[i], res.Error)
+				}
+			}
+			require.Equal(t, tc.received, received)
+		})
+	}
+}
+
+func TestReceiverTestAlertmanagerKey(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		namespace string
+		ref       monitoringv1alpha1.AlertmanagerReference
+		exp       string
+	}{
+		{
+			name:      "same namespace",
+			namespace: "monitoring",
+			ref:       monitoringv1alpha1.AlertmanagerReference{Name: "am"},
+			exp:       "monitoring/am",
+		},
+		{
+			name:      "other namespace",
+			namespace: "default",
+			ref:       monitoringv1alpha1.AlertmanagerReference{Name: "am", Namespace: ptr.To("monitoring")},
+			exp:       "monitoring/am",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rt := &monitoringv1alpha1.ReceiverTest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tc.namespace},
+				Spec:       monitoringv1alpha1.ReceiverTestSpec{Alertmanager: tc.ref},
+			}
+
+			require.Equal(t, tc.exp, receiverTestAlertmanagerKey(rt))
+		})
+	}
+}
+
+func TestSyncReceiverTest(t *testing.T) {
+	var received int
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		received++
+	}))
+	t.Cleanup(srv.Close)
+
+	rt := &monitoringv1alpha1.ReceiverTest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test",
+			Namespace:  "ns",
+			Generation: 1,
+		},
+		Spec: monitoringv1alpha1.ReceiverTestSpec{
+			Alertmanager:       monitoringv1alpha1.AlertmanagerReference{Name: "am"},
+			AlertmanagerConfig: "amc",
+			Receiver:           "ok",
+		},
+	}
+
+	mclient := monitoringfake.NewSimpleClientset(rt)
+
+	// Fail the first status update.
+	var updates int
+	mclient.PrependReactor("update", "receivertests", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "status" {
+			return false, nil, nil
+		}
+
+		updates++
+		if updates == 1 {
+			return true, nil, errors.New("update failed")
+		}
+
+		return false, nil, nil
+	})
+
+	rcvTestInfs, err := informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			map[string]struct{}{metav1.NamespaceAll: {}},
+			nil,
+			mclient,
+			0,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ReceiverTestName),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	rcvTestInfs.Start(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), rcvTestInfs.HasSynced))
+
+	o := &Operator{
+		mclient:     mclient,
+		logger:      newNopLogger(t),
+		rcvTestInfs: rcvTestInfs,
+	}
+	WithReceiverTest()(o)
+
+	externalURL, err := url.Parse("http://alertmanager.example.com")
+	require.NoError(t, err)
+
+	o.receiverTestInputs["ns/am"] = &receiverTestInput{
+		config: fmt.Appendf(nil, `route:
+  receiver: ns/amc/ok
+receivers:
+- name: ns/amc/ok
+  webhook_configs:
+  - url: %s
+    send_resolved: false
+`, srv.URL),
+		externalURL: externalURL,
+	}
+
+	require.Error(t, o.syncReceiverTest(ctx, "ns/test"))
+	require.Equal(t, 1, received)
+
+	// The retry shouldn't send the notification again.
+	require.NoError(t, o.syncReceiverTest(ctx, "ns/test"))
+	require.Equal(t, 1, received)
+
+	got, err := mclient.MonitoringV1alpha1().ReceiverTests("ns").Get(ctx, "test", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), got.Status.ObservedGeneration)
+	require.Equal(t, monitoringv1alpha1.ReceiverTestSucceeded, got.Status.Outcome)
+}
+
+func TestInlineFiles(t *testing.T) {
+	const cfg = `route:
+  receiver: test
+receivers:
+- name: test
+  webhook_configs:
+  - url_file: /etc/alertmanager/config/url
+    http_config:
+      basic_auth:
+        username: user
+        password_file: /etc/alertmanager/config/password
+      tls_config:
+        ca_file: /etc/alertmanager/certs/ca
+  slack_configs:
+  - api_url_file: /etc/alertmanager/config/slack
+    channel: test
+`
+
+	c, err := config.Load(cfg)
+	require.NoError(t, err)
+
+	rcv := c.Receivers[0]
+	err = inlineFiles(reflect.ValueOf(&rcv).Elem(), map[string][]byte{
+		"/etc/alertmanager/config/url":      []byte("https://example.com/hook\n"),
+		"/etc/alertmanager/config/password": []byte("secret\n"),
+		"/etc/alertmanager/certs/ca":        []byte("ca"),
+		"/etc/alertmanager/config/slack":    []byte("https://slack.example.com/hook"),
+	})
+	require.NoError(t, err)
+
+	wh := rcv.WebhookConfigs[0]
+	require.Empty(t, wh.URLFile)
+	require.Equal(t, "https://example.com/hook", string(wh.URL))
+	require.Empty(t, wh.HTTPConfig.BasicAuth.PasswordFile)
+	require.Equal(t, "secret", string(wh.HTTPConfig.BasicAuth.Password))
+	require.Empty(t, wh.HTTPConfig.TLSConfig.CAFile)
+	require.Equal(t, "ca", wh.HTTPConfig.TLSConfig.CA)
+
+	slack := rcv.SlackConfigs[0]
+	require.Empty(t, slack.APIURLFile)
+	require.Equal(t, "https://slack.example.com/hook", slack.APIURL.String())
+
+	c, err = config.Load(cfg)
+	require.NoError(t, err)
+
+	err = inlineFiles(reflect.ValueOf(&c.Receivers[0]).Elem(), map[string][]byte{})
+	require.ErrorContains(t, err, "isn't available to the operator")
+}
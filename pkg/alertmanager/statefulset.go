@@ -41,10 +41,10 @@ import (
 
 const (
 	// WARNING: Do not use directly - users might specify a different service name!
-	defaultOperatedServiceName = "alertmanager-operated"
+	defaultOperatedServiceName = operator.AlertmanagerGoverningServiceName
 
 	defaultRetention = "120h"
-	defaultPortName  = "web"
+	defaultPortName  = operator.AlertmanagerDefaultPortName
 
 	tlsAssetsVolumeName                = "tls-assets"
 	tlsAssetsDir                       = "/etc/alertmanager/certs"
@@ -61,6 +61,10 @@ const (
 	alertmanagerConfigFile             = "alertmanager.yaml"
 	alertmanagerConfigFileCompressed   = "alertmanager.yaml.gz"
 	alertmanagerConfigEnvsubstFilename = "alertmanager.env.yaml"
+	// alertmanagerConfigChecksumFile contains the SHA256 checksum of the
+	// compressed configuration. Its name must not match the pattern of the
+	// configuration parts.
+	alertmanagerConfigChecksumFile = "alertmanager.yaml.sha256"
 
 	alertmanagerWebPort         = 9093
 	alertmanagerMeshPort        = 9094
@@ -81,7 +85,7 @@ func getServiceName(a *monitoringv1.Alertmanager) string {
 	return ptr.Deref(a.Spec.ServiceName, defaultOperatedServiceName)
 }
 
-func makeStatefulSet(logger *slog.Logger, am *monitoringv1.Alertmanager, config Config, inputHash string, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSet, error) {
+func makeStatefulSet(logger *slog.Logger, am *monitoringv1.Alertmanager, config Config, inputHash string, configSecrets, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSet, error) {
 	// TODO(fabxc): is this the right point to inject defaults?
 	// Ideally we would do it before storing but that's currently not possible.
 	// Potentially an update handler on first insertion.
@@ -107,7 +111,7 @@ func makeStatefulSet(logger *slog.Logger, am *monitoringv1.Alertmanager, config
 		am.Spec.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("200Mi")
 	}
 
-	spec, err := makeStatefulSetSpec(logger, am, config, tlsSecrets)
+	spec, err := makeStatefulSetSpec(logger, am, config, configSecrets, tlsSecrets)
 	if err != nil {
 		return nil, err
 	}
@@ -227,7 +231,7 @@ func makeStatefulSetService(a *monitoringv1.Alertmanager, config Config) *corev1
 	return svc
 }
 
-func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, config Config, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSetSpec, error) {
+func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, config Config, configSecrets, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSetSpec, error) {
 	amVersion := operator.StringValOrDefault(a.Spec.Version, operator.DefaultAlertmanagerVersion)
 	amImagePath, err := operator.BuildImagePath(
 		ptr.Deref(a.Spec.Image, ""),
@@ -456,14 +460,7 @@ func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, conf
 	amArgs = append(amArgs, monitoringv1.Argument{Name: "cluster.reconnect-timeout", Value: "5m"})
 
 	volumes := []corev1.Volume{
-		{
-			Name: alertmanagerConfigVolumeName,
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: generatedConfigSecretName(a.Name),
-				},
-			},
-		},
+		configSecrets.Volume(alertmanagerConfigVolumeName),
 		tlsSecrets.Volume(tlsAssetsVolumeName),
 		{
 			Name: alertmanagerConfigOutVolumeName,
@@ -745,7 +742,13 @@ func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, conf
 			operator.VolumeMounts(configReloaderVolumeMounts),
 			operator.Shard(-1),
 			operator.WebConfigFile(configReloaderWebConfigFile),
-			operator.ConfigFile(path.Join(alertmanagerConfigDir, alertmanagerConfigFileCompressed)),
+			// The compressed configuration may be split into several parts
+			// (see makeGeneratedConfigData()).
+			operator.ConfigFile(path.Join(alertmanagerConfigOutDir, alertmanagerConfigFileCompressed)),
+			operator.ConfigFileParts(
+				path.Join(alertmanagerConfigDir, alertmanagerConfigFileCompressed+"*"),
+				path.Join(alertmanagerConfigDir, alertmanagerConfigChecksumFile),
+			),
 			operator.ConfigEnvsubstFile(path.Join(alertmanagerConfigOutDir, alertmanagerConfigEnvsubstFilename)),
 			operator.ImagePullPolicy(a.Spec.ImagePullPolicy),
 		),
@@ -766,7 +769,13 @@ func makeStatefulSetSpec(logger *slog.Logger, a *monitoringv1.Alertmanager, conf
 			operator.WatchedDirectories(watchedDirectories),
 			operator.VolumeMounts(configReloaderVolumeMounts),
 			operator.Shard(-1),
-			operator.ConfigFile(path.Join(alertmanagerConfigDir, alertmanagerConfigFileCompressed)),
+			// The compressed configuration may be split into several parts
+			// (see makeGeneratedConfigData()).
+			operator.ConfigFile(path.Join(alertmanagerConfigOutDir, alertmanagerConfigFileCompressed)),
+			operator.ConfigFileParts(
+				path.Join(alertmanagerConfigDir, alertmanagerConfigFileCompressed+"*"),
+				path.Join(alertmanagerConfigDir, alertmanagerConfigChecksumFile),
+			),
 			operator.ConfigEnvsubstFile(path.Join(alertmanagerConfigOutDir, alertmanagerConfigEnvsubstFilename)),
 			operator.ImagePullPolicy(a.Spec.ImagePullPolicy),
 		),
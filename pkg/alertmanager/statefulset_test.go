@@ -82,7 +82,7 @@ func TestStatefulSetLabelingAndAnnotations(t *testing.T) {
 			Labels:      labels,
 			Annotations: annotations,
 		},
-	}, defaultTestConfig, "abc", &operator.ShardedSecret{})
+	}, defaultTestConfig, "abc", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 
 	require.NoError(t, err)
 
@@ -108,7 +108,7 @@ func TestStatefulSetStoragePath(t *testing.T) {
 			Labels:      labels,
 			Annotations: annotations,
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 
 	require.NoError(t, err)
 
@@ -137,7 +137,7 @@ func TestPodLabelsAnnotations(t *testing.T) {
 				Labels:      labels,
 			},
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	valLabels, ok := sset.Spec.Template.ObjectMeta.Labels["testlabel"]
@@ -158,7 +158,7 @@ func TestPodLabelsShouldNotBeSelectorLabels(t *testing.T) {
 				Labels: labels,
 			},
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 
 	require.NoError(t, err)
 
@@ -195,7 +195,7 @@ func TestStatefulSetPVC(t *testing.T) {
 				VolumeClaimTemplate: pvc,
 			},
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 
 	require.NoError(t, err)
 	ssetPvc := sset.Spec.VolumeClaimTemplates[0]
@@ -224,7 +224,7 @@ func TestStatefulEmptyDir(t *testing.T) {
 				EmptyDir: &emptyDir,
 			},
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 
 	require.NoError(t, err)
 	ssetVolumes := sset.Spec.Template.Spec.Volumes
@@ -261,7 +261,7 @@ func TestStatefulSetEphemeral(t *testing.T) {
 				Ephemeral: &ephemeral,
 			},
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 
 	require.NoError(t, err)
 	ssetVolumes := sset.Spec.Template.Spec.Volumes
@@ -274,7 +274,7 @@ func TestListenLocal(t *testing.T) {
 		Spec: monitoringv1.AlertmanagerSpec{
 			ListenLocal: true,
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	found := false
@@ -323,7 +323,7 @@ func TestListenTLS(t *testing.T) {
 				},
 			},
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	expectedProbeHandler := func(probePath string) corev1.ProbeHandler {
@@ -369,7 +369,9 @@ func TestListenTLS(t *testing.T) {
 		"--listen-address=:8080",
 		"--web-config-file=/etc/alertmanager/web_config/web-config.yaml",
 		"--reload-url=https://localhost:9093/-/reload",
-		"--config-file=/etc/alertmanager/config/alertmanager.yaml.gz",
+		"--config-file=/etc/alertmanager/config_out/alertmanager.yaml.gz",
+		"--config-file-parts=/etc/alertmanager/config/alertmanager.yaml.gz*",
+		"--config-file-parts-checksum=/etc/alertmanager/config/alertmanager.yaml.sha256",
 		"--config-envsubst-file=/etc/alertmanager/config_out/alertmanager.env.yaml",
 		"--watched-dir=/etc/alertmanager/config",
 	}
@@ -398,7 +400,7 @@ func TestMakeStatefulSetSpecSingleDoubleDashedArgs(t *testing.T) {
 		replicas := int32(3)
 		a.Spec.Replicas = &replicas
 
-		statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+		statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 		require.NoError(t, err)
 
 		amArgs := statefulSet.Template.Spec.Containers[0].Args
@@ -415,7 +417,7 @@ func TestMakeStatefulSetSpecWebRoutePrefix(t *testing.T) {
 	a.Spec.Version = operator.DefaultAlertmanagerVersion
 	a.Spec.Replicas = &replicas
 
-	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	amArgs := statefulSet.Template.Spec.Containers[0].Args
@@ -467,7 +469,7 @@ func TestMakeStatefulSetSpecWebTimeout(t *testing.T) {
 			a.Spec.Version = ts.version
 			a.Spec.Web = ts.web
 
-			ss, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+			ss, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 			require.NoError(t, err)
 
 			args := ss.Template.Spec.Containers[0].Args
@@ -514,7 +516,7 @@ func TestMakeStatefulSetSpecWebConcurrency(t *testing.T) {
 			a.Spec.Version = ts.version
 			a.Spec.Web = ts.web
 
-			ss, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+			ss, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 			require.NoError(t, err)
 
 			args := ss.Template.Spec.Containers[0].Args
@@ -561,7 +563,7 @@ func TestMakeStatefulSetSpecMaxSilences(t *testing.T) {
 			a.Spec.Version = ts.version
 			a.Spec.Limits = ts.limits
 
-			ss, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+			ss, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 			require.NoError(t, err)
 
 			args := ss.Template.Spec.Containers[0].Args
@@ -608,7 +610,7 @@ func TestMakeStatefulSetSpecMaxPerSilenceBytes(t *testing.T) {
 			a.Spec.Version = ts.version
 			a.Spec.Limits = ts.limits
 
-			ss, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+			ss, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 			require.NoError(t, err)
 
 			args := ss.Template.Spec.Containers[0].Args
@@ -631,7 +633,7 @@ func TestMakeStatefulSetSpecPeersWithoutClusterDomain(t *testing.T) {
 		},
 	}
 
-	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	found := false
@@ -662,7 +664,7 @@ func TestMakeStatefulSetSpecPeersWithClusterDomain(t *testing.T) {
 	configWithClusterDomain := defaultTestConfig
 	configWithClusterDomain.ClusterDomain = "custom.cluster"
 
-	statefulSet, err := makeStatefulSetSpec(nil, &a, configWithClusterDomain, &operator.ShardedSecret{})
+	statefulSet, err := makeStatefulSetSpec(nil, &a, configWithClusterDomain, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	amArgs := statefulSet.Template.Spec.Containers[0].Args
@@ -689,7 +691,7 @@ func TestMakeStatefulSetSpecWithCustomServiceName(t *testing.T) {
 	cfg := defaultTestConfig
 	cfg.ClusterDomain = "cluster.local"
 
-	spec, err := makeStatefulSetSpec(nil, am, cfg, &operator.ShardedSecret{})
+	spec, err := makeStatefulSetSpec(nil, am, cfg, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	// Check StatefulSet.Spec.ServiceName
@@ -718,7 +720,7 @@ func TestMakeStatefulSetSpecWithDefaultServiceName(t *testing.T) {
 	cfg := defaultTestConfig
 	cfg.ClusterDomain = "cluster.local"
 
-	spec, err := makeStatefulSetSpec(nil, am, cfg, &operator.ShardedSecret{})
+	spec, err := makeStatefulSetSpec(nil, am, cfg, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	defaultServiceName := "alertmanager-operated"
@@ -739,7 +741,7 @@ func TestMakeStatefulSetSpecAdditionalPeers(t *testing.T) {
 	a.Spec.Replicas = &replicas
 	a.Spec.AdditionalPeers = []string{"example.com"}
 
-	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	peerFound := false
@@ -772,7 +774,7 @@ func TestMakeStatefulSetSpecNotificationTemplates(t *testing.T) {
 			},
 		},
 	}
-	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	var foundConfigReloaderVM, foundVM, foundV bool
@@ -806,7 +808,9 @@ func TestMakeStatefulSetSpecNotificationTemplates(t *testing.T) {
 		"--listen-address=:8080",
 		"--web-config-file=/etc/alertmanager/web_config/web-config.yaml",
 		"--reload-url=http://localhost:9093/-/reload",
-		"--config-file=/etc/alertmanager/config/alertmanager.yaml.gz",
+		"--config-file=/etc/alertmanager/config_out/alertmanager.yaml.gz",
+		"--config-file-parts=/etc/alertmanager/config/alertmanager.yaml.gz*",
+		"--config-file-parts-checksum=/etc/alertmanager/config/alertmanager.yaml.sha256",
 		"--config-envsubst-file=/etc/alertmanager/config_out/alertmanager.env.yaml",
 		"--watched-dir=/etc/alertmanager/config",
 		"--watched-dir=/etc/alertmanager/templates",
@@ -826,7 +830,7 @@ func TestAdditionalSecretsMounted(t *testing.T) {
 		Spec: monitoringv1.AlertmanagerSpec{
 			Secrets: secrets,
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	secret1Found := false
@@ -876,7 +880,7 @@ func TestAlertManagerDefaultBaseImageFlag(t *testing.T) {
 			Labels:      labels,
 			Annotations: annotations,
 		},
-	}, alertManagerBaseImageConfig, "", &operator.ShardedSecret{})
+	}, alertManagerBaseImageConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 
 	require.NoError(t, err)
 
@@ -892,7 +896,7 @@ func TestSHAAndTagAndVersion(t *testing.T) {
 				Tag:     "my-unrelated-tag",
 				Version: "v0.15.3",
 			},
-		}, defaultTestConfig, "", &operator.ShardedSecret{})
+		}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 		require.NoError(t, err)
 
 		image := sset.Spec.Template.Spec.Containers[0].Image
@@ -906,7 +910,7 @@ func TestSHAAndTagAndVersion(t *testing.T) {
 				Tag:     "my-unrelated-tag",
 				Version: "v0.15.3",
 			},
-		}, defaultTestConfig, "", &operator.ShardedSecret{})
+		}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 		require.NoError(t, err)
 
 		image := sset.Spec.Template.Spec.Containers[0].Image
@@ -922,7 +926,7 @@ func TestSHAAndTagAndVersion(t *testing.T) {
 				Version: "v0.15.3",
 				Image:   &image,
 			},
-		}, defaultTestConfig, "", &operator.ShardedSecret{})
+		}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 		require.NoError(t, err)
 
 		resultImage := sset.Spec.Template.Spec.Containers[0].Image
@@ -945,7 +949,7 @@ func TestRetention(t *testing.T) {
 			Spec: monitoringv1.AlertmanagerSpec{
 				Retention: test.specRetention,
 			},
-		}, defaultTestConfig, "", &operator.ShardedSecret{})
+		}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 		require.NoError(t, err)
 
 		amArgs := sset.Spec.Template.Spec.Containers[0].Args
@@ -960,7 +964,7 @@ func TestAdditionalConfigMap(t *testing.T) {
 		Spec: monitoringv1.AlertmanagerSpec{
 			ConfigMaps: []string{"test-cm1"},
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	cmVolumeFound := false
@@ -992,7 +996,7 @@ func TestSidecarResources(t *testing.T) {
 			Spec: monitoringv1.AlertmanagerSpec{},
 		}
 
-		sset, err := makeStatefulSet(nil, am, testConfig, "", &operator.ShardedSecret{})
+		sset, err := makeStatefulSet(nil, am, testConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 		require.NoError(t, err)
 		return sset
 	})
@@ -1001,7 +1005,7 @@ func TestSidecarResources(t *testing.T) {
 func TestTerminationPolicy(t *testing.T) {
 	sset, err := makeStatefulSet(nil, &monitoringv1.Alertmanager{
 		Spec: monitoringv1.AlertmanagerSpec{},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	for _, c := range sset.Spec.Template.Spec.Containers {
@@ -1017,7 +1021,7 @@ func TestClusterListenAddressForSingleReplica(t *testing.T) {
 
 	a.Spec.ForceEnableClusterMode = false
 
-	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	amArgs := statefulSet.Template.Spec.Containers[0].Args
@@ -1032,7 +1036,7 @@ func TestClusterListenAddressForSingleReplicaWithForceEnableClusterMode(t *testi
 	a.Spec.Replicas = &replicas
 	a.Spec.ForceEnableClusterMode = true
 
-	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	amArgs := statefulSet.Template.Spec.Containers[0].Args
@@ -1054,7 +1058,7 @@ func TestClusterListenAddressForMultiReplica(t *testing.T) {
 	a.Spec.Version = operator.DefaultAlertmanagerVersion
 	a.Spec.Replicas = &replicas
 
-	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	amArgs := statefulSet.Template.Spec.Containers[0].Args
@@ -1076,13 +1080,13 @@ func TestExpectStatefulSetMinReadySeconds(t *testing.T) {
 	a.Spec.Replicas = ptr.To(int32(3))
 
 	// assert defaults to zero if nil
-	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+	statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 	require.Equal(t, int32(0), statefulSet.MinReadySeconds)
 
 	// assert set correctly if not nil
 	a.Spec.MinReadySeconds = ptr.To(int32(5))
-	statefulSet, err = makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+	statefulSet, err = makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 	require.Equal(t, int32(5), statefulSet.MinReadySeconds)
 }
@@ -1149,7 +1153,7 @@ func TestPodTemplateConfig(t *testing.T) {
 			HostUsers:          ptr.To(true),
 			HostNetwork:        hostNetwork,
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	require.Equal(t, sset.Spec.Template.Spec.NodeSelector, nodeSelector, "expected node selector to match, want %v, got %v", nodeSelector, sset.Spec.Template.Spec.NodeSelector)
@@ -1178,7 +1182,7 @@ func TestPodHostNetworkConfig(t *testing.T) {
 		Spec: monitoringv1.AlertmanagerSpec{
 			HostNetwork: true,
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	require.True(t, sset.Spec.Template.Spec.HostNetwork, "expected hostNetwork to be true")
@@ -1187,14 +1191,16 @@ func TestPodHostNetworkConfig(t *testing.T) {
 }
 
 func TestConfigReloader(t *testing.T) {
-	baseSet, err := makeStatefulSet(nil, &monitoringv1.Alertmanager{}, defaultTestConfig, "", &operator.ShardedSecret{})
+	baseSet, err := makeStatefulSet(nil, &monitoringv1.Alertmanager{}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	expectedArgsConfigReloader := []string{
 		"--listen-address=:8080",
 		"--web-config-file=/etc/alertmanager/web_config/web-config.yaml",
 		"--reload-url=http://localhost:9093/-/reload",
-		"--config-file=/etc/alertmanager/config/alertmanager.yaml.gz",
+		"--config-file=/etc/alertmanager/config_out/alertmanager.yaml.gz",
+		"--config-file-parts=/etc/alertmanager/config/alertmanager.yaml.gz*",
+		"--config-file-parts-checksum=/etc/alertmanager/config/alertmanager.yaml.sha256",
 		"--config-envsubst-file=/etc/alertmanager/config_out/alertmanager.env.yaml",
 		"--watched-dir=/etc/alertmanager/config",
 	}
@@ -1208,7 +1214,9 @@ func TestConfigReloader(t *testing.T) {
 	expectedArgsInitConfigReloader := []string{
 		"--watch-interval=0",
 		"--listen-address=:8080",
-		"--config-file=/etc/alertmanager/config/alertmanager.yaml.gz",
+		"--config-file=/etc/alertmanager/config_out/alertmanager.yaml.gz",
+		"--config-file-parts=/etc/alertmanager/config/alertmanager.yaml.gz*",
+		"--config-file-parts-checksum=/etc/alertmanager/config/alertmanager.yaml.sha256",
 		"--config-envsubst-file=/etc/alertmanager/config_out/alertmanager.env.yaml",
 	}
 
@@ -1227,7 +1235,7 @@ func TestAutomountServiceAccountToken(t *testing.T) {
 			Spec: monitoringv1.AlertmanagerSpec{
 				AutomountServiceAccountToken: &automountServiceAccountToken,
 			},
-		}, defaultTestConfig, "", &operator.ShardedSecret{})
+		}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 		require.NoError(t, err)
 		require.Equal(t, *sset.Spec.Template.Spec.AutomountServiceAccountToken, automountServiceAccountToken, "AutomountServiceAccountToken not found")
 	}
@@ -1271,7 +1279,7 @@ func TestClusterLabel(t *testing.T) {
 				a.Spec.ClusterLabel = &ts.customClusterLabel
 			}
 
-			ss, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+			ss, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 			require.NoError(t, err)
 
 			args := ss.Template.Spec.Containers[0].Args
@@ -1375,7 +1383,7 @@ func TestMakeStatefulSetSpecTemplatesUniqueness(t *testing.T) {
 	logger := slog.New(slog.DiscardHandler)
 
 	for _, test := range tt {
-		statefulSpec, err := makeStatefulSetSpec(logger, &test.a, defaultTestConfig, &operator.ShardedSecret{})
+		statefulSpec, err := makeStatefulSetSpec(logger, &test.a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 		require.NoError(t, err)
 		volumes := statefulSpec.Template.Spec.Volumes
 		for _, volume := range volumes {
@@ -1431,7 +1439,7 @@ func TestEnableFeatures(t *testing.T) {
 					Replicas:       toPtr(int32(1)),
 					EnableFeatures: test.features,
 				},
-			}, defaultTestConfig, &operator.ShardedSecret{})
+			}, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 			require.NoError(t, err)
 
 			expectedFeatures := make([]string, 0)
@@ -1456,7 +1464,7 @@ func TestValidateAdditionalArgs(t *testing.T) {
 			Replicas:       toPtr(int32(1)),
 			AdditionalArgs: additionalArgs,
 		},
-	}, defaultTestConfig, &operator.ShardedSecret{})
+	}, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	actualArgs := statefulSpec.Template.Spec.Containers[0].Args
@@ -1482,7 +1490,7 @@ func TestStatefulSetDNSPolicyAndDNSConfig(t *testing.T) {
 				},
 			},
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	require.Equal(t, corev1.DNSClusterFirst, sset.Spec.Template.Spec.DNSPolicy, "expected dns policy to match")
@@ -1508,7 +1516,7 @@ func TestPersistentVolumeClaimRetentionPolicy(t *testing.T) {
 				WhenScaled:  appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
 			},
 		},
-	}, defaultTestConfig, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	if sset.Spec.PersistentVolumeClaimRetentionPolicy.WhenDeleted != appsv1.DeletePersistentVolumeClaimRetentionPolicyType {
@@ -1534,7 +1542,7 @@ func TestStatefulSetEnableServiceLinks(t *testing.T) {
 		sset, err := makeStatefulSet(nil, &monitoringv1.Alertmanager{
 			ObjectMeta: metav1.ObjectMeta{},
 			Spec:       monitoringv1.AlertmanagerSpec{EnableServiceLinks: test.expectedEnableService},
-		}, defaultTestConfig, "", &operator.ShardedSecret{})
+		}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 		require.NoError(t, err)
 
 		if test.expectedEnableService != nil {
@@ -1569,7 +1577,7 @@ func TestStatefulSetPodManagementPolicy(t *testing.T) {
 				Spec: monitoringv1.AlertmanagerSpec{
 					PodManagementPolicy: tc.podManagementPolicy,
 				},
-			}, defaultTestConfig, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 
 			require.NoError(t, err)
 			require.Equal(t, tc.exp, sset.Spec.PodManagementPolicy)
@@ -1624,7 +1632,7 @@ func TestStatefulSetUpdateStrategy(t *testing.T) {
 				Spec: monitoringv1.AlertmanagerSpec{
 					UpdateStrategy: tc.updateStrategy,
 				},
-			}, defaultTestConfig, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, "", &operator.ShardedSecret{}, &operator.ShardedSecret{})
 
 			require.NoError(t, err)
 			require.Equal(t, tc.exp, sset.Spec.UpdateStrategy)
@@ -1677,7 +1685,7 @@ func TestMakeStatefulSetSpecDispatchStartDelay(t *testing.T) {
 				},
 			}
 
-			statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{})
+			statefulSet, err := makeStatefulSetSpec(nil, &a, defaultTestConfig, &operator.ShardedSecret{}, &operator.ShardedSecret{})
 			require.NoError(t, err)
 
 			if tc.expContains != "" {
@@ -34,6 +34,24 @@ type alertmanagerConfig struct {
 	MuteTimeIntervals []*timeInterval `yaml:"mute_time_intervals,omitempty"`
 	TimeIntervals     []*timeInterval `yaml:"time_intervals,omitempty"`
 	Templates         []string        `yaml:"templates"`
+	Tracing           *tracingConfig  `yaml:"tracing,omitempty"`
+}
+
+type tracingConfig struct {
+	ClientType       string                   `yaml:"client_type,omitempty"`
+	Endpoint         string                   `yaml:"endpoint,omitempty"`
+	SamplingFraction *float64                 `yaml:"sampling_fraction,omitempty"`
+	Insecure         bool                     `yaml:"insecure,omitempty"`
+	TLSConfig        *tlsConfig               `yaml:"tls_config,omitempty"`
+	Headers          map[string]tracingHeader `yaml:"headers,omitempty"`
+	Compression      string                   `yaml:"compression,omitempty"`
+	Timeout          *model.Duration          `yaml:"timeout,omitempty"`
+}
+
+type tracingHeader struct {
+	Values  []string `yaml:"values,omitempty"`
+	Secrets []string `yaml:"secrets,omitempty"`
+	Files   []string `yaml:"files,omitempty"`
 }
 
 type globalConfig struct {
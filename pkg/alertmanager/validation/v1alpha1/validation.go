@@ -15,6 +15,7 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -31,20 +32,22 @@ import (
 // In particular, it verifies things that can't be modelized with the OpenAPI
 // specification such as routes should refer to an existing receiver.
 func ValidateAlertmanagerConfig(amc *monitoringv1alpha1.AlertmanagerConfig) error {
-	receivers, err := validateReceivers(amc.Spec.Receivers)
+	receivers, err := validateReceivers(amc.Spec.Receivers, amc.Spec.SharedReceivers)
 	if err != nil {
 		return err
 	}
 
-	muteTimeIntervals, err := validateMuteTimeIntervals(amc.Spec.MuteTimeIntervals)
+	timeIntervals, err := validateMuteTimeIntervals(amc.Spec.MuteTimeIntervals, amc.Spec.SharedTimeIntervals)
 	if err != nil {
 		return err
 	}
 
-	return validateRoute(amc.Spec.Route, receivers, muteTimeIntervals, true)
+	return validateRoute(amc.Spec.Route, receivers, timeIntervals, true)
 }
 
-func validateReceivers(receivers []monitoringv1alpha1.Receiver) (map[string]struct{}, error) {
+// validateReceivers validates the receivers and returns the names which can
+// be referenced by the routes (including the shared receivers).
+func validateReceivers(receivers []monitoringv1alpha1.Receiver, sharedReceivers []string) (map[string]struct{}, error) {
 	var err error
 	receiverNames := make(map[string]struct{})
 
@@ -113,6 +116,25 @@ func validateReceivers(receivers []monitoringv1alpha1.Receiver) (map[string]stru
 		if err := validateMSTeamsV2Configs(receiver.MSTeamsV2Configs); err != nil {
 			return nil, fmt.Errorf("failed to validate 'msteamsv2Config' - receiver %s: %w", receiver.Name, err)
 		}
+
+		if err := validateJiraConfigs(receiver.JiraConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'jiraConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
+		if err := validateMattermostConfigs(receiver.MattermostConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'mattermostConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
+		if err := validateIncidentioConfigs(receiver.IncidentioConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'incidentioConfig' - receiver %s: %w", receiver.Name, err)
+		}
+	}
+
+	for _, name := range sharedReceivers {
+		if _, found := receiverNames[name]; found {
+			return nil, fmt.Errorf("shared receiver %q conflicts with a receiver with the same name", name)
+		}
+		receiverNames[name] = struct{}{}
 	}
 
 	return receiverNames, nil
@@ -433,11 +455,85 @@ func validateMSTeamsV2Configs(configs []monitoringv1alpha1.MSTeamsV2Config) erro
 	return nil
 }
 
+func validateJiraConfigs(configs []monitoringv1alpha1.JiraConfig) error {
+	for i, config := range configs {
+		if err := validation.ValidateURLPtr((*string)(config.APIURL)); err != nil {
+			return fmt.Errorf("[%d]: apiURL: %w", i, err)
+		}
+
+		keys := make(map[string]struct{}, len(config.Fields))
+		for j, f := range config.Fields {
+			if f.Key == "" {
+				return fmt.Errorf("[%d]: fields[%d]: missing key", i, j)
+			}
+
+			if _, found := keys[f.Key]; found {
+				return fmt.Errorf("[%d]: fields[%d]: duplicate key %q", i, j, f.Key)
+			}
+			keys[f.Key] = struct{}{}
+
+			if !json.Valid(f.Value.Raw) {
+				return fmt.Errorf("[%d]: fields[%d]: invalid JSON value for key %q", i, j, f.Key)
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateMattermostConfigs(configs []monitoringv1alpha1.MattermostConfig) error {
+	for i, config := range configs {
+		if err := validation.ValidateTemplateURLPtr((*string)(config.IconURL)); err != nil {
+			return fmt.Errorf("[%d]: invalid 'iconURL': %w", i, err)
+		}
+
+		for j, a := range config.Attachments {
+			for name, u := range map[string]*monitoringv1alpha1.URL{
+				"authorLink": a.AuthorLink,
+				"authorIcon": a.AuthorIcon,
+				"titleLink":  a.TitleLink,
+				"thumbURL":   a.ThumbURL,
+				"footerIcon": a.FooterIcon,
+				"imageURL":   a.ImageURL,
+			} {
+				if err := validation.ValidateTemplateURLPtr((*string)(u)); err != nil {
+					return fmt.Errorf("[%d]: attachments[%d]: invalid '%s': %w", i, j, name, err)
+				}
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateIncidentioConfigs(configs []monitoringv1alpha1.IncidentioConfig) error {
+	for i, config := range configs {
+		hasAuthorization := config.HTTPConfig != nil && config.HTTPConfig.Authorization != nil
+		if err := validation.ValidateIncidentioConfig((*string)(config.URL), config.URLSecret, config.AlertSourceToken, hasAuthorization); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
 // validateRoute verifies that the given route and all its children are
 // semantically valid.  because of the self-referential issues mentioned in
 // https://github.com/kubernetes/kubernetes/issues/62872 it is not currently
 // possible to apply OpenAPI validation to a v1alpha1.Route.
-func validateRoute(r *monitoringv1alpha1.Route, receivers, muteTimeIntervals map[string]struct{}, topLevelRoute bool) error {
+func validateRoute(r *monitoringv1alpha1.Route, receivers, timeIntervals map[string]struct{}, topLevelRoute bool) error {
 	if r == nil {
 		return nil
 	}
@@ -465,15 +561,15 @@ func validateRoute(r *monitoringv1alpha1.Route, receivers, muteTimeIntervals map
 		}
 	}
 
-	for _, namedMuteTimeInterval := range r.MuteTimeIntervals {
-		if _, found := muteTimeIntervals[namedMuteTimeInterval]; !found {
-			return fmt.Errorf("mute time interval %q not found", namedMuteTimeInterval)
+	for _, namedTimeInterval := range r.MuteTimeIntervals {
+		if _, found := timeIntervals[namedTimeInterval]; !found {
+			return fmt.Errorf("mute time interval %q not found", namedTimeInterval)
 		}
 	}
 
-	for _, namedActiveTimeInterval := range r.ActiveTimeIntervals {
-		if _, found := muteTimeIntervals[namedActiveTimeInterval]; !found {
-			return fmt.Errorf("time interval %q not found", namedActiveTimeInterval)
+	for _, namedTimeInterval := range r.ActiveTimeIntervals {
+		if _, found := timeIntervals[namedTimeInterval]; !found {
+			return fmt.Errorf("time interval %q not found", namedTimeInterval)
 		}
 	}
 
@@ -490,7 +586,7 @@ func validateRoute(r *monitoringv1alpha1.Route, receivers, muteTimeIntervals map
 	}
 
 	for i := range children {
-		if err := validateRoute(&children[i], receivers, muteTimeIntervals, false); err != nil {
+		if err := validateRoute(&children[i], receivers, timeIntervals, false); err != nil {
 			return fmt.Errorf("route[%d]: %w", i, err)
 		}
 	}
@@ -498,14 +594,28 @@ func validateRoute(r *monitoringv1alpha1.Route, receivers, muteTimeIntervals map
 	return nil
 }
 
-func validateMuteTimeIntervals(muteTimeIntervals []monitoringv1alpha1.MuteTimeInterval) (map[string]struct{}, error) {
-	muteTimeIntervalNames := make(map[string]struct{}, len(muteTimeIntervals))
+// validateMuteTimeIntervals validates the time intervals and returns the names which
+// can be referenced by the routes (including the shared time intervals).
+func validateMuteTimeIntervals(muteTimeIntervals []monitoringv1alpha1.MuteTimeInterval, sharedTimeIntervals []string) (map[string]struct{}, error) {
+	muteTimeIntervalNames := make(map[string]struct{}, len(muteTimeIntervals)+len(sharedTimeIntervals))
 
 	for i, mti := range muteTimeIntervals {
 		if err := mti.Validate(); err != nil {
 			return nil, fmt.Errorf("mute time interval[%d] is invalid: %w", i, err)
 		}
+
+		if _, found := muteTimeIntervalNames[mti.Name]; found {
+			return nil, fmt.Errorf("mute time interval %q is not unique", mti.Name)
+		}
 		muteTimeIntervalNames[mti.Name] = struct{}{}
 	}
+
+	for _, name := range sharedTimeIntervals {
+		if _, found := muteTimeIntervalNames[name]; found {
+			return nil, fmt.Errorf("shared time interval %q conflicts with a mute time interval with the same name", name)
+		}
+		muteTimeIntervalNames[name] = struct{}{}
+	}
+
 	return muteTimeIntervalNames, nil
 }
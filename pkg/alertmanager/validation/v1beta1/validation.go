@@ -15,6 +15,7 @@
 package v1beta1
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -31,12 +32,12 @@ import (
 // In particular, it verifies things that can't be modelized with the OpenAPI
 // specification such as routes should refer to an existing receiver.
 func ValidateAlertmanagerConfig(amc *monitoringv1beta1.AlertmanagerConfig) error {
-	receivers, err := validateReceivers(amc.Spec.Receivers)
+	receivers, err := validateReceivers(amc.Spec.Receivers, amc.Spec.SharedReceivers)
 	if err != nil {
 		return err
 	}
 
-	timeIntervals, err := validateTimeIntervals(amc.Spec.TimeIntervals)
+	timeIntervals, err := validateTimeIntervals(amc.Spec.TimeIntervals, amc.Spec.SharedTimeIntervals)
 	if err != nil {
 		return err
 	}
@@ -44,7 +45,9 @@ func ValidateAlertmanagerConfig(amc *monitoringv1beta1.AlertmanagerConfig) error
 	return validateRoute(amc.Spec.Route, receivers, timeIntervals, true)
 }
 
-func validateReceivers(receivers []monitoringv1beta1.Receiver) (map[string]struct{}, error) {
+// validateReceivers validates the receivers and returns the names which can
+// be referenced by the routes (including the shared receivers).
+func validateReceivers(receivers []monitoringv1beta1.Receiver, sharedReceivers []string) (map[string]struct{}, error) {
 	var err error
 	receiverNames := make(map[string]struct{})
 
@@ -113,6 +116,25 @@ func validateReceivers(receivers []monitoringv1beta1.Receiver) (map[string]struc
 		if err := validateMSTeamsV2Configs(receiver.MSTeamsV2Configs); err != nil {
 			return nil, fmt.Errorf("failed to validate 'msteamsv2Config' - receiver %s: %w", receiver.Name, err)
 		}
+
+		if err := validateJiraConfigs(receiver.JiraConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'jiraConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
+		if err := validateMattermostConfigs(receiver.MattermostConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'mattermostConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
+		if err := validateIncidentioConfigs(receiver.IncidentioConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'incidentioConfig' - receiver %s: %w", receiver.Name, err)
+		}
+	}
+
+	for _, name := range sharedReceivers {
+		if _, found := receiverNames[name]; found {
+			return nil, fmt.Errorf("shared receiver %q conflicts with a receiver with the same name", name)
+		}
+		receiverNames[name] = struct{}{}
 	}
 
 	return receiverNames, nil
@@ -434,6 +456,80 @@ func validateMSTeamsV2Configs(configs []monitoringv1beta1.MSTeamsV2Config) error
 	return nil
 }
 
+func validateJiraConfigs(configs []monitoringv1beta1.JiraConfig) error {
+	for i, config := range configs {
+		if err := validation.ValidateURLPtr((*string)(config.APIURL)); err != nil {
+			return fmt.Errorf("[%d]: apiURL: %w", i, err)
+		}
+
+		keys := make(map[string]struct{}, len(config.Fields))
+		for j, f := range config.Fields {
+			if f.Key == "" {
+				return fmt.Errorf("[%d]: fields[%d]: missing key", i, j)
+			}
+
+			if _, found := keys[f.Key]; found {
+				return fmt.Errorf("[%d]: fields[%d]: duplicate key %q", i, j, f.Key)
+			}
+			keys[f.Key] = struct{}{}
+
+			if !json.Valid(f.Value.Raw) {
+				return fmt.Errorf("[%d]: fields[%d]: invalid JSON value for key %q", i, j, f.Key)
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateMattermostConfigs(configs []monitoringv1beta1.MattermostConfig) error {
+	for i, config := range configs {
+		if err := validation.ValidateTemplateURLPtr((*string)(config.IconURL)); err != nil {
+			return fmt.Errorf("[%d]: invalid 'iconURL': %w", i, err)
+		}
+
+		for j, a := range config.Attachments {
+			for name, u := range map[string]*monitoringv1beta1.URL{
+				"authorLink": a.AuthorLink,
+				"authorIcon": a.AuthorIcon,
+				"titleLink":  a.TitleLink,
+				"thumbURL":   a.ThumbURL,
+				"footerIcon": a.FooterIcon,
+				"imageURL":   a.ImageURL,
+			} {
+				if err := validation.ValidateTemplateURLPtr((*string)(u)); err != nil {
+					return fmt.Errorf("[%d]: attachments[%d]: invalid '%s': %w", i, j, name, err)
+				}
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateIncidentioConfigs(configs []monitoringv1beta1.IncidentioConfig) error {
+	for i, config := range configs {
+		hasAuthorization := config.HTTPConfig != nil && config.HTTPConfig.Authorization != nil
+		if err := validation.ValidateIncidentioConfig((*string)(config.URL), config.URLSecret, config.AlertSourceToken, hasAuthorization); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
 // validateRoute verifies that the given route and all its children are
 // semantically valid.  because of the self-referential issues mentioned in
 // https://github.com/kubernetes/kubernetes/issues/62872 it is not currently
@@ -499,14 +595,28 @@ func validateRoute(r *monitoringv1beta1.Route, receivers, timeIntervals map[stri
 	return nil
 }
 
-func validateTimeIntervals(timeIntervals []monitoringv1beta1.TimeInterval) (map[string]struct{}, error) {
-	timeIntervalNames := make(map[string]struct{}, len(timeIntervals))
+// validateTimeIntervals validates the time intervals and returns the names which
+// can be referenced by the routes (including the shared time intervals).
+func validateTimeIntervals(timeIntervals []monitoringv1beta1.TimeInterval, sharedTimeIntervals []string) (map[string]struct{}, error) {
+	timeIntervalNames := make(map[string]struct{}, len(timeIntervals)+len(sharedTimeIntervals))
 
 	for i, ti := range timeIntervals {
 		if err := ti.Validate(); err != nil {
 			return nil, fmt.Errorf("time interval[%d] is invalid: %w", i, err)
 		}
+
+		if _, found := timeIntervalNames[ti.Name]; found {
+			return nil, fmt.Errorf("time interval %q is not unique", ti.Name)
+		}
 		timeIntervalNames[ti.Name] = struct{}{}
 	}
+
+	for _, name := range sharedTimeIntervals {
+		if _, found := timeIntervalNames[name]; found {
+			return nil, fmt.Errorf("shared time interval %q conflicts with a time interval with the same name", name)
+		}
+		timeIntervalNames[name] = struct{}{}
+	}
+
 	return timeIntervalNames, nil
 }
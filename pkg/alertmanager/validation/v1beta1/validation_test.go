@@ -17,6 +17,8 @@ package v1beta1
 import (
 	"testing"
 
+	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/utils/ptr"
 
 	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
@@ -141,6 +143,179 @@ func TestValidateAlertmanagerConfig(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "Test fail to validate jira config - invalid URL",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "jira",
+							JiraConfigs: []monitoringv1beta1.JiraConfig{
+								{
+									APIURL:    ptr.To(monitoringv1beta1.URL("http://%><invalid.com")),
+									Project:   "OPS",
+									IssueType: "Bug",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate jira config - duplicate field keys",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "jira",
+							JiraConfigs: []monitoringv1beta1.JiraConfig{
+								{
+									Project:   "OPS",
+									IssueType: "Bug",
+									Fields: []monitoringv1beta1.JiraField{
+										{Key: "customfield_10001", Value: apiextensionsv1.JSON{Raw: []byte(`"a"`)}},
+										{Key: "customfield_10001", Value: apiextensionsv1.JSON{Raw: []byte(`"b"`)}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate jira config - invalid field value",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "jira",
+							JiraConfigs: []monitoringv1beta1.JiraConfig{
+								{
+									Project:   "OPS",
+									IssueType: "Bug",
+									Fields: []monitoringv1beta1.JiraField{
+										{Key: "customfield_10001", Value: apiextensionsv1.JSON{Raw: []byte(`{`)}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test validate jira config",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "jira",
+							JiraConfigs: []monitoringv1beta1.JiraConfig{
+								{
+									APIURL:    ptr.To(monitoringv1beta1.URL("https://example.atlassian.net/rest/api/2")),
+									Project:   "OPS",
+									IssueType: "Bug",
+									Fields: []monitoringv1beta1.JiraField{
+										{Key: "customfield_10001", Value: apiextensionsv1.JSON{Raw: []byte(`{"value":"red"}`)}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Test fail to validate incidentio config - missing url",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "incidentio",
+							IncidentioConfigs: []monitoringv1beta1.IncidentioConfig{
+								{
+									AlertSourceToken: &v1.SecretKeySelector{Key: "token"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate incidentio config - missing credentials",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "incidentio",
+							IncidentioConfigs: []monitoringv1beta1.IncidentioConfig{
+								{
+									URL: ptr.To(monitoringv1beta1.URL("https://api.incident.io/v2/alert_events/http/01ABCDEF")),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate mattermost config - invalid attachment URL",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "mattermost",
+							MattermostConfigs: []monitoringv1beta1.MattermostConfig{
+								{
+									Attachments: []monitoringv1beta1.MattermostAttachment{
+										{
+											TitleLink: ptr.To(monitoringv1beta1.URL("http://%><invalid.com")),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test validate incidentio and mattermost configs",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "recv",
+							IncidentioConfigs: []monitoringv1beta1.IncidentioConfig{
+								{
+									URL:              ptr.To(monitoringv1beta1.URL("https://api.incident.io/v2/alert_events/http/01ABCDEF")),
+									AlertSourceToken: &v1.SecretKeySelector{Key: "token"},
+								},
+							},
+							MattermostConfigs: []monitoringv1beta1.MattermostConfig{
+								{
+									WebhookURL: &v1.SecretKeySelector{Key: "url"},
+									Attachments: []monitoringv1beta1.MattermostAttachment{
+										{
+											TitleLink: ptr.To(monitoringv1beta1.URL("https://alertmanager.example.com")),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Test fail to validate email config - missing to field",
 			in: &monitoringv1beta1.AlertmanagerConfig{
@@ -421,6 +596,53 @@ func TestValidateAlertmanagerConfig(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "Test validate routes - shared receiver",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+					},
+					SharedReceivers: []string{"on-call"},
+					Route: &monitoringv1beta1.Route{
+						Receiver: "on-call",
+					},
+				},
+			},
+		},
+		{
+			name: "Test fail to validate routes - shared receiver conflicts with a receiver",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+					},
+					SharedReceivers: []string{"same"},
+					Route: &monitoringv1beta1.Route{
+						Receiver: "same",
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate routes - root route has no receiver",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+					},
+					Route: &monitoringv1beta1.Route{},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "Test fail to validate routes with duplicate groupBy",
 			in: &monitoringv1beta1.AlertmanagerConfig{
@@ -472,6 +694,129 @@ func TestValidateAlertmanagerConfig(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "Test validate routes - named time interval declared as shared",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+					},
+					Route: &monitoringv1beta1.Route{
+						Receiver:          "same",
+						MuteTimeIntervals: []string{"company-holidays"},
+					},
+					SharedTimeIntervals: []string{"company-holidays"},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Test fail to validate time intervals - shared time interval defined locally",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+					},
+					Route: &monitoringv1beta1.Route{
+						Receiver: "same",
+					},
+					TimeIntervals: []monitoringv1beta1.TimeInterval{
+						{
+							Name: "company-holidays",
+						},
+					},
+					SharedTimeIntervals: []string{"company-holidays"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate time intervals - duplicate names",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+					},
+					Route: &monitoringv1beta1.Route{
+						Receiver: "same",
+					},
+					TimeIntervals: []monitoringv1beta1.TimeInterval{
+						{
+							Name: "weekends",
+						},
+						{
+							Name: "weekends",
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate time intervals - invalid location",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+					},
+					Route: &monitoringv1beta1.Route{
+						Receiver: "same",
+					},
+					TimeIntervals: []monitoringv1beta1.TimeInterval{
+						{
+							Name: "business-hours",
+							TimeIntervals: []monitoringv1beta1.TimePeriod{
+								{
+									Location: ptr.To("Europe/Nowhere"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test validate time intervals - valid location",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+					},
+					Route: &monitoringv1beta1.Route{
+						Receiver:            "same",
+						ActiveTimeIntervals: []string{"business-hours"},
+					},
+					TimeIntervals: []monitoringv1beta1.TimeInterval{
+						{
+							Name: "business-hours",
+							TimeIntervals: []monitoringv1beta1.TimePeriod{
+								{
+									Times: []monitoringv1beta1.TimeRange{
+										{
+											StartTime: "09:00",
+											EndTime:   "17:00",
+										},
+									},
+									Location: ptr.To("Europe/Paris"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
 		{
 			name: "Test happy path",
 			in: &monitoringv1beta1.AlertmanagerConfig{
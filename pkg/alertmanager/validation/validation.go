@@ -16,11 +16,13 @@ package validation
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"text/template"
 
 	"github.com/prometheus/alertmanager/config/common"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -80,6 +82,30 @@ func validateStringPtr(s *string, validFn func(string) error) error {
 	return validFn(*s)
 }
 
+// ValidateIncidentioConfig validates the settings of an incident.io
+// receiver which are common to all the AlertmanagerConfig API versions:
+// exactly one of url and urlSecret must be set and the alert source token is
+// mutually exclusive with the HTTP authorization (one of them is required).
+func ValidateIncidentioConfig(url *string, urlSecret, alertSourceToken *corev1.SecretKeySelector, hasAuthorization bool) error {
+	if (url == nil) == (urlSecret == nil) {
+		return errors.New("exactly one of 'url' or 'urlSecret' must be specified")
+	}
+
+	if err := ValidateURLPtr(url); err != nil {
+		return fmt.Errorf("url: %w", err)
+	}
+
+	if alertSourceToken != nil && hasAuthorization {
+		return errors.New("'alertSourceToken' and 'httpConfig.authorization' are mutually exclusive")
+	}
+
+	if alertSourceToken == nil && !hasAuthorization {
+		return errors.New("one of 'alertSourceToken' or 'httpConfig.authorization' must be specified")
+	}
+
+	return nil
+}
+
 // ValidateSecretURL against config.URL
 // This is for URLs which are retrieved from secrets and should not
 // logged as part of the err.
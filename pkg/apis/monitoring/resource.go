@@ -48,6 +48,9 @@ const (
 
 	ThanosRulersKind = "ThanosRuler"
 	ThanosRulerName  = "thanosrulers"
+
+	ReceiverTestsKind = "ReceiverTest"
+	ReceiverTestName  = "receivertests"
 )
 
 var resourceToKindMap = map[string]string{
@@ -61,6 +64,7 @@ var resourceToKindMap = map[string]string{
 	ProbeName:              ProbesKind,
 	ScrapeConfigName:       ScrapeConfigsKind,
 	ThanosRulerName:        ThanosRulersKind,
+	ReceiverTestName:       ReceiverTestsKind,
 }
 
 var kindToResource = map[string]string{
@@ -74,6 +78,7 @@ var kindToResource = map[string]string{
 	ProbesKind:              ProbeName,
 	ScrapeConfigsKind:       ScrapeConfigName,
 	ThanosRulersKind:        ThanosRulerName,
+	ReceiverTestsKind:       ReceiverTestName,
 }
 
 // KindToResource returns the resource name corresponding to the given kind.
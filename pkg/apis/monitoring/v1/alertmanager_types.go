@@ -452,6 +452,8 @@ type AlertmanagerSpec struct {
 	HostUsers *bool `json:"hostUsers,omitempty"` // nolint:kubeapilinter
 }
 
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 'OnLabel' || has(self.label)",message="label is required when type is OnLabel"
+// +kubebuilder:validation:XValidation:rule="!has(self.label) || (has(self.type) && self.type == 'OnLabel')",message="label can only be defined when type is OnLabel"
 type AlertmanagerConfigMatcherStrategy struct {
 	// type defines the strategy used by
 	// AlertmanagerConfig objects to match alerts in the routes and inhibition
@@ -459,10 +461,23 @@ type AlertmanagerConfigMatcherStrategy struct {
 	//
 	// The default value is `OnNamespace`.
 	//
-	// +kubebuilder:validation:Enum="OnNamespace";"OnNamespaceExceptForAlertmanagerNamespace";"None"
+	// +kubebuilder:validation:Enum="OnNamespace";"OnNamespaceExceptForAlertmanagerNamespace";"OnLabel";"None"
 	// +kubebuilder:default:="OnNamespace"
 	// +optional
 	Type AlertmanagerConfigMatcherStrategyType `json:"type,omitempty"`
+
+	// label defines the name of the label used by the `OnLabel` strategy.
+	//
+	// The value is read from the labels of the namespace of the
+	// AlertmanagerConfig object and, only if the namespace doesn't have the
+	// label, from the labels of the object itself. The operator rejects
+	// AlertmanagerConfig objects for which no value can be found.
+	//
+	// It is required when type is `OnLabel`.
+	//
+	// +kubebuilder:validation:Pattern:="^[a-zA-Z_][a-zA-Z0-9_]*$"
+	// +optional
+	Label *string `json:"label,omitempty"`
 }
 
 type AlertmanagerConfigMatcherStrategyType string
@@ -479,6 +494,12 @@ const (
 	// is in the same namespace as the Alertmanager object, where it will process all alerts.
 	OnNamespaceExceptForAlertmanagerNamespaceConfigMatcherStrategyType AlertmanagerConfigMatcherStrategyType = "OnNamespaceExceptForAlertmanagerNamespace"
 
+	// With `OnLabel`, the route and inhibition rules of an AlertmanagerConfig
+	// object only process alerts that have a label (defined by the `label`
+	// field) equal to the value of the same label on the object's namespace
+	// or, if not present, on the object.
+	OnLabelConfigMatcherStrategyType AlertmanagerConfigMatcherStrategyType = "OnLabel"
+
 	// With `None`, the route and inhibition rules of an AlertmanagerConfig
 	// object process all incoming alerts.
 	NoneConfigMatcherStrategyType AlertmanagerConfigMatcherStrategyType = "None"
@@ -499,6 +520,39 @@ type AlertmanagerConfiguration struct {
 	// templates defines the custom notification templates.
 	// +optional
 	Templates []SecretOrConfigMap `json:"templates,omitempty"`
+	// sharedReceivers defines the receivers of the AlertmanagerConfig
+	// resource which can be referenced by the routes of the other
+	// AlertmanagerConfig resources selected by the Alertmanager object.
+	//
+	// The other AlertmanagerConfig resources reference a shared receiver by
+	// declaring its name in `spec.sharedReceivers`.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	SharedReceivers []SharedReceiver `json:"sharedReceivers,omitempty"`
+	// tracing defines the configuration of the OpenTelemetry tracing
+	// exporter for the notification pipelines.
+	//
+	// It requires Alertmanager >= v0.30.0.
+	// +optional
+	Tracing *TracingConfig `json:"tracing,omitempty"`
+}
+
+// SharedReceiver defines a receiver which can be referenced by the routes of
+// AlertmanagerConfig resources from other namespaces.
+type SharedReceiver struct {
+	// name defines the name of the receiver in the AlertmanagerConfig
+	// resource referenced by `spec.alertmanagerConfiguration.name`.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// namespaceSelector defines the namespaces of the AlertmanagerConfig
+	// resources which are allowed to reference the receiver.
+	//
+	// When not defined, all the AlertmanagerConfig resources selected by the
+	// Alertmanager object can reference the receiver.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 }
 
 // AlertmanagerGlobalConfig configures parameters that are valid in all other configuration contexts.
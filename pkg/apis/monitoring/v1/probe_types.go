@@ -200,12 +200,26 @@ type ProbeTargets struct {
 	// If `staticConfig` is also defined, `staticConfig` takes precedence.
 	// +optional
 	Ingress *ProbeTargetIngress `json:"ingress,omitempty"`
+	// service defines the Service objects to probe and the relabeling
+	// configuration.
+	// If `staticConfig` or `ingress` is also defined, they take precedence.
+	// +optional
+	Service *ProbeTargetService `json:"service,omitempty"`
+	// httpRoute defines the Gateway API HTTPRoute objects to probe and the
+	// relabeling configuration.
+	// If `staticConfig`, `ingress` or `service` is also defined, they take
+	// precedence.
+	//
+	// It requires the HTTPRoute CRD (`gateway.networking.k8s.io/v1`) to be
+	// installed in the cluster, otherwise the Probe is rejected.
+	// +optional
+	HTTPRoute *ProbeTargetHTTPRoute `json:"httpRoute,omitempty"`
 }
 
 // Validate semantically validates the given ProbeTargets.
 func (it *ProbeTargets) Validate() error {
-	if it.StaticConfig == nil && it.Ingress == nil {
-		return errors.New("at least one of .spec.targets.staticConfig and .spec.targets.ingress is required")
+	if it.StaticConfig == nil && it.Ingress == nil && it.Service == nil && it.HTTPRoute == nil {
+		return errors.New("at least one of .spec.targets.staticConfig, .spec.targets.ingress, .spec.targets.service and .spec.targets.httpRoute is required")
 	}
 
 	return nil
@@ -249,6 +263,68 @@ type ProbeTargetIngress struct {
 	RelabelConfigs []RelabelConfig `json:"relabelingConfigs,omitempty"`
 }
 
+// ProbeTargetService defines the set of Service objects considered for probing.
+// The operator configures a target for each port of each service object
+// using the `<scheme>://<service>.<namespace>.svc:<port><path>` URL.
+// +k8s:openapi-gen=true
+type ProbeTargetService struct {
+	// selector to select the Service objects.
+	// +optional
+	Selector metav1.LabelSelector `json:"selector,omitempty"`
+	// namespaceSelector defines from which namespaces to select Service objects.
+	// +optional
+	NamespaceSelector NamespaceSelector `json:"namespaceSelector,omitempty"`
+	// port defines the name of the service port to probe.
+	// If empty, all the ports of the selected services are probed.
+	// +optional
+	Port string `json:"port,omitempty"`
+	// scheme defines the HTTP scheme of the probed URL.
+	// Defaults to `http`.
+	// +kubebuilder:validation:Enum=http;https
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
+	// path defines the HTTP path of the probed URL.
+	// Defaults to `/`.
+	// +kubebuilder:validation:Pattern:="^/.*$"
+	// +optional
+	Path string `json:"path,omitempty"`
+	// relabelingConfigs to apply to the label set of the target before it gets
+	// scraped.
+	// The original service address is available via the
+	// `__tmp_service_address` label. It can be used to customize the
+	// probed URL.
+	// The original scrape job's name is available via the `__tmp_prometheus_job_name` label.
+	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+	// +optional
+	RelabelConfigs []RelabelConfig `json:"relabelingConfigs,omitempty"`
+}
+
+// ProbeTargetHTTPRoute defines the set of Gateway API HTTPRoute objects
+// considered for probing.
+// The operator configures a target for each hostname/path combination of each
+// HTTPRoute object. Only the `Exact` and `PathPrefix` path matches are
+// considered. Wildcard hostnames and routes without hostname are ignored.
+// +k8s:openapi-gen=true
+type ProbeTargetHTTPRoute struct {
+	// selector to select the HTTPRoute objects.
+	// +optional
+	Selector metav1.LabelSelector `json:"selector,omitempty"`
+	// namespaceSelector defines from which namespaces to select HTTPRoute objects.
+	// +optional
+	NamespaceSelector NamespaceSelector `json:"namespaceSelector,omitempty"`
+	// scheme defines the HTTP scheme of the probed URLs.
+	// Defaults to `https`.
+	// +kubebuilder:validation:Enum=http;https
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
+	// relabelingConfigs to apply to the label set of the target before it gets
+	// scraped.
+	// The original scrape job's name is available via the `__tmp_prometheus_job_name` label.
+	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+	// +optional
+	RelabelConfigs []RelabelConfig `json:"relabelingConfigs,omitempty"`
+}
+
 // ProberSpec contains specification parameters for the Prober used for probing.
 // +k8s:openapi-gen=true
 type ProberSpec struct {
@@ -52,7 +52,33 @@ func TestValidateProbeTargets(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "one of staticConfig and ingress is required",
+			name: "probe with service target",
+			probeTargets: ProbeTargets{
+				Service: &ProbeTargetService{
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app": "foo",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "probe with httpRoute target",
+			probeTargets: ProbeTargets{
+				HTTPRoute: &ProbeTargetHTTPRoute{
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app": "foo",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "one of staticConfig, ingress, service and httpRoute is required",
 			probeTargets: ProbeTargets{
 				StaticConfig: nil,
 				Ingress:      nil,
@@ -1223,6 +1223,39 @@ type PrometheusSpec struct {
 	// +optional
 	ShardRetentionPolicy *ShardRetentionPolicy `json:"shardRetentionPolicy,omitempty"`
 
+	// autoscaling defines the automatic scaling of the number of shards based
+	// on the number of head series.
+	//
+	// When defined, the operator periodically queries the TSDB status of the
+	// Prometheus pods and updates the number of shards (via the scale
+	// subresource) so that each shard holds about
+	// `autoscaling.targetHeadSeries` series.
+	//
+	// Because the data isn't rebalanced between shards, the scaled-down
+	// shards are retained until their data ages out unless
+	// `spec.shardRetentionPolicy.whenScaled` is set to `Delete`. Scaling down
+	// requires the 'PrometheusShardRetentionPolicy' feature gate to be
+	// enabled.
+	//
+	// Autoscaling requires the operator to be able to reach the Prometheus
+	// pods on the web port without authentication. When the web server uses
+	// TLS, the certificate must be referenced by `spec.web.tlsConfig.cert` so
+	// that the operator can verify it.
+	//
+	// (Alpha) Using this field requires the 'PrometheusShardAutoscaling' feature gate to be enabled.
+	//
+	// +optional
+	Autoscaling *ShardAutoscaling `json:"autoscaling,omitempty"`
+
+	// ruleSharding defines how the PrometheusRule groups are assigned to the
+	// Prometheus shards.
+	//
+	// By default, every shard evaluates all the rule groups against its own
+	// partial data which can produce partial results and duplicated alerts.
+	//
+	// +optional
+	RuleSharding *RuleShardingStrategy `json:"ruleSharding,omitempty"`
+
 	// disableCompaction when true, the Prometheus compaction is disabled.
 	// When `spec.thanos.objectStorageConfig` or `spec.objectStorageConfigFile` are defined, the operator automatically
 	// disables block compaction to avoid race conditions during block uploads (as the Thanos documentation recommends).
@@ -1293,6 +1326,25 @@ type PrometheusSpec struct {
 	// +optional
 	RemoteRead []RemoteReadSpec `json:"remoteRead,omitempty"`
 
+	// federationSources defines the Prometheus resources from which this
+	// Prometheus federates series through the `/federate` endpoint.
+	//
+	// For each source, the operator generates a scrape job targeting all the
+	// pods (shards and replicas) of the referenced Prometheus resource. It
+	// updates the configuration when the referenced resources change (for
+	// instance when they are scaled).
+	//
+	// The referenced Prometheus resources must be managed by the same
+	// operator instance. References to non-existing Prometheus resources
+	// or to Prometheus resources with `listenLocal: true` are ignored.
+	// Sources with invalid `match` selectors and references to the
+	// Prometheus resource itself are ignored and reported in the
+	// `Reconciled` condition.
+	//
+	// +listType=atomic
+	// +optional
+	FederationSources []FederationSource `json:"federationSources,omitempty"`
+
 	// thanos defines the configuration of the optional Thanos sidecar.
 	//
 	// +optional
@@ -1384,8 +1436,81 @@ type ShardRetentionPolicy struct {
 	Retain *RetainConfig `json:"retain,omitempty"`
 }
 
+// RuleShardingMode defines how the rule groups are assigned to the shards.
+// +kubebuilder:validation:Enum=All;Hash;FirstShard
+type RuleShardingMode string
+
+const (
+	// AllRuleShardingMode is the default mode.
+	// All the shards evaluate all the rule groups.
+	AllRuleShardingMode RuleShardingMode = "All"
+
+	// HashRuleShardingMode distributes the rule groups across the shards
+	// based on a hash of the PrometheusRule's namespace and name and the
+	// group's name.
+	HashRuleShardingMode RuleShardingMode = "Hash"
+
+	// FirstShardRuleShardingMode assigns the rule groups to the first
+	// shard (shard 0). It is meant for rules evaluated against a global
+	// query layer (e.g. Thanos Query) via remote read.
+	FirstShardRuleShardingMode RuleShardingMode = "FirstShard"
+)
+
+// RuleShardingStrategy defines how the rule groups are assigned to the shards.
+type RuleShardingStrategy struct {
+	// mode defines how the rule groups are assigned to the shards.
+	//
+	// * `All` (default): all the shards evaluate all the rule groups.
+	// * `Hash`: each rule group is evaluated by a single shard, selected from
+	// a hash of the PrometheusRule's namespace and name and the group's name.
+	// * `FirstShard`: the rule groups are only evaluated by the first shard.
+	//
+	// With the `Hash` and `FirstShard` modes, a rule group can be pinned to a
+	// specific shard with the group's `shard` field.
+	//
+	// +optional
+	Mode *RuleShardingMode `json:"mode,omitempty"`
+}
+
+// ShardAutoscaling defines the automatic scaling of the Prometheus shards.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.minShards) || self.minShards <= self.maxShards",message="minShards must be less than or equal to maxShards"
+type ShardAutoscaling struct {
+	// minShards defines the minimum number of shards.
+	//
+	// If not defined, the operator assumes 1.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinShards *int32 `json:"minShards,omitempty"`
+	// maxShards defines the maximum number of shards.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxShards int32 `json:"maxShards"`
+	// targetHeadSeries defines the target number of head series per shard.
+	//
+	// The desired number of shards is the total number of head series
+	// across all the active shards divided by this value (rounded up).
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	TargetHeadSeries int64 `json:"targetHeadSeries"`
+	// scaleDownStabilizationWindow defines the duration for which past
+	// recommendations are considered when scaling down. The operator scales
+	// down to the highest number of shards recommended during the window
+	// which prevents flapping when the number of series fluctuates.
+	//
+	// Scaling up isn't subject to the stabilization window.
+	//
+	// If not defined, the operator assumes 1 hour.
+	//
+	// +optional
+	ScaleDownStabilizationWindow *Duration `json:"scaleDownStabilizationWindow,omitempty"`
+}
+
 // ShardingStrategyMode defines the sharding mode for Prometheus.
-// +kubebuilder:validation:Enum=Address;Topology
+// +kubebuilder:validation:Enum=Address;Topology;Namespace;Monitor
 type ShardingStrategyMode string
 
 const (
@@ -1397,6 +1522,18 @@ const (
 	// Each shard is assigned to a specific topology zone and only scrapes targets in that zone.
 	// (Alpha) Using this mode requires the `PrometheusTopologySharding` feature gate to be enabled.
 	TopologyShardingStrategyMode ShardingStrategyMode = "Topology"
+
+	// NamespaceShardingStrategyMode assigns the monitoring resources
+	// (ServiceMonitor, PodMonitor, Probe and ScrapeConfig) to shards based on
+	// a hash of their namespace.
+	// (Alpha) Using this mode requires the `PrometheusResourceSharding` feature gate to be enabled.
+	NamespaceShardingStrategyMode ShardingStrategyMode = "Namespace"
+
+	// MonitorShardingStrategyMode assigns the monitoring resources
+	// (ServiceMonitor, PodMonitor, Probe and ScrapeConfig) to shards based on
+	// a hash of their namespace and name.
+	// (Alpha) Using this mode requires the `PrometheusResourceSharding` feature gate to be enabled.
+	MonitorShardingStrategyMode ShardingStrategyMode = "Monitor"
 )
 
 // TopologyShardingStrategy defines the configuration for topology-aware sharding.
@@ -1421,7 +1558,8 @@ type TopologyShardingStrategy struct {
 // ShardingStrategy defines the sharding strategy for Prometheus.
 // +kubebuilder:validation:XValidation:rule="!has(self.topology) || (has(self.mode) && self.mode == 'Topology')",message="topology can only be defined when mode is set to 'Topology'"
 type ShardingStrategy struct {
-	// mode defines the sharding mode. Can be 'Address' or 'Topology'.
+	// mode defines the sharding mode. Can be 'Address', 'Topology',
+	// 'Namespace' or 'Monitor'.
 	//
 	// 'Address' is the default mode and distributes targets across shards
 	// based on a hash of the target address.
@@ -1431,6 +1569,17 @@ type ShardingStrategy struct {
 	// (Alpha) Using the 'Topology' mode requires the `PrometheusTopologySharding`
 	// feature gate to be enabled.
 	//
+	// 'Namespace' and 'Monitor' assign whole ServiceMonitor, PodMonitor, Probe
+	// and ScrapeConfig resources to shards based on a hash of respectively
+	// their namespace or their namespace and name. Each shard's configuration
+	// only contains the scrape jobs of the resources assigned to it which
+	// reduces the configuration size and the service discovery load.
+	// The additional scrape configurations are still sharded by target address.
+	// (Alpha) Using the 'Namespace' and 'Monitor' modes requires the
+	// `PrometheusResourceSharding` feature gate to be enabled. These modes are
+	// only supported by the Prometheus resource, PrometheusAgent resources
+	// fall back to the 'Address' mode.
+	//
 	// +optional
 	Mode *ShardingStrategyMode `json:"mode,omitempty"`
 
@@ -1486,8 +1635,115 @@ type PrometheusStatus struct {
 // +k8s:openapi-gen=true
 type AlertingSpec struct {
 	// alertmanagers endpoints where Prometheus should send alerts to.
+	// +optional
+	Alertmanagers []AlertmanagerEndpoints `json:"alertmanagers,omitempty"`
+
+	// alertmanagerSelector defines the Alertmanager resources, in the same
+	// namespace as the Prometheus resource, where Prometheus should send alerts to.
+	//
+	// The operator configures the endpoints (service, port, scheme, path
+	// prefix and API version) from the Alertmanager resources and it updates
+	// the configuration when the Alertmanager resources change.
+	//
+	// An empty label selector matches all Alertmanager resources in the
+	// namespace. A null label selector matches none.
+	//
+	// +optional
+	AlertmanagerSelector *metav1.LabelSelector `json:"alertmanagerSelector,omitempty"`
+
+	// alertmanagerRefs defines references to Alertmanager resources where
+	// Prometheus should send alerts to.
+	//
+	// The referenced Alertmanager resources must be managed by the same
+	// operator instance. References to non-existing Alertmanager resources
+	// are ignored.
+	//
+	// +optional
+	AlertmanagerRefs []AlertmanagerReference `json:"alertmanagerRefs,omitempty"`
+
+	// alertmanagerTLSConfig defines the TLS configuration used to connect to
+	// the Alertmanager resources selected by `alertmanagerSelector` and
+	// `alertmanagerRefs` which serve their web endpoints over HTTPS.
+	//
+	// +optional
+	AlertmanagerTLSConfig *SafeTLSConfig `json:"alertmanagerTLSConfig,omitempty"`
+}
+
+// AlertmanagerReference references an Alertmanager resource.
+// +k8s:openapi-gen=true
+type AlertmanagerReference struct {
+	// namespace of the Alertmanager resource.
+	//
+	// When not defined, it defaults to the namespace of the referencing
+	// resource.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// name of the Alertmanager resource.
+	//
+	// +kubebuilder:validation:MinLength=1
 	// +required
-	Alertmanagers []AlertmanagerEndpoints `json:"alertmanagers"`
+	Name string `json:"name"`
+}
+
+// FederationSource defines a Prometheus resource from which series are
+// federated.
+// +k8s:openapi-gen=true
+type FederationSource struct {
+	// namespace of the Prometheus resource.
+	//
+	// When not defined, it defaults to the namespace of the referencing
+	// resource.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// name of the Prometheus resource.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// match defines the series selectors (`match[]` parameters) of the
+	// series to federate.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +required
+	Match []string `json:"match"`
+
+	// scrapeInterval defines the interval at which series are federated.
+	//
+	// If not defined, the global scrape interval is used.
+	//
+	// +optional
+	ScrapeInterval *Duration `json:"scrapeInterval,omitempty"`
+
+	// scrapeTimeout defines the timeout of the federation requests.
+	//
+	// If not defined, the global scrape timeout is used.
+	//
+	// +optional
+	ScrapeTimeout *Duration `json:"scrapeTimeout,omitempty"`
+
+	// tlsConfig defines the TLS configuration used to connect to the
+	// Prometheus resource when it serves its web endpoint over HTTPS.
+	//
+	// +optional
+	TLSConfig *SafeTLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// HasAlertmanagerResources returns true if the alerting configuration
+// selects or references Alertmanager resources.
+func (as *AlertingSpec) HasAlertmanagerResources() bool {
+	if as == nil {
+		return false
+	}
+
+	return as.AlertmanagerSelector != nil || len(as.AlertmanagerRefs) > 0
 }
 
 // StorageSpec defines the configured storage for a group Prometheus servers.
@@ -2558,6 +2814,100 @@ type ScrapeClass struct {
 	// +optional
 	Default *bool `json:"default,omitempty"` // nolint:kubeapilinter
 
+	// namespaceSelector defines the namespaces for which the scrape class
+	// applies to the scrape objects that don't configure an explicit scrape
+	// class name. It takes precedence over the default scrape class.
+	//
+	// When several scrape classes select the same namespace, the first one
+	// in the list is used.
+	//
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// scrapeInterval defines the interval between consecutive scrapes.
+	// It will only apply if the scrape resource doesn't specify any interval.
+	//
+	// +optional
+	ScrapeInterval *Duration `json:"scrapeInterval,omitempty"`
+
+	// scrapeTimeout defines the timeout after which the scrape is ended.
+	// It will only apply if the scrape resource doesn't specify any timeout.
+	//
+	// +optional
+	ScrapeTimeout *Duration `json:"scrapeTimeout,omitempty"`
+
+	// honorLabels defines when the scraped labels take precedence over the
+	// target labels.
+	// It will only apply if the scrape resource doesn't set `honorLabels` to true.
+	//
+	// +optional
+	HonorLabels *bool `json:"honorLabels,omitempty"` // nolint:kubeapilinter
+
+	// honorTimestamps defines whether Prometheus preserves the timestamps
+	// when exposed by the target.
+	// It will only apply if the scrape resource doesn't specify any value.
+	//
+	// +optional
+	HonorTimestamps *bool `json:"honorTimestamps,omitempty"` // nolint:kubeapilinter
+
+	// params defines optional HTTP URL parameters.
+	// The parameters defined by the scrape resource take precedence over the
+	// parameters with the same name.
+	//
+	// +optional
+	Params map[string][]string `json:"params,omitempty"`
+
+	// scheme defines the HTTP scheme to use for scraping.
+	// It will only apply if the scrape resource doesn't specify any scheme.
+	//
+	// +optional
+	Scheme *Scheme `json:"scheme,omitempty"`
+
+	// sampleLimit defines a per-scrape limit on the number of scraped samples
+	// that will be accepted.
+	// It will only apply if the scrape resource doesn't specify any limit.
+	//
+	// +optional
+	SampleLimit *uint64 `json:"sampleLimit,omitempty"`
+
+	// targetLimit defines a limit on the number of scraped targets that will
+	// be accepted.
+	// It will only apply if the scrape resource doesn't specify any limit.
+	//
+	// +optional
+	TargetLimit *uint64 `json:"targetLimit,omitempty"`
+
+	// labelLimit defines the per-scrape limit on the number of labels that
+	// will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any limit.
+	//
+	// +optional
+	LabelLimit *uint64 `json:"labelLimit,omitempty"`
+
+	// labelNameLengthLimit defines the per-scrape limit on the length of
+	// labels name that will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any limit.
+	//
+	// +optional
+	LabelNameLengthLimit *uint64 `json:"labelNameLengthLimit,omitempty"`
+
+	// labelValueLengthLimit defines the per-scrape limit on the length of
+	// labels value that will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any limit.
+	//
+	// +optional
+	LabelValueLengthLimit *uint64 `json:"labelValueLengthLimit,omitempty"`
+
+	// The native histogram settings apply field by field when the scrape
+	// resource doesn't define them.
+	NativeHistogramConfig `json:",inline"`
+
+	// The proxy settings apply if the scrape resource doesn't configure
+	// any proxy.
+	//
+	// For now the `proxyConnectHeader` field isn't supported.
+	ProxyConfig `json:",inline"`
+
 	// fallbackScrapeProtocol defines the protocol to use if a scrape returns blank, unparseable, or otherwise invalid Content-Type.
 	// It will only apply if the scrape resource doesn't specify any FallbackScrapeProtocol
 	//
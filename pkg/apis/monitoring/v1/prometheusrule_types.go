@@ -115,6 +115,17 @@ type RuleGroup struct {
 	// Limit is supported starting with Prometheus >= 2.31 and Thanos Ruler >= 0.24.
 	// +optional
 	Limit *int `json:"limit,omitempty"`
+	// shard defines the Prometheus shard which evaluates the rule group.
+	//
+	// It is only used by Prometheus resources for which
+	// `spec.ruleSharding.mode` is `Hash` or `FirstShard`. If the value is
+	// greater than or equal to the number of shards, the group is assigned
+	// to the shard equal to the value modulo the number of shards.
+	// The field is ignored for Thanos Ruler.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Shard *int32 `json:"shard,omitempty"`
 }
 
 // Rule describes an alerting or recording rule
@@ -283,6 +283,46 @@ type ThanosRulerSpec struct {
 	// +optional
 	AlertManagersConfig *v1.SecretKeySelector `json:"alertmanagersConfig,omitempty"`
 
+	// alertmanagerSelector defines the Alertmanager resources, in the same
+	// namespace as the ThanosRuler resource, where Thanos Ruler should send
+	// alerts to.
+	//
+	// The operator configures the endpoints (addresses, scheme, path prefix
+	// and API version) from the Alertmanager resources and it updates the
+	// configuration when the Alertmanager resources change.
+	//
+	// An empty label selector matches all Alertmanager resources in the
+	// namespace. A null label selector matches none.
+	//
+	// `alertmanagersConfig` takes precedence over this field and this field
+	// takes precedence over `alertmanagersUrl`.
+	//
+	// +optional
+	AlertmanagerSelector *metav1.LabelSelector `json:"alertmanagerSelector,omitempty"`
+
+	// alertmanagerRefs defines references to Alertmanager resources where
+	// Thanos Ruler should send alerts to.
+	//
+	// The referenced Alertmanager resources must be managed by the same
+	// operator instance. References to non-existing Alertmanager resources
+	// are ignored.
+	//
+	// `alertmanagersConfig` takes precedence over this field and this field
+	// takes precedence over `alertmanagersUrl`.
+	//
+	// +optional
+	AlertmanagerRefs []AlertmanagerReference `json:"alertmanagerRefs,omitempty"`
+
+	// alertmanagerTLSConfig defines the TLS configuration used to connect to
+	// the Alertmanager resources selected by `alertmanagerSelector` and
+	// `alertmanagerRefs` which serve their web endpoints over HTTPS.
+	//
+	// The `minVersion` and `maxVersion` fields aren't supported by Thanos
+	// Ruler and they are ignored.
+	//
+	// +optional
+	AlertmanagerTLSConfig *SafeTLSConfig `json:"alertmanagerTLSConfig,omitempty"`
+
 	// ruleSelector defines the PrometheusRule objects to be selected for rule evaluation. An empty
 	// label selector matches all objects. A null label selector matches no
 	// objects.
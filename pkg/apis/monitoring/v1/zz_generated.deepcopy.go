@@ -66,6 +66,23 @@ func (in *AlertingSpec) DeepCopyInto(out *AlertingSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AlertmanagerSelector != nil {
+		in, out := &in.AlertmanagerSelector, &out.AlertmanagerSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertmanagerRefs != nil {
+		in, out := &in.AlertmanagerRefs, &out.AlertmanagerRefs
+		*out = make([]AlertmanagerReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AlertmanagerTLSConfig != nil {
+		in, out := &in.AlertmanagerTLSConfig, &out.AlertmanagerTLSConfig
+		*out = new(SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingSpec.
@@ -100,6 +117,11 @@ func (in *Alertmanager) DeepCopy() *Alertmanager {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertmanagerConfigMatcherStrategy) DeepCopyInto(out *AlertmanagerConfigMatcherStrategy) {
 	*out = *in
+	if in.Label != nil {
+		in, out := &in.Label, &out.Label
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfigMatcherStrategy.
@@ -127,6 +149,18 @@ func (in *AlertmanagerConfiguration) DeepCopyInto(out *AlertmanagerConfiguration
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SharedReceivers != nil {
+		in, out := &in.SharedReceivers, &out.SharedReceivers
+		*out = make([]SharedReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfiguration.
@@ -349,6 +383,26 @@ func (in *AlertmanagerList) DeepCopy() *AlertmanagerList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerReference) DeepCopyInto(out *AlertmanagerReference) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerReference.
+func (in *AlertmanagerReference) DeepCopy() *AlertmanagerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 	*out = *in
@@ -502,7 +556,7 @@ func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	out.AlertmanagerConfigMatcherStrategy = in.AlertmanagerConfigMatcherStrategy
+	in.AlertmanagerConfigMatcherStrategy.DeepCopyInto(&out.AlertmanagerConfigMatcherStrategy)
 	if in.MinReadySeconds != nil {
 		in, out := &in.MinReadySeconds, &out.MinReadySeconds
 		*out = new(int32)
@@ -1488,6 +1542,46 @@ func (in *Exemplars) DeepCopy() *Exemplars {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederationSource) DeepCopyInto(out *FederationSource) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScrapeInterval != nil {
+		in, out := &in.ScrapeInterval, &out.ScrapeInterval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.ScrapeTimeout != nil {
+		in, out := &in.ScrapeTimeout, &out.ScrapeTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationSource.
+func (in *FederationSource) DeepCopy() *FederationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(FederationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GRPCServerTLSConfig) DeepCopyInto(out *GRPCServerTLSConfig) {
 	*out = *in
@@ -2512,6 +2606,30 @@ func (in *ProbeSpec) DeepCopy() *ProbeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeTargetHTTPRoute) DeepCopyInto(out *ProbeTargetHTTPRoute) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.RelabelConfigs != nil {
+		in, out := &in.RelabelConfigs, &out.RelabelConfigs
+		*out = make([]RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeTargetHTTPRoute.
+func (in *ProbeTargetHTTPRoute) DeepCopy() *ProbeTargetHTTPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeTargetHTTPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProbeTargetIngress) DeepCopyInto(out *ProbeTargetIngress) {
 	*out = *in
@@ -2536,6 +2654,30 @@ func (in *ProbeTargetIngress) DeepCopy() *ProbeTargetIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeTargetService) DeepCopyInto(out *ProbeTargetService) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.RelabelConfigs != nil {
+		in, out := &in.RelabelConfigs, &out.RelabelConfigs
+		*out = make([]RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeTargetService.
+func (in *ProbeTargetService) DeepCopy() *ProbeTargetService {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeTargetService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProbeTargetStaticConfig) DeepCopyInto(out *ProbeTargetStaticConfig) {
 	*out = *in
@@ -2583,6 +2725,16 @@ func (in *ProbeTargets) DeepCopyInto(out *ProbeTargets) {
 		*out = new(ProbeTargetIngress)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ProbeTargetService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRoute != nil {
+		in, out := &in.HTTPRoute, &out.HTTPRoute
+		*out = new(ProbeTargetHTTPRoute)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeTargets.
@@ -2748,6 +2900,16 @@ func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 		*out = new(ShardRetentionPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(ShardAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuleSharding != nil {
+		in, out := &in.RuleSharding, &out.RuleSharding
+		*out = new(RuleShardingStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	out.Rules = in.Rules
 	if in.PrometheusRulesExcludedFromEnforce != nil {
 		in, out := &in.PrometheusRulesExcludedFromEnforce, &out.PrometheusRulesExcludedFromEnforce
@@ -2791,6 +2953,13 @@ func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FederationSources != nil {
+		in, out := &in.FederationSources, &out.FederationSources
+		*out = make([]FederationSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Thanos != nil {
 		in, out := &in.Thanos, &out.Thanos
 		*out = new(ThanosSpec)
@@ -3301,6 +3470,11 @@ func (in *RuleGroup) DeepCopyInto(out *RuleGroup) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Shard != nil {
+		in, out := &in.Shard, &out.Shard
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleGroup.
@@ -3313,6 +3487,26 @@ func (in *RuleGroup) DeepCopy() *RuleGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleShardingStrategy) DeepCopyInto(out *RuleShardingStrategy) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(RuleShardingMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleShardingStrategy.
+func (in *RuleShardingStrategy) DeepCopy() *RuleShardingStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleShardingStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rules) DeepCopyInto(out *Rules) {
 	*out = *in
@@ -3434,6 +3628,79 @@ func (in *ScrapeClass) DeepCopyInto(out *ScrapeClass) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScrapeInterval != nil {
+		in, out := &in.ScrapeInterval, &out.ScrapeInterval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.ScrapeTimeout != nil {
+		in, out := &in.ScrapeTimeout, &out.ScrapeTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.HonorLabels != nil {
+		in, out := &in.HonorLabels, &out.HonorLabels
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HonorTimestamps != nil {
+		in, out := &in.HonorTimestamps, &out.HonorTimestamps
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(Scheme)
+		**out = **in
+	}
+	if in.SampleLimit != nil {
+		in, out := &in.SampleLimit, &out.SampleLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TargetLimit != nil {
+		in, out := &in.TargetLimit, &out.TargetLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelLimit != nil {
+		in, out := &in.LabelLimit, &out.LabelLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelNameLengthLimit != nil {
+		in, out := &in.LabelNameLengthLimit, &out.LabelNameLengthLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelValueLengthLimit != nil {
+		in, out := &in.LabelValueLengthLimit, &out.LabelValueLengthLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	in.NativeHistogramConfig.DeepCopyInto(&out.NativeHistogramConfig)
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.FallbackScrapeProtocol != nil {
 		in, out := &in.FallbackScrapeProtocol, &out.FallbackScrapeProtocol
 		*out = new(ScrapeProtocol)
@@ -3648,6 +3915,31 @@ func (in *ServiceMonitorSpec) DeepCopy() *ServiceMonitorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardAutoscaling) DeepCopyInto(out *ShardAutoscaling) {
+	*out = *in
+	if in.MinShards != nil {
+		in, out := &in.MinShards, &out.MinShards
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDownStabilizationWindow != nil {
+		in, out := &in.ScaleDownStabilizationWindow, &out.ScaleDownStabilizationWindow
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardAutoscaling.
+func (in *ShardAutoscaling) DeepCopy() *ShardAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShardRetentionPolicy) DeepCopyInto(out *ShardRetentionPolicy) {
 	*out = *in
@@ -3713,6 +4005,26 @@ func (in *ShardingStrategy) DeepCopy() *ShardingStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedReceiver) DeepCopyInto(out *SharedReceiver) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedReceiver.
+func (in *SharedReceiver) DeepCopy() *SharedReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sigv4) DeepCopyInto(out *Sigv4) {
 	*out = *in
@@ -4018,6 +4330,23 @@ func (in *ThanosRulerSpec) DeepCopyInto(out *ThanosRulerSpec) {
 		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AlertmanagerSelector != nil {
+		in, out := &in.AlertmanagerSelector, &out.AlertmanagerSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertmanagerRefs != nil {
+		in, out := &in.AlertmanagerRefs, &out.AlertmanagerRefs
+		*out = make([]AlertmanagerReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AlertmanagerTLSConfig != nil {
+		in, out := &in.AlertmanagerTLSConfig, &out.AlertmanagerTLSConfig
+		*out = new(SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RuleSelector != nil {
 		in, out := &in.RuleSelector, &out.RuleSelector
 		*out = new(metav1.LabelSelector)
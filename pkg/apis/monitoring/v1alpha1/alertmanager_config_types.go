@@ -101,6 +101,32 @@ type AlertmanagerConfigSpec struct {
 	// +listType=atomic
 	// +optional
 	MuteTimeIntervals []MuteTimeInterval `json:"muteTimeIntervals,omitempty"`
+	// sharedTimeIntervals defines the names of the time intervals, defined by
+	// the base configuration of the Alertmanager, which can be referenced by
+	// the routes.
+	//
+	// The base configuration is either the Alertmanager configuration secret
+	// or the global AlertmanagerConfig referenced by
+	// `spec.alertmanagerConfiguration` of the Alertmanager resource. The
+	// operator rejects the resource if a time interval isn't defined by the
+	// base configuration.
+	//
+	// The names must not conflict with the names defined by `muteTimeIntervals`.
+	// +listType=set
+	// +optional
+	SharedTimeIntervals []string `json:"sharedTimeIntervals,omitempty"`
+	// sharedReceivers defines the names of the receivers, shared by the global
+	// AlertmanagerConfig of the Alertmanager, which can be referenced by the
+	// routes.
+	//
+	// The receivers are shared with `spec.alertmanagerConfiguration.sharedReceivers`
+	// of the Alertmanager resource. The operator rejects the resource if a
+	// receiver isn't shared or isn't allowed for the namespace of the resource.
+	//
+	// The names must not conflict with the names defined by `receivers`.
+	// +listType=set
+	// +optional
+	SharedReceivers []string `json:"sharedReceivers,omitempty"`
 }
 
 // Route defines a node in the routing tree.
@@ -247,6 +273,21 @@ type Receiver struct {
 	// +listType=atomic
 	// +optional
 	RocketChatConfigs []RocketChatConfig `json:"rocketchatConfigs,omitempty"`
+	// jiraConfigs defines the list of Jira configurations.
+	// It requires Alertmanager >= 0.28.0.
+	// +listType=atomic
+	// +optional
+	JiraConfigs []JiraConfig `json:"jiraConfigs,omitempty"`
+	// mattermostConfigs defines the list of Mattermost configurations.
+	// It requires Alertmanager >= 0.30.0.
+	// +listType=atomic
+	// +optional
+	MattermostConfigs []MattermostConfig `json:"mattermostConfigs,omitempty"`
+	// incidentioConfigs defines the list of incident.io configurations.
+	// It requires Alertmanager >= 0.29.0.
+	// +listType=atomic
+	// +optional
+	IncidentioConfigs []IncidentioConfig `json:"incidentioConfigs,omitempty"`
 }
 
 // PagerDutyConfig configures notifications via PagerDuty.
@@ -1353,6 +1394,279 @@ type RocketChatActionConfig struct {
 	Msg *string `json:"msg,omitempty"`
 }
 
+// JiraConfig configures notifications via Jira.
+// See https://prometheus.io/docs/alerting/latest/configuration/#jira_config
+// It requires Alertmanager >= 0.28.0.
+type JiraConfig struct {
+	// sendResolved defines whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"` // nolint:kubeapilinter
+	// apiURL defines the URL of the Jira REST API.
+	// If not specified, the global `jira_api_url` setting of the
+	// Alertmanager configuration is used.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+	// apiType defines the flavor of the Jira API.
+	// It requires Alertmanager >= 0.29.0.
+	// +kubebuilder:validation:Enum=auto;cloud;datacenter
+	// +optional
+	APIType *string `json:"apiType,omitempty"`
+	// project defines the key of the Jira project where issues are created.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Project string `json:"project"`
+	// issueType defines the type of the created issues (e.g. "Bug").
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	IssueType string `json:"issueType"`
+	// summary defines the issue summary template.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Summary *string `json:"summary,omitempty"`
+	// description defines the issue description template.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Description *string `json:"description,omitempty"`
+	// labels defines the labels of the created issues.
+	// Label values support templating.
+	// +listType=set
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+	// priority defines the issue priority template.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Priority *string `json:"priority,omitempty"`
+	// reopenTransition defines the name of the workflow transition to reopen
+	// an issue when a resolved alert fires again.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ReopenTransition *string `json:"reopenTransition,omitempty"`
+	// resolveTransition defines the name of the workflow transition to
+	// resolve an issue. If not set, the issue isn't resolved when the alert
+	// resolves.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ResolveTransition *string `json:"resolveTransition,omitempty"`
+	// wontFixResolution defines the resolution of the issues which shouldn't
+	// be reopened.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	WontFixResolution *string `json:"wontFixResolution,omitempty"`
+	// reopenDuration defines the duration after which a resolved issue is
+	// no longer reopened and a new issue is created instead.
+	// +optional
+	ReopenDuration *monitoringv1.Duration `json:"reopenDuration,omitempty"`
+	// fields defines additional fields of the created issues (e.g. custom
+	// fields). String values support templating.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	Fields []JiraField `json:"fields,omitempty"`
+	// httpConfig defines the HTTP client configuration for Jira API requests.
+	// The Jira API token is typically provided with the `basicAuth` or
+	// `authorization` fields.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// JiraField defines an additional field of a Jira issue.
+type JiraField struct {
+	// key defines the key of the field (e.g. "customfield_10000").
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Key string `json:"key"`
+	// value defines the value of the field. It can be any JSON value.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +required
+	Value apiextensionsv1.JSON `json:"value"`
+}
+
+// MattermostConfig configures notifications via Mattermost.
+// See https://prometheus.io/docs/alerting/latest/configuration/#mattermost_config
+// It requires Alertmanager >= 0.30.0.
+type MattermostConfig struct {
+	// sendResolved defines whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"` // nolint:kubeapilinter
+	// webhookURL defines the secret's key that contains the Mattermost
+	// incoming webhook URL.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// It is required for Alertmanager < 0.32.0, otherwise the global
+	// Mattermost webhook URL is used when not specified.
+	// +optional
+	WebhookURL *v1.SecretKeySelector `json:"webhookURL,omitempty"`
+	// channel overrides the channel configured for the incoming webhook.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Channel *string `json:"channel,omitempty"`
+	// username overrides the username configured for the incoming webhook.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Username *string `json:"username,omitempty"`
+	// text defines the message text template.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Text *string `json:"text,omitempty"`
+	// iconURL overrides the profile picture of the message sender.
+	// +optional
+	IconURL *URL `json:"iconURL,omitempty"`
+	// iconEmoji overrides the profile picture of the message sender with an
+	// emoji. It takes precedence over `iconURL`.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	IconEmoji *string `json:"iconEmoji,omitempty"`
+	// attachments defines the message attachments.
+	// +listType=atomic
+	// +optional
+	Attachments []MattermostAttachment `json:"attachments,omitempty"`
+	// props defines additional properties of the message.
+	// +optional
+	Props *MattermostProps `json:"props,omitempty"`
+	// priority defines the priority of the message.
+	// It requires Mattermost >= 9.7 (Professional or Enterprise edition).
+	// +optional
+	Priority *MattermostPriority `json:"priority,omitempty"`
+	// httpConfig defines the HTTP client configuration for Mattermost webhook requests.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// MattermostAttachment defines an attachment of a Mattermost message.
+// See https://developers.mattermost.com/integrate/reference/message-attachments/
+type MattermostAttachment struct {
+	// fallback defines a plain-text summary of the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Fallback *string `json:"fallback,omitempty"`
+	// color defines the color of the left border of the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Color *string `json:"color,omitempty"`
+	// pretext defines the text displayed above the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Pretext *string `json:"pretext,omitempty"`
+	// text defines the text of the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Text *string `json:"text,omitempty"`
+	// authorName defines the name of the author.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	AuthorName *string `json:"authorName,omitempty"`
+	// authorLink defines the URL linked from the author name.
+	// +optional
+	AuthorLink *URL `json:"authorLink,omitempty"`
+	// authorIcon defines the URL of the author icon.
+	// +optional
+	AuthorIcon *URL `json:"authorIcon,omitempty"`
+	// title defines the title of the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Title *string `json:"title,omitempty"`
+	// titleLink defines the URL linked from the title.
+	// +optional
+	TitleLink *URL `json:"titleLink,omitempty"`
+	// fields defines the fields displayed in a table inside the attachment.
+	// +listType=atomic
+	// +optional
+	Fields []MattermostField `json:"fields,omitempty"`
+	// thumbURL defines the URL of the thumbnail image.
+	// +optional
+	ThumbURL *URL `json:"thumbURL,omitempty"`
+	// footer defines the footer text of the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Footer *string `json:"footer,omitempty"`
+	// footerIcon defines the URL of the footer icon.
+	// +optional
+	FooterIcon *URL `json:"footerIcon,omitempty"`
+	// imageURL defines the URL of the image displayed inside the attachment.
+	// +optional
+	ImageURL *URL `json:"imageURL,omitempty"`
+}
+
+// MattermostField defines a field of a Mattermost attachment.
+type MattermostField struct {
+	// title defines the title of the field.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Title string `json:"title"`
+	// value defines the value of the field.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Value string `json:"value"`
+	// short defines whether the field is displayed side by side with other
+	// short fields.
+	// +optional
+	Short *bool `json:"short,omitempty"` // nolint:kubeapilinter
+}
+
+// MattermostProps defines additional properties of a Mattermost message.
+type MattermostProps struct {
+	// card defines the text displayed in the right-hand sidebar when
+	// clicking on the message "Info" button.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Card *string `json:"card,omitempty"`
+}
+
+// MattermostPriority defines the priority of a Mattermost message.
+type MattermostPriority struct {
+	// priority defines the priority label of the message.
+	// +kubebuilder:validation:Enum=important;urgent
+	// +required
+	Priority string `json:"priority"`
+	// requestedAck defines whether the recipients are asked to acknowledge
+	// the message.
+	// +optional
+	RequestedAck *bool `json:"requestedAck,omitempty"` // nolint:kubeapilinter
+	// persistentNotifications defines whether the recipients are notified
+	// repeatedly until they acknowledge the message. It only applies to
+	// urgent messages.
+	// +optional
+	PersistentNotifications *bool `json:"persistentNotifications,omitempty"` // nolint:kubeapilinter
+}
+
+// IncidentioConfig configures notifications via incident.io.
+// See https://prometheus.io/docs/alerting/latest/configuration/#incidentio_config
+// It requires Alertmanager >= 0.29.0.
+type IncidentioConfig struct {
+	// sendResolved defines whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"` // nolint:kubeapilinter
+	// url defines the URL of the incident.io alert source.
+	// Either `url` or `urlSecret` must be defined.
+	// +optional
+	URL *URL `json:"url,omitempty"`
+	// urlSecret defines the secret's key that contains the URL of the
+	// incident.io alert source.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	URLSecret *v1.SecretKeySelector `json:"urlSecret,omitempty"`
+	// alertSourceToken defines the secret's key that contains the token of
+	// the incident.io alert source.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// Either this field or `httpConfig.authorization` must be defined.
+	// +optional
+	AlertSourceToken *v1.SecretKeySelector `json:"alertSourceToken,omitempty"`
+	// maxAlerts defines the maximum number of alerts included in a single
+	// message. 0 means no limit.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxAlerts *int32 `json:"maxAlerts,omitempty"`
+	// timeout defines the maximum time allowed to invoke incident.io.
+	// +optional
+	Timeout *monitoringv1.Duration `json:"timeout,omitempty"`
+	// httpConfig defines the HTTP client configuration for incident.io requests.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
 // InhibitRule defines an inhibition rule that allows to mute alerts when other
 // alerts are already firing.
 // See https://prometheus.io/docs/alerting/latest/configuration/#inhibit_rule
@@ -1517,6 +1831,14 @@ type TimeInterval struct {
 	// +listType=atomic
 	// +optional
 	Years []YearRange `json:"years,omitempty"`
+	// location defines the time zone in which the time interval is evaluated,
+	// expressed as an IANA Time Zone Database name (e.g. "Europe/Paris").
+	// When not defined, the time interval is evaluated in UTC.
+	//
+	// It requires Alertmanager >= 0.25.0.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Location *string `json:"location,omitempty"`
 }
 
 // Time defines a time in 24hr format
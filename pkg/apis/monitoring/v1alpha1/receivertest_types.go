@@ -0,0 +1,170 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	ReceiverTestsKind   = "ReceiverTest"
+	ReceiverTestName    = "receivertests"
+	ReceiverTestKindKey = "receivertest"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="rcvtest"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Receiver",type="string",JSONPath=".spec.receiver"
+// +kubebuilder:printcolumn:name="Outcome",type="string",JSONPath=".status.outcome"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ReceiverTest sends a synthetic notification through a receiver of an
+// AlertmanagerConfig resource and reports the outcome in its status.
+//
+// The operator sends the notification once for each generation of the
+// ReceiverTest resource. The notification is rendered from the
+// configuration generated for the Alertmanager resource referenced by
+// `spec.alertmanager`.
+type ReceiverTest struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of ReceiverTestSpec
+	// +required
+	Spec ReceiverTestSpec `json:"spec"`
+	// status defines the outcome of the test. Read-only.
+	// +optional
+	Status ReceiverTestStatus `json:"status,omitempty,omitzero"`
+}
+
+// ReceiverTestList is a list of ReceiverTest.
+// +k8s:openapi-gen=true
+type ReceiverTestList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ReceiverTest
+	Items []ReceiverTest `json:"items"`
+}
+
+// ReceiverTestSpec is a specification of the receiver to test.
+type ReceiverTestSpec struct {
+	// alertmanager defines the Alertmanager resource which selects the
+	// AlertmanagerConfig resource.
+	// +required
+	Alertmanager AlertmanagerReference `json:"alertmanager"`
+	// alertmanagerConfig defines the name of the AlertmanagerConfig resource
+	// in the same namespace as the ReceiverTest resource.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	AlertmanagerConfig string `json:"alertmanagerConfig"`
+	// receiver defines the name of the receiver in the AlertmanagerConfig
+	// resource.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Receiver string `json:"receiver"`
+	// labels defines additional labels of the synthetic alert.
+	//
+	// The `alertname` label defaults to "ReceiverTest" and the `namespace`
+	// label is always set to the namespace of the ReceiverTest resource.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// annotations defines the annotations of the synthetic alert.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// AlertmanagerReference references an Alertmanager resource.
+type AlertmanagerReference struct {
+	// namespace defines the namespace of the Alertmanager resource.
+	//
+	// When not defined, it defaults to the namespace of the ReceiverTest resource.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// name defines the name of the Alertmanager resource.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+}
+
+// ReceiverTestOutcome is the outcome of a receiver test.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type ReceiverTestOutcome string
+
+const (
+	ReceiverTestSucceeded ReceiverTestOutcome = "Succeeded"
+	ReceiverTestFailed    ReceiverTestOutcome = "Failed"
+)
+
+// ReceiverTestStatus defines the outcome of a receiver test.
+type ReceiverTestStatus struct {
+	// observedGeneration defines the .metadata.generation of the
+	// ReceiverTest resource which has been tested.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// testTime defines when the notification has been sent.
+	// +optional
+	TestTime *metav1.Time `json:"testTime,omitempty"`
+	// outcome defines whether the notification has been delivered by all
+	// the integrations of the receiver.
+	// +optional
+	Outcome ReceiverTestOutcome `json:"outcome,omitempty"`
+	// message defines a human-readable message when the test couldn't be
+	// performed (e.g. the receiver doesn't exist).
+	// +optional
+	Message string `json:"message,omitempty"`
+	// results defines the outcome for each integration of the receiver.
+	// +listType=atomic
+	// +optional
+	Results []ReceiverTestResult `json:"results,omitempty"`
+}
+
+// ReceiverTestResult defines the outcome of the notification for an
+// integration of the receiver.
+type ReceiverTestResult struct {
+	// integration defines the name of the integration (e.g. "slack").
+	// +required
+	Integration string `json:"integration"`
+	// index defines the position of the integration's configuration in the
+	// receiver.
+	// +required
+	Index int32 `json:"index"`
+	// outcome defines whether the notification has been delivered.
+	// +required
+	Outcome ReceiverTestOutcome `json:"outcome"`
+	// latency defines the time taken to send the notification.
+	// +required
+	Latency metav1.Duration `json:"latency"`
+	// error defines the error returned by the integration.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ReceiverTest) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ReceiverTestList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
@@ -177,6 +177,19 @@ type ScrapeConfigSpec struct {
 	// staticConfigs defines a list of static targets with a common label set.
 	// +optional
 	StaticConfigs []StaticConfig `json:"staticConfigs,omitempty"`
+	// staticConfigsFrom defines a list of ConfigMap or Secret keys containing
+	// targets in the file service discovery format (either JSON or YAML).
+	//
+	// The operator mounts the referenced keys into the Prometheus pods and
+	// configures a file service discovery for them. Prometheus picks up
+	// changes of the targets without reloading its configuration.
+	//
+	// Because the objects are mounted into the pods, the ScrapeConfig resource
+	// and the objects must be in the same namespace as the Prometheus
+	// resource. The keys must not be empty.
+	// +listType=atomic
+	// +optional
+	StaticConfigsFrom []v1.SecretOrConfigMap `json:"staticConfigsFrom,omitempty"`
 	// fileSDConfigs defines a list of file service discovery configurations.
 	// +optional
 	FileSDConfigs []FileSDConfig `json:"fileSDConfigs,omitempty"`
@@ -243,6 +256,27 @@ type ScrapeConfigSpec struct {
 	// ionosSDConfigs defines a list of IONOS service discovery configurations.
 	// +optional
 	IonosSDConfigs []IonosSDConfig `json:"ionosSDConfigs,omitempty"`
+	// vultrSDConfigs defines a list of Vultr service discovery configurations.
+	// +optional
+	VultrSDConfigs []VultrSDConfig `json:"vultrSDConfigs,omitempty"`
+	// stackitSDConfigs defines a list of STACKIT service discovery configurations.
+	// +optional
+	STACKITSDConfigs []STACKITSDConfig `json:"stackitSDConfigs,omitempty"`
+	// marathonSDConfigs defines a list of Marathon service discovery configurations.
+	// +optional
+	MarathonSDConfigs []MarathonSDConfig `json:"marathonSDConfigs,omitempty"`
+	// tritonSDConfigs defines a list of Triton service discovery configurations.
+	// +optional
+	TritonSDConfigs []TritonSDConfig `json:"tritonSDConfigs,omitempty"`
+	// uyuniSDConfigs defines a list of Uyuni service discovery configurations.
+	// +optional
+	UyuniSDConfigs []UyuniSDConfig `json:"uyuniSDConfigs,omitempty"`
+	// serversetSDConfigs defines a list of Serverset service discovery configurations.
+	// +optional
+	ServersetSDConfigs []ServersetSDConfig `json:"serversetSDConfigs,omitempty"`
+	// nerveSDConfigs defines a list of Nerve service discovery configurations.
+	// +optional
+	NerveSDConfigs []NerveSDConfig `json:"nerveSDConfigs,omitempty"`
 	// relabelings defines how to rewrite the target's labels before scraping.
 	// Prometheus Operator automatically adds relabelings for a few standard Kubernetes fields.
 	// The original scrape job's name is available via the `__tmp_prometheus_job_name` label.
@@ -1534,3 +1568,270 @@ type IonosSDConfig struct {
 	// +optional
 	OAuth2 *v1.OAuth2 `json:"oauth2,omitempty"`
 }
+
+// VultrSDConfig configurations allow retrieving scrape targets from Vultr instances.
+// The operator requires Prometheus >= v2.36.0.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#vultr_sd_config
+// +k8s:openapi-gen=true
+type VultrSDConfig struct {
+	// port defines the port to scrape metrics from.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// refreshInterval defines the time after which the instances are refreshed.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// authorization defines the header configuration to authenticate against the Vultr API.
+	// Cannot be set at the same time as `oauth2`.
+	// +optional
+	Authorization *v1.SafeAuthorization `json:"authorization,omitempty"`
+	// oauth2 defines the optional OAuth 2.0 configuration to authenticate against the Vultr API.
+	// Cannot be set at the same time as `authorization`.
+	// +optional
+	OAuth2         *v1.OAuth2 `json:"oauth2,omitempty"`
+	v1.ProxyConfig `json:",inline"`
+	// followRedirects defines whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"` // nolint:kubeapilinter
+	// tlsConfig defines the TLS configuration to connect to the Vultr API.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// enableHTTP2 defines whether to enable HTTP2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"` // nolint:kubeapilinter
+}
+
+// STACKITSDConfig configurations allow retrieving scrape targets from STACKIT Cloud servers.
+// The operator requires Prometheus >= v3.5.0.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#stackit_sd_config
+// +k8s:openapi-gen=true
+type STACKITSDConfig struct {
+	// project defines the ID of the STACKIT project.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Project string `json:"project"`
+	// region defines the STACKIT region of the servers.
+	// If not set, Prometheus uses its default value.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Region *string `json:"region,omitempty"`
+	// endpoint defines the URL of the STACKIT API.
+	// If not set, the endpoint is derived from the region.
+	// +optional
+	Endpoint *URL `json:"endpoint,omitempty"`
+	// serviceAccountKey defines the Secret key containing the service account
+	// key (JSON) used to authenticate against the STACKIT API.
+	// +optional
+	ServiceAccountKey *corev1.SecretKeySelector `json:"serviceAccountKey,omitempty"`
+	// privateKey defines the Secret key containing the private key (PEM)
+	// used to sign the service account key.
+	// It requires `serviceAccountKey` to be set.
+	// +optional
+	PrivateKey *corev1.SecretKeySelector `json:"privateKey,omitempty"`
+	// port defines the port to scrape metrics from.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// refreshInterval defines the time after which the servers are refreshed.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// authorization defines the header configuration to authenticate against the STACKIT API.
+	// Cannot be set at the same time as `serviceAccountKey`.
+	// +optional
+	Authorization  *v1.SafeAuthorization `json:"authorization,omitempty"`
+	v1.ProxyConfig `json:",inline"`
+	// followRedirects defines whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"` // nolint:kubeapilinter
+	// tlsConfig defines the TLS configuration to connect to the STACKIT API.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// enableHTTP2 defines whether to enable HTTP2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"` // nolint:kubeapilinter
+}
+
+// MarathonSDConfig configurations allow retrieving scrape targets from Marathon's REST API.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#marathon_sd_config
+//
+// Note: The `_file` variants of credential fields (e.g. `auth_token_file`)
+// from the Prometheus configuration are not supported. Use Kubernetes secrets via `authToken` instead.
+// +k8s:openapi-gen=true
+type MarathonSDConfig struct {
+	// servers defines the list of URLs to be used to contact Marathon servers.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +required
+	Servers []URL `json:"servers"`
+	// refreshInterval defines the time after which the applications are refreshed.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// authToken defines the Secret key containing the token used to
+	// authenticate against the Marathon servers with DC/OS token based
+	// authentication.
+	// Cannot be set at the same time as `basicAuth` or `authorization`.
+	// +optional
+	AuthToken *corev1.SecretKeySelector `json:"authToken,omitempty"`
+	// basicAuth defines the information to authenticate against the Marathon servers.
+	// Cannot be set at the same time as `authToken` or `authorization`.
+	// +optional
+	BasicAuth *v1.BasicAuth `json:"basicAuth,omitempty"`
+	// authorization defines the header configuration to authenticate against the Marathon servers.
+	// Cannot be set at the same time as `authToken` or `basicAuth`.
+	// +optional
+	Authorization  *v1.SafeAuthorization `json:"authorization,omitempty"`
+	v1.ProxyConfig `json:",inline"`
+	// tlsConfig defines the TLS configuration to connect to the Marathon servers.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// followRedirects defines whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"` // nolint:kubeapilinter
+	// enableHTTP2 defines whether to enable HTTP2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"` // nolint:kubeapilinter
+}
+
+// Role of the Triton targets to retrieve. Must be `Container` or `CN`.
+// +kubebuilder:validation:Enum=Container;CN
+type TritonRole string
+
+const (
+	TritonRoleContainer TritonRole = "Container"
+	TritonRoleCN        TritonRole = "CN"
+)
+
+// TritonSDConfig configurations allow retrieving scrape targets from Container Monitor discovery endpoints.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#triton_sd_config
+// +k8s:openapi-gen=true
+type TritonSDConfig struct {
+	// account defines the account to use for discovering new targets.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Account string `json:"account"`
+	// role defines the type of targets to discover: `Container` for the
+	// containers or `CN` for the compute nodes (global zones).
+	// If not set, Prometheus uses its default value (`Container`).
+	// The `CN` role requires Prometheus >= v2.19.0.
+	// +optional
+	Role *TritonRole `json:"role,omitempty"`
+	// dnsSuffix defines the DNS suffix which should be applied to the targets.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	DNSSuffix string `json:"dnsSuffix"`
+	// endpoint defines the Triton discovery endpoint (e.g. `cmon.us-east-3b.triton.zone`).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Endpoint string `json:"endpoint"`
+	// groups defines a list of groups for which targets are retrieved, only
+	// supported when `role` is `Container`. If empty, all containers
+	// available to the account are retrieved.
+	// +listType=set
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+	// port defines the port to use for discovery and metric scraping.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// refreshInterval defines the time after which the targets are refreshed.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// version defines the Triton discovery API version.
+	// If not set, Prometheus uses its default value.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Version *int32 `json:"version,omitempty"`
+	// tlsConfig defines the TLS configuration to connect to the Triton
+	// discovery endpoint, including the client certificate.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// UyuniSDConfig configurations allow retrieving scrape targets from the Uyuni API.
+// The operator requires Prometheus >= v2.31.0.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#uyuni_sd_config
+// +k8s:openapi-gen=true
+type UyuniSDConfig struct {
+	// server defines the URL of the Uyuni server.
+	// +required
+	Server URL `json:"server"`
+	// username defines the Secret key containing the username to authenticate against the Uyuni API.
+	// +required
+	Username corev1.SecretKeySelector `json:"username"`
+	// password defines the Secret key containing the password to authenticate against the Uyuni API.
+	// +required
+	Password corev1.SecretKeySelector `json:"password"`
+	// entitlement defines the entitlement string to filter the systems.
+	// If not set, Prometheus uses its default value.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Entitlement *string `json:"entitlement,omitempty"`
+	// separator defines the string by which Uyuni group names are joined into the groups label.
+	// If not set, Prometheus uses its default value.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Separator *string `json:"separator,omitempty"`
+	// refreshInterval defines the time after which the systems are refreshed.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	v1.ProxyConfig  `json:",inline"`
+	// tlsConfig defines the TLS configuration to connect to the Uyuni API.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// followRedirects defines whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"` // nolint:kubeapilinter
+	// enableHTTP2 defines whether to enable HTTP2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"` // nolint:kubeapilinter
+}
+
+// ServersetSDConfig configurations allow retrieving scrape targets from
+// Serversets which are stored in ZooKeeper.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#serverset_sd_config
+// +k8s:openapi-gen=true
+type ServersetSDConfig struct {
+	// servers defines the list of ZooKeeper servers, in the `host:port` format.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +required
+	Servers []string `json:"servers"`
+	// paths defines the ZooKeeper paths where the Serversets are registered.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +required
+	Paths []string `json:"paths"`
+	// timeout defines the ZooKeeper session timeout.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	Timeout *v1.Duration `json:"timeout,omitempty"`
+}
+
+// NerveSDConfig configurations allow retrieving scrape targets from AirBnB's
+// Nerve which are stored in ZooKeeper.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#nerve_sd_config
+// +k8s:openapi-gen=true
+type NerveSDConfig struct {
+	// servers defines the list of ZooKeeper servers, in the `host:port` format.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +required
+	Servers []string `json:"servers"`
+	// paths defines the ZooKeeper paths where the Nerve services are registered.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +required
+	Paths []string `json:"paths"`
+	// timeout defines the ZooKeeper session timeout.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	Timeout *v1.Duration `json:"timeout,omitempty"`
+}
@@ -21,6 +21,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"k8s.io/utils/ptr"
 )
@@ -103,6 +104,11 @@ func (mti MuteTimeInterval) Validate() error {
 				return fmt.Errorf("year range at %d is invalid: %w", i, err)
 			}
 		}
+		if ti.Location != nil {
+			if _, err := time.LoadLocation(*ti.Location); err != nil {
+				return fmt.Errorf("location at %d is invalid: %w", i, err)
+			}
+		}
 	}
 	return nil
 }
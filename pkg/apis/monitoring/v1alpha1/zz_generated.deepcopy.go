@@ -96,6 +96,16 @@ func (in *AlertmanagerConfigSpec) DeepCopyInto(out *AlertmanagerConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SharedTimeIntervals != nil {
+		in, out := &in.SharedTimeIntervals, &out.SharedTimeIntervals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SharedReceivers != nil {
+		in, out := &in.SharedReceivers, &out.SharedReceivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfigSpec.
@@ -108,6 +118,26 @@ func (in *AlertmanagerConfigSpec) DeepCopy() *AlertmanagerConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerReference) DeepCopyInto(out *AlertmanagerReference) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerReference.
+func (in *AlertmanagerReference) DeepCopy() *AlertmanagerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AttachMetadata) DeepCopyInto(out *AttachMetadata) {
 	*out = *in
@@ -1113,6 +1143,56 @@ func (in *HetznerSDConfig) DeepCopy() *HetznerSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncidentioConfig) DeepCopyInto(out *IncidentioConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.URLSecret != nil {
+		in, out := &in.URLSecret, &out.URLSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertSourceToken != nil {
+		in, out := &in.AlertSourceToken, &out.AlertSourceToken
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxAlerts != nil {
+		in, out := &in.MaxAlerts, &out.MaxAlerts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentioConfig.
+func (in *IncidentioConfig) DeepCopy() *IncidentioConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentioConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InhibitRule) DeepCopyInto(out *InhibitRule) {
 	*out = *in
@@ -1190,6 +1270,104 @@ func (in *IonosSDConfig) DeepCopy() *IonosSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraConfig) DeepCopyInto(out *JiraConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.APIType != nil {
+		in, out := &in.APIType, &out.APIType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenTransition != nil {
+		in, out := &in.ReopenTransition, &out.ReopenTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResolveTransition != nil {
+		in, out := &in.ResolveTransition, &out.ResolveTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.WontFixResolution != nil {
+		in, out := &in.WontFixResolution, &out.WontFixResolution
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenDuration != nil {
+		in, out := &in.ReopenDuration, &out.ReopenDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]JiraField, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraConfig.
+func (in *JiraConfig) DeepCopy() *JiraConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraField) DeepCopyInto(out *JiraField) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraField.
+func (in *JiraField) DeepCopy() *JiraField {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraField)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8SSelectorConfig) DeepCopyInto(out *K8SSelectorConfig) {
 	*out = *in
@@ -1583,94 +1761,22 @@ func (in *MSTeamsV2Config) DeepCopy() *MSTeamsV2Config {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Matcher) DeepCopyInto(out *Matcher) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Matcher.
-func (in *Matcher) DeepCopy() *Matcher {
-	if in == nil {
-		return nil
-	}
-	out := new(Matcher)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MuteTimeInterval) DeepCopyInto(out *MuteTimeInterval) {
-	*out = *in
-	if in.TimeIntervals != nil {
-		in, out := &in.TimeIntervals, &out.TimeIntervals
-		*out = make([]TimeInterval, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MuteTimeInterval.
-func (in *MuteTimeInterval) DeepCopy() *MuteTimeInterval {
-	if in == nil {
-		return nil
-	}
-	out := new(MuteTimeInterval)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespaceDiscovery) DeepCopyInto(out *NamespaceDiscovery) {
+func (in *MarathonSDConfig) DeepCopyInto(out *MarathonSDConfig) {
 	*out = *in
-	if in.IncludeOwnNamespace != nil {
-		in, out := &in.IncludeOwnNamespace, &out.IncludeOwnNamespace
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Names != nil {
-		in, out := &in.Names, &out.Names
-		*out = make([]string, len(*in))
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]URL, len(*in))
 		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDiscovery.
-func (in *NamespaceDiscovery) DeepCopy() *NamespaceDiscovery {
-	if in == nil {
-		return nil
-	}
-	out := new(NamespaceDiscovery)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NomadSDConfig) DeepCopyInto(out *NomadSDConfig) {
-	*out = *in
-	if in.AllowStale != nil {
-		in, out := &in.AllowStale, &out.AllowStale
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Namespace != nil {
-		in, out := &in.Namespace, &out.Namespace
-		*out = new(string)
-		**out = **in
-	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
-		*out = new(string)
-		**out = **in
-	}
-	if in.TagSeparator != nil {
-		in, out := &in.TagSeparator, &out.TagSeparator
-		*out = new(string)
-		**out = **in
+	if in.AuthToken != nil {
+		in, out := &in.AuthToken, &out.AuthToken
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
@@ -1682,17 +1788,12 @@ func (in *NomadSDConfig) DeepCopyInto(out *NomadSDConfig) {
 		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.OAuth2 != nil {
-		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(v1.OAuth2)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
 		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.FollowRedirects != nil {
 		in, out := &in.FollowRedirects, &out.FollowRedirects
 		*out = new(bool)
@@ -1705,47 +1806,434 @@ func (in *NomadSDConfig) DeepCopyInto(out *NomadSDConfig) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NomadSDConfig.
-func (in *NomadSDConfig) DeepCopy() *NomadSDConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MarathonSDConfig.
+func (in *MarathonSDConfig) DeepCopy() *MarathonSDConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NomadSDConfig)
+	out := new(MarathonSDConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OVHCloudSDConfig) DeepCopyInto(out *OVHCloudSDConfig) {
+func (in *Matcher) DeepCopyInto(out *Matcher) {
 	*out = *in
-	in.ApplicationSecret.DeepCopyInto(&out.ApplicationSecret)
-	in.ConsumerKey.DeepCopyInto(&out.ConsumerKey)
-	if in.Endpoint != nil {
-		in, out := &in.Endpoint, &out.Endpoint
-		*out = new(string)
-		**out = **in
-	}
-	if in.RefreshInterval != nil {
-		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(v1.Duration)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVHCloudSDConfig.
-func (in *OVHCloudSDConfig) DeepCopy() *OVHCloudSDConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Matcher.
+func (in *Matcher) DeepCopy() *Matcher {
 	if in == nil {
 		return nil
 	}
-	out := new(OVHCloudSDConfig)
+	out := new(Matcher)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenStackSDConfig) DeepCopyInto(out *OpenStackSDConfig) {
+func (in *MattermostAttachment) DeepCopyInto(out *MattermostAttachment) {
 	*out = *in
-	if in.IdentityEndpoint != nil {
+	if in.Fallback != nil {
+		in, out := &in.Fallback, &out.Fallback
+		*out = new(string)
+		**out = **in
+	}
+	if in.Color != nil {
+		in, out := &in.Color, &out.Color
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pretext != nil {
+		in, out := &in.Pretext, &out.Pretext
+		*out = new(string)
+		**out = **in
+	}
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthorName != nil {
+		in, out := &in.AuthorName, &out.AuthorName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthorLink != nil {
+		in, out := &in.AuthorLink, &out.AuthorLink
+		*out = new(URL)
+		**out = **in
+	}
+	if in.AuthorIcon != nil {
+		in, out := &in.AuthorIcon, &out.AuthorIcon
+		*out = new(URL)
+		**out = **in
+	}
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+	if in.TitleLink != nil {
+		in, out := &in.TitleLink, &out.TitleLink
+		*out = new(URL)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]MattermostField, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ThumbURL != nil {
+		in, out := &in.ThumbURL, &out.ThumbURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.Footer != nil {
+		in, out := &in.Footer, &out.Footer
+		*out = new(string)
+		**out = **in
+	}
+	if in.FooterIcon != nil {
+		in, out := &in.FooterIcon, &out.FooterIcon
+		*out = new(URL)
+		**out = **in
+	}
+	if in.ImageURL != nil {
+		in, out := &in.ImageURL, &out.ImageURL
+		*out = new(URL)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostAttachment.
+func (in *MattermostAttachment) DeepCopy() *MattermostAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostConfig) DeepCopyInto(out *MattermostConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WebhookURL != nil {
+		in, out := &in.WebhookURL, &out.WebhookURL
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(string)
+		**out = **in
+	}
+	if in.IconURL != nil {
+		in, out := &in.IconURL, &out.IconURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.IconEmoji != nil {
+		in, out := &in.IconEmoji, &out.IconEmoji
+		*out = new(string)
+		**out = **in
+	}
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = make([]MattermostAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Props != nil {
+		in, out := &in.Props, &out.Props
+		*out = new(MattermostProps)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(MattermostPriority)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostConfig.
+func (in *MattermostConfig) DeepCopy() *MattermostConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostField) DeepCopyInto(out *MattermostField) {
+	*out = *in
+	if in.Short != nil {
+		in, out := &in.Short, &out.Short
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostField.
+func (in *MattermostField) DeepCopy() *MattermostField {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostPriority) DeepCopyInto(out *MattermostPriority) {
+	*out = *in
+	if in.RequestedAck != nil {
+		in, out := &in.RequestedAck, &out.RequestedAck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PersistentNotifications != nil {
+		in, out := &in.PersistentNotifications, &out.PersistentNotifications
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostPriority.
+func (in *MattermostPriority) DeepCopy() *MattermostPriority {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostPriority)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostProps) DeepCopyInto(out *MattermostProps) {
+	*out = *in
+	if in.Card != nil {
+		in, out := &in.Card, &out.Card
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostProps.
+func (in *MattermostProps) DeepCopy() *MattermostProps {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostProps)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MuteTimeInterval) DeepCopyInto(out *MuteTimeInterval) {
+	*out = *in
+	if in.TimeIntervals != nil {
+		in, out := &in.TimeIntervals, &out.TimeIntervals
+		*out = make([]TimeInterval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MuteTimeInterval.
+func (in *MuteTimeInterval) DeepCopy() *MuteTimeInterval {
+	if in == nil {
+		return nil
+	}
+	out := new(MuteTimeInterval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDiscovery) DeepCopyInto(out *NamespaceDiscovery) {
+	*out = *in
+	if in.IncludeOwnNamespace != nil {
+		in, out := &in.IncludeOwnNamespace, &out.IncludeOwnNamespace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDiscovery.
+func (in *NamespaceDiscovery) DeepCopy() *NamespaceDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NerveSDConfig) DeepCopyInto(out *NerveSDConfig) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NerveSDConfig.
+func (in *NerveSDConfig) DeepCopy() *NerveSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NerveSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NomadSDConfig) DeepCopyInto(out *NomadSDConfig) {
+	*out = *in
+	if in.AllowStale != nil {
+		in, out := &in.AllowStale, &out.AllowStale
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagSeparator != nil {
+		in, out := &in.TagSeparator, &out.TagSeparator
+		*out = new(string)
+		**out = **in
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(v1.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(v1.SafeAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(v1.OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NomadSDConfig.
+func (in *NomadSDConfig) DeepCopy() *NomadSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NomadSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVHCloudSDConfig) DeepCopyInto(out *OVHCloudSDConfig) {
+	*out = *in
+	in.ApplicationSecret.DeepCopyInto(&out.ApplicationSecret)
+	in.ConsumerKey.DeepCopyInto(&out.ConsumerKey)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVHCloudSDConfig.
+func (in *OVHCloudSDConfig) DeepCopy() *OVHCloudSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OVHCloudSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackSDConfig) DeepCopyInto(out *OpenStackSDConfig) {
+	*out = *in
+	if in.IdentityEndpoint != nil {
 		in, out := &in.IdentityEndpoint, &out.IdentityEndpoint
 		*out = new(URL)
 		**out = **in
@@ -2444,21 +2932,155 @@ func (in *Receiver) DeepCopyInto(out *Receiver) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.RocketChatConfigs != nil {
-		in, out := &in.RocketChatConfigs, &out.RocketChatConfigs
-		*out = make([]RocketChatConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.RocketChatConfigs != nil {
+		in, out := &in.RocketChatConfigs, &out.RocketChatConfigs
+		*out = make([]RocketChatConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JiraConfigs != nil {
+		in, out := &in.JiraConfigs, &out.JiraConfigs
+		*out = make([]JiraConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MattermostConfigs != nil {
+		in, out := &in.MattermostConfigs, &out.MattermostConfigs
+		*out = make([]MattermostConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IncidentioConfigs != nil {
+		in, out := &in.IncidentioConfigs, &out.IncidentioConfigs
+		*out = make([]IncidentioConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receiver.
+func (in *Receiver) DeepCopy() *Receiver {
+	if in == nil {
+		return nil
+	}
+	out := new(Receiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverTest) DeepCopyInto(out *ReceiverTest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverTest.
+func (in *ReceiverTest) DeepCopy() *ReceiverTest {
+	if in == nil {
+		return nil
+	}
+	out := new(ReceiverTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverTestList) DeepCopyInto(out *ReceiverTestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReceiverTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverTestList.
+func (in *ReceiverTestList) DeepCopy() *ReceiverTestList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReceiverTestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverTestResult) DeepCopyInto(out *ReceiverTestResult) {
+	*out = *in
+	out.Latency = in.Latency
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverTestResult.
+func (in *ReceiverTestResult) DeepCopy() *ReceiverTestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ReceiverTestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverTestSpec) DeepCopyInto(out *ReceiverTestSpec) {
+	*out = *in
+	in.Alertmanager.DeepCopyInto(&out.Alertmanager)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverTestSpec.
+func (in *ReceiverTestSpec) DeepCopy() *ReceiverTestSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(ReceiverTestSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receiver.
-func (in *Receiver) DeepCopy() *Receiver {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverTestStatus) DeepCopyInto(out *ReceiverTestStatus) {
+	*out = *in
+	if in.TestTime != nil {
+		in, out := &in.TestTime, &out.TestTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]ReceiverTestResult, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverTestStatus.
+func (in *ReceiverTestStatus) DeepCopy() *ReceiverTestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Receiver)
+	out := new(ReceiverTestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2748,6 +3370,72 @@ func (in *SNSConfig) DeepCopy() *SNSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *STACKITSDConfig) DeepCopyInto(out *STACKITSDConfig) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(URL)
+		**out = **in
+	}
+	if in.ServiceAccountKey != nil {
+		in, out := &in.ServiceAccountKey, &out.ServiceAccountKey
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(v1.SafeAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new STACKITSDConfig.
+func (in *STACKITSDConfig) DeepCopy() *STACKITSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(STACKITSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalewaySDConfig) DeepCopyInto(out *ScalewaySDConfig) {
 	*out = *in
@@ -2868,6 +3556,13 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.StaticConfigsFrom != nil {
+		in, out := &in.StaticConfigsFrom, &out.StaticConfigsFrom
+		*out = make([]v1.SecretOrConfigMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FileSDConfigs != nil {
 		in, out := &in.FileSDConfigs, &out.FileSDConfigs
 		*out = make([]FileSDConfig, len(*in))
@@ -3022,6 +3717,55 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.VultrSDConfigs != nil {
+		in, out := &in.VultrSDConfigs, &out.VultrSDConfigs
+		*out = make([]VultrSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.STACKITSDConfigs != nil {
+		in, out := &in.STACKITSDConfigs, &out.STACKITSDConfigs
+		*out = make([]STACKITSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MarathonSDConfigs != nil {
+		in, out := &in.MarathonSDConfigs, &out.MarathonSDConfigs
+		*out = make([]MarathonSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TritonSDConfigs != nil {
+		in, out := &in.TritonSDConfigs, &out.TritonSDConfigs
+		*out = make([]TritonSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UyuniSDConfigs != nil {
+		in, out := &in.UyuniSDConfigs, &out.UyuniSDConfigs
+		*out = make([]UyuniSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServersetSDConfigs != nil {
+		in, out := &in.ServersetSDConfigs, &out.ServersetSDConfigs
+		*out = make([]ServersetSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NerveSDConfigs != nil {
+		in, out := &in.NerveSDConfigs, &out.NerveSDConfigs
+		*out = make([]NerveSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RelabelConfigs != nil {
 		in, out := &in.RelabelConfigs, &out.RelabelConfigs
 		*out = make([]v1.RelabelConfig, len(*in))
@@ -3191,6 +3935,36 @@ func (in *ScrapeConfigSpec) DeepCopy() *ScrapeConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServersetSDConfig) DeepCopyInto(out *ServersetSDConfig) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServersetSDConfig.
+func (in *ServersetSDConfig) DeepCopy() *ServersetSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServersetSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SlackAction) DeepCopyInto(out *SlackAction) {
 	*out = *in
@@ -3500,6 +4274,11 @@ func (in *TimeInterval) DeepCopyInto(out *TimeInterval) {
 		*out = make([]YearRange, len(*in))
 		copy(*out, *in)
 	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeInterval.
@@ -3527,6 +4306,99 @@ func (in *TimeRange) DeepCopy() *TimeRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TritonSDConfig) DeepCopyInto(out *TritonSDConfig) {
+	*out = *in
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(TritonRole)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TritonSDConfig.
+func (in *TritonSDConfig) DeepCopy() *TritonSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TritonSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UyuniSDConfig) DeepCopyInto(out *UyuniSDConfig) {
+	*out = *in
+	in.Username.DeepCopyInto(&out.Username)
+	in.Password.DeepCopyInto(&out.Password)
+	if in.Entitlement != nil {
+		in, out := &in.Entitlement, &out.Entitlement
+		*out = new(string)
+		**out = **in
+	}
+	if in.Separator != nil {
+		in, out := &in.Separator, &out.Separator
+		*out = new(string)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UyuniSDConfig.
+func (in *UyuniSDConfig) DeepCopy() *UyuniSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UyuniSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VictorOpsConfig) DeepCopyInto(out *VictorOpsConfig) {
 	*out = *in
@@ -3587,6 +4459,57 @@ func (in *VictorOpsConfig) DeepCopy() *VictorOpsConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VultrSDConfig) DeepCopyInto(out *VultrSDConfig) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(v1.SafeAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(v1.OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VultrSDConfig.
+func (in *VultrSDConfig) DeepCopy() *VultrSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VultrSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WeChatConfig) DeepCopyInto(out *WeChatConfig) {
 	*out = *in
@@ -130,6 +130,7 @@ func convertTimeIntervalsFrom(in []v1alpha1.TimeInterval) []TimePeriod {
 				DaysOfMonth: doms,
 				Months:      mrs,
 				Years:       yrs,
+				Location:    ti.Location,
 			},
 		)
 	}
@@ -328,6 +329,122 @@ func convertRocketchatConfigFrom(in v1alpha1.RocketChatConfig) RocketChatConfig
 	}
 }
 
+func convertJiraFieldsFrom(in []v1alpha1.JiraField) []JiraField {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]JiraField, len(in))
+	for i, f := range in {
+		out[i] = JiraField{
+			Key:   f.Key,
+			Value: *f.Value.DeepCopy(),
+		}
+	}
+	return out
+}
+
+func convertJiraConfigFrom(in v1alpha1.JiraConfig) JiraConfig {
+	return JiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            (*URL)(in.APIURL),
+		APIType:           in.APIType,
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           in.Summary,
+		Description:       in.Description,
+		Labels:            in.Labels,
+		Priority:          in.Priority,
+		ReopenTransition:  in.ReopenTransition,
+		ResolveTransition: in.ResolveTransition,
+		WontFixResolution: in.WontFixResolution,
+		ReopenDuration:    in.ReopenDuration,
+		Fields:            convertJiraFieldsFrom(in.Fields),
+		HTTPConfig:        convertHTTPConfigFrom(in.HTTPConfig),
+	}
+}
+
+func convertMattermostAttachmentsFrom(in []v1alpha1.MattermostAttachment) []MattermostAttachment {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]MattermostAttachment, len(in))
+	for i, a := range in {
+		var fields []MattermostField
+		if a.Fields != nil {
+			fields = make([]MattermostField, len(a.Fields))
+			for j, f := range a.Fields {
+				fields[j] = MattermostField{
+					Title: f.Title,
+					Value: f.Value,
+					Short: f.Short,
+				}
+			}
+		}
+
+		out[i] = MattermostAttachment{
+			Fallback:   a.Fallback,
+			Color:      a.Color,
+			Pretext:    a.Pretext,
+			Text:       a.Text,
+			AuthorName: a.AuthorName,
+			AuthorLink: (*URL)(a.AuthorLink),
+			AuthorIcon: (*URL)(a.AuthorIcon),
+			Title:      a.Title,
+			TitleLink:  (*URL)(a.TitleLink),
+			Fields:     fields,
+			ThumbURL:   (*URL)(a.ThumbURL),
+			Footer:     a.Footer,
+			FooterIcon: (*URL)(a.FooterIcon),
+			ImageURL:   (*URL)(a.ImageURL),
+		}
+	}
+	return out
+}
+
+func convertMattermostConfigFrom(in v1alpha1.MattermostConfig) MattermostConfig {
+	out := MattermostConfig{
+		SendResolved: in.SendResolved,
+		WebhookURL:   in.WebhookURL,
+		Channel:      in.Channel,
+		Username:     in.Username,
+		Text:         in.Text,
+		IconURL:      (*URL)(in.IconURL),
+		IconEmoji:    in.IconEmoji,
+		Attachments:  convertMattermostAttachmentsFrom(in.Attachments),
+		HTTPConfig:   convertHTTPConfigFrom(in.HTTPConfig),
+	}
+
+	if in.Props != nil {
+		out.Props = &MattermostProps{
+			Card: in.Props.Card,
+		}
+	}
+
+	if in.Priority != nil {
+		out.Priority = &MattermostPriority{
+			Priority:                in.Priority.Priority,
+			RequestedAck:            in.Priority.RequestedAck,
+			PersistentNotifications: in.Priority.PersistentNotifications,
+		}
+	}
+
+	return out
+}
+
+func convertIncidentioConfigFrom(in v1alpha1.IncidentioConfig) IncidentioConfig {
+	return IncidentioConfig{
+		SendResolved:     in.SendResolved,
+		URL:              (*URL)(in.URL),
+		URLSecret:        in.URLSecret,
+		AlertSourceToken: in.AlertSourceToken,
+		MaxAlerts:        in.MaxAlerts,
+		Timeout:          in.Timeout,
+		HTTPConfig:       convertHTTPConfigFrom(in.HTTPConfig),
+	}
+}
+
 func convertSlackFieldsFrom(in []v1alpha1.SlackField) []SlackField {
 	out := make([]SlackField, len(in))
 
@@ -455,6 +572,10 @@ func convertEmailConfigFrom(in v1alpha1.EmailConfig) EmailConfig {
 }
 
 func convertEmailThreadingConfigFrom(in *v1alpha1.EmailThreadingConfig) *EmailThreadingConfig {
+	if in == nil {
+		return nil
+	}
+
 	return &EmailThreadingConfig{
 		ThreadByDate: ThreadByDateType(in.ThreadByDate),
 	}
@@ -665,6 +786,27 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 			)
 		}
 
+		for _, in := range in.JiraConfigs {
+			out.JiraConfigs = append(
+				out.JiraConfigs,
+				convertJiraConfigFrom(in),
+			)
+		}
+
+		for _, in := range in.MattermostConfigs {
+			out.MattermostConfigs = append(
+				out.MattermostConfigs,
+				convertMattermostConfigFrom(in),
+			)
+		}
+
+		for _, in := range in.IncidentioConfigs {
+			out.IncidentioConfigs = append(
+				out.IncidentioConfigs,
+				convertIncidentioConfigFrom(in),
+			)
+		}
+
 		dst.Spec.Receivers = append(dst.Spec.Receivers, out)
 	}
 
@@ -689,6 +831,9 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 		)
 	}
 
+	dst.Spec.SharedTimeIntervals = src.Spec.SharedTimeIntervals
+	dst.Spec.SharedReceivers = src.Spec.SharedReceivers
+
 	r, err := convertRouteFrom(src.Spec.Route)
 	if err != nil {
 		return err
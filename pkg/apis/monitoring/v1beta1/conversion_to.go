@@ -123,6 +123,7 @@ func convertTimeIntervalsTo(in []TimePeriod) []v1alpha1.TimeInterval {
 				DaysOfMonth: doms,
 				Months:      mrs,
 				Years:       yrs,
+				Location:    ti.Location,
 			},
 		)
 	}
@@ -324,6 +325,122 @@ func convertRocketchatConfigTo(in RocketChatConfig) v1alpha1.RocketChatConfig {
 	}
 }
 
+func convertJiraFieldsTo(in []JiraField) []v1alpha1.JiraField {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]v1alpha1.JiraField, len(in))
+	for i, f := range in {
+		out[i] = v1alpha1.JiraField{
+			Key:   f.Key,
+			Value: *f.Value.DeepCopy(),
+		}
+	}
+	return out
+}
+
+func convertJiraConfigTo(in JiraConfig) v1alpha1.JiraConfig {
+	return v1alpha1.JiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            (*v1alpha1.URL)(in.APIURL),
+		APIType:           in.APIType,
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           in.Summary,
+		Description:       in.Description,
+		Labels:            in.Labels,
+		Priority:          in.Priority,
+		ReopenTransition:  in.ReopenTransition,
+		ResolveTransition: in.ResolveTransition,
+		WontFixResolution: in.WontFixResolution,
+		ReopenDuration:    in.ReopenDuration,
+		Fields:            convertJiraFieldsTo(in.Fields),
+		HTTPConfig:        convertHTTPConfigTo(in.HTTPConfig),
+	}
+}
+
+func convertMattermostAttachmentsTo(in []MattermostAttachment) []v1alpha1.MattermostAttachment {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]v1alpha1.MattermostAttachment, len(in))
+	for i, a := range in {
+		var fields []v1alpha1.MattermostField
+		if a.Fields != nil {
+			fields = make([]v1alpha1.MattermostField, len(a.Fields))
+			for j, f := range a.Fields {
+				fields[j] = v1alpha1.MattermostField{
+					Title: f.Title,
+					Value: f.Value,
+					Short: f.Short,
+				}
+			}
+		}
+
+		out[i] = v1alpha1.MattermostAttachment{
+			Fallback:   a.Fallback,
+			Color:      a.Color,
+			Pretext:    a.Pretext,
+			Text:       a.Text,
+			AuthorName: a.AuthorName,
+			AuthorLink: (*v1alpha1.URL)(a.AuthorLink),
+			AuthorIcon: (*v1alpha1.URL)(a.AuthorIcon),
+			Title:      a.Title,
+			TitleLink:  (*v1alpha1.URL)(a.TitleLink),
+			Fields:     fields,
+			ThumbURL:   (*v1alpha1.URL)(a.ThumbURL),
+			Footer:     a.Footer,
+			FooterIcon: (*v1alpha1.URL)(a.FooterIcon),
+			ImageURL:   (*v1alpha1.URL)(a.ImageURL),
+		}
+	}
+	return out
+}
+
+func convertMattermostConfigTo(in MattermostConfig) v1alpha1.MattermostConfig {
+	out := v1alpha1.MattermostConfig{
+		SendResolved: in.SendResolved,
+		WebhookURL:   in.WebhookURL,
+		Channel:      in.Channel,
+		Username:     in.Username,
+		Text:         in.Text,
+		IconURL:      (*v1alpha1.URL)(in.IconURL),
+		IconEmoji:    in.IconEmoji,
+		Attachments:  convertMattermostAttachmentsTo(in.Attachments),
+		HTTPConfig:   convertHTTPConfigTo(in.HTTPConfig),
+	}
+
+	if in.Props != nil {
+		out.Props = &v1alpha1.MattermostProps{
+			Card: in.Props.Card,
+		}
+	}
+
+	if in.Priority != nil {
+		out.Priority = &v1alpha1.MattermostPriority{
+			Priority:                in.Priority.Priority,
+			RequestedAck:            in.Priority.RequestedAck,
+			PersistentNotifications: in.Priority.PersistentNotifications,
+		}
+	}
+
+	return out
+}
+
+func convertIncidentioConfigTo(in IncidentioConfig) v1alpha1.IncidentioConfig {
+	return v1alpha1.IncidentioConfig{
+		SendResolved:     in.SendResolved,
+		URL:              (*v1alpha1.URL)(in.URL),
+		URLSecret:        in.URLSecret,
+		AlertSourceToken: in.AlertSourceToken,
+		MaxAlerts:        in.MaxAlerts,
+		Timeout:          in.Timeout,
+		HTTPConfig:       convertHTTPConfigTo(in.HTTPConfig),
+	}
+}
+
 func convertSlackFieldsTo(in []SlackField) []v1alpha1.SlackField {
 	out := make([]v1alpha1.SlackField, len(in))
 
@@ -451,6 +568,10 @@ func convertEmailConfigTo(in EmailConfig) v1alpha1.EmailConfig {
 }
 
 func convertEmailThreadingConfigTo(in *EmailThreadingConfig) *v1alpha1.EmailThreadingConfig {
+	if in == nil {
+		return nil
+	}
+
 	return &v1alpha1.EmailThreadingConfig{
 		ThreadByDate: v1alpha1.ThreadByDateType(in.ThreadByDate),
 	}
@@ -661,6 +782,27 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 			)
 		}
 
+		for _, in := range in.JiraConfigs {
+			out.JiraConfigs = append(
+				out.JiraConfigs,
+				convertJiraConfigTo(in),
+			)
+		}
+
+		for _, in := range in.MattermostConfigs {
+			out.MattermostConfigs = append(
+				out.MattermostConfigs,
+				convertMattermostConfigTo(in),
+			)
+		}
+
+		for _, in := range in.IncidentioConfigs {
+			out.IncidentioConfigs = append(
+				out.IncidentioConfigs,
+				convertIncidentioConfigTo(in),
+			)
+		}
+
 		dst.Spec.Receivers = append(dst.Spec.Receivers, out)
 	}
 
@@ -686,6 +828,9 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 		)
 	}
 
+	dst.Spec.SharedTimeIntervals = src.Spec.SharedTimeIntervals
+	dst.Spec.SharedReceivers = src.Spec.SharedReceivers
+
 	r, err := convertRouteTo(src.Spec.Route)
 	if err != nil {
 		return err
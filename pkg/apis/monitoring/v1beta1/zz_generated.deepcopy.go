@@ -96,6 +96,16 @@ func (in *AlertmanagerConfigSpec) DeepCopyInto(out *AlertmanagerConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SharedTimeIntervals != nil {
+		in, out := &in.SharedTimeIntervals, &out.SharedTimeIntervals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SharedReceivers != nil {
+		in, out := &in.SharedReceivers, &out.SharedReceivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfigSpec.
@@ -340,6 +350,56 @@ func (in *HTTPConfig) DeepCopy() *HTTPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncidentioConfig) DeepCopyInto(out *IncidentioConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.URLSecret != nil {
+		in, out := &in.URLSecret, &out.URLSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertSourceToken != nil {
+		in, out := &in.AlertSourceToken, &out.AlertSourceToken
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxAlerts != nil {
+		in, out := &in.MaxAlerts, &out.MaxAlerts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentioConfig.
+func (in *IncidentioConfig) DeepCopy() *IncidentioConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentioConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InhibitRule) DeepCopyInto(out *InhibitRule) {
 	*out = *in
@@ -370,6 +430,104 @@ func (in *InhibitRule) DeepCopy() *InhibitRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraConfig) DeepCopyInto(out *JiraConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.APIType != nil {
+		in, out := &in.APIType, &out.APIType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenTransition != nil {
+		in, out := &in.ReopenTransition, &out.ReopenTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResolveTransition != nil {
+		in, out := &in.ResolveTransition, &out.ResolveTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.WontFixResolution != nil {
+		in, out := &in.WontFixResolution, &out.WontFixResolution
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenDuration != nil {
+		in, out := &in.ReopenDuration, &out.ReopenDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]JiraField, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraConfig.
+func (in *JiraConfig) DeepCopy() *JiraConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraField) DeepCopyInto(out *JiraField) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraField.
+func (in *JiraField) DeepCopy() *JiraField {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraField)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyValue) DeepCopyInto(out *KeyValue) {
 	*out = *in
@@ -481,6 +639,230 @@ func (in *Matcher) DeepCopy() *Matcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostAttachment) DeepCopyInto(out *MattermostAttachment) {
+	*out = *in
+	if in.Fallback != nil {
+		in, out := &in.Fallback, &out.Fallback
+		*out = new(string)
+		**out = **in
+	}
+	if in.Color != nil {
+		in, out := &in.Color, &out.Color
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pretext != nil {
+		in, out := &in.Pretext, &out.Pretext
+		*out = new(string)
+		**out = **in
+	}
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthorName != nil {
+		in, out := &in.AuthorName, &out.AuthorName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthorLink != nil {
+		in, out := &in.AuthorLink, &out.AuthorLink
+		*out = new(URL)
+		**out = **in
+	}
+	if in.AuthorIcon != nil {
+		in, out := &in.AuthorIcon, &out.AuthorIcon
+		*out = new(URL)
+		**out = **in
+	}
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+	if in.TitleLink != nil {
+		in, out := &in.TitleLink, &out.TitleLink
+		*out = new(URL)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]MattermostField, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ThumbURL != nil {
+		in, out := &in.ThumbURL, &out.ThumbURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.Footer != nil {
+		in, out := &in.Footer, &out.Footer
+		*out = new(string)
+		**out = **in
+	}
+	if in.FooterIcon != nil {
+		in, out := &in.FooterIcon, &out.FooterIcon
+		*out = new(URL)
+		**out = **in
+	}
+	if in.ImageURL != nil {
+		in, out := &in.ImageURL, &out.ImageURL
+		*out = new(URL)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostAttachment.
+func (in *MattermostAttachment) DeepCopy() *MattermostAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostConfig) DeepCopyInto(out *MattermostConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WebhookURL != nil {
+		in, out := &in.WebhookURL, &out.WebhookURL
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(string)
+		**out = **in
+	}
+	if in.IconURL != nil {
+		in, out := &in.IconURL, &out.IconURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.IconEmoji != nil {
+		in, out := &in.IconEmoji, &out.IconEmoji
+		*out = new(string)
+		**out = **in
+	}
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = make([]MattermostAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Props != nil {
+		in, out := &in.Props, &out.Props
+		*out = new(MattermostProps)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(MattermostPriority)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostConfig.
+func (in *MattermostConfig) DeepCopy() *MattermostConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostField) DeepCopyInto(out *MattermostField) {
+	*out = *in
+	if in.Short != nil {
+		in, out := &in.Short, &out.Short
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostField.
+func (in *MattermostField) DeepCopy() *MattermostField {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostPriority) DeepCopyInto(out *MattermostPriority) {
+	*out = *in
+	if in.RequestedAck != nil {
+		in, out := &in.RequestedAck, &out.RequestedAck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PersistentNotifications != nil {
+		in, out := &in.PersistentNotifications, &out.PersistentNotifications
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostPriority.
+func (in *MattermostPriority) DeepCopy() *MattermostPriority {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostPriority)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostProps) DeepCopyInto(out *MattermostProps) {
+	*out = *in
+	if in.Card != nil {
+		in, out := &in.Card, &out.Card
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostProps.
+func (in *MattermostProps) DeepCopy() *MattermostProps {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostProps)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpsGenieConfig) DeepCopyInto(out *OpsGenieConfig) {
 	*out = *in
@@ -965,6 +1347,27 @@ func (in *Receiver) DeepCopyInto(out *Receiver) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.JiraConfigs != nil {
+		in, out := &in.JiraConfigs, &out.JiraConfigs
+		*out = make([]JiraConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MattermostConfigs != nil {
+		in, out := &in.MattermostConfigs, &out.MattermostConfigs
+		*out = make([]MattermostConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IncidentioConfigs != nil {
+		in, out := &in.IncidentioConfigs, &out.IncidentioConfigs
+		*out = make([]IncidentioConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receiver.
@@ -1581,6 +1984,11 @@ func (in *TimePeriod) DeepCopyInto(out *TimePeriod) {
 		*out = make([]YearRange, len(*in))
 		copy(*out, *in)
 	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimePeriod.
@@ -43,6 +43,10 @@ type StoreBuilder struct {
 	objStore   cache.Store
 	refTracker RefTracker
 
+	// assetVersions records the resource versions of the objects accessed
+	// via ForNamespace() when not nil.
+	assetVersions AssetVersions
+
 	tlsAssetKeys map[tlsAssetKey]struct{}
 }
 
@@ -356,29 +360,33 @@ func (s *StoreBuilder) ForNamespace(namespace string) StoreGetter {
 		panic("namespace can't be empty")
 	}
 	return &cacheOnlyStore{
-		ns: namespace,
-		c:  s.objStore,
+		ns:       namespace,
+		c:        s.objStore,
+		versions: s.assetVersions,
 	}
 }
 
 type cacheOnlyStore struct {
-	ns string
-	c  cache.Store
+	ns       string
+	c        cache.Store
+	versions AssetVersions
 }
 
 var _ = StoreGetter(&cacheOnlyStore{})
 
 func (cos *cacheOnlyStore) GetConfigMapKey(sel corev1.ConfigMapKeySelector) (string, error) {
-	obj, exists, err := cos.c.Get(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: sel.Name, Namespace: cos.ns}})
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: sel.Name, Namespace: cos.ns}}
+	obj, exists, err := cos.c.Get(cm)
 	if err != nil {
 		return "", fmt.Errorf("failed to get configmap %s/%s: %w", cos.ns, sel.Name, err)
 	}
+	cos.versions.record(configMapKey(cm), obj)
 
 	if !exists {
 		return "", fmt.Errorf("configmap %s/%s not found", cos.ns, sel.Name)
 	}
 
-	cm := obj.(*corev1.ConfigMap)
+	cm = obj.(*corev1.ConfigMap)
 	if _, found := cm.Data[sel.Key]; !found {
 		return "", fmt.Errorf("key %q in configmap %s/%s not found", sel.Key, cos.ns, sel.Name)
 	}
@@ -387,21 +395,23 @@ func (cos *cacheOnlyStore) GetConfigMapKey(sel corev1.ConfigMapKeySelector) (str
 }
 
 func (cos *cacheOnlyStore) GetSecretKey(sel corev1.SecretKeySelector) ([]byte, error) {
-	obj, exists, err := cos.c.Get(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: sel.Name, Namespace: cos.ns}})
+	sec := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: sel.Name, Namespace: cos.ns}}
+	obj, exists, err := cos.c.Get(sec)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get secret %s/%s: %w", cos.ns, sel.Name, err)
 	}
+	cos.versions.record(secretKey(sec), obj)
 
 	if !exists {
 		return nil, fmt.Errorf("secret %s/%s not found", cos.ns, sel.Name)
 	}
 
-	s := obj.(*corev1.Secret)
-	if _, found := s.Data[sel.Key]; !found {
+	sec = obj.(*corev1.Secret)
+	if _, found := sec.Data[sel.Key]; !found {
 		return nil, fmt.Errorf("key %q in secret %s/%s not found", sel.Key, cos.ns, sel.Name)
 	}
 
-	return s.Data[sel.Key], nil
+	return sec.Data[sel.Key], nil
 }
 
 func (cos *cacheOnlyStore) GetSecretOrConfigMapKey(key monitoringv1.SecretOrConfigMap) (string, error) {
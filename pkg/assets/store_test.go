@@ -1472,3 +1472,79 @@ func TestAddObject(t *testing.T) {
 	err = store.AddObject(nil)
 	require.Error(t, err)
 }
+
+func TestAssetVersions(t *testing.T) {
+	store := NewTestStoreBuilder(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "secret",
+				Namespace:       "ns1",
+				ResourceVersion: "1",
+			},
+			Data: map[string][]byte{
+				"key1": []byte("val1"),
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "cm",
+				Namespace:       "ns1",
+				ResourceVersion: "1",
+			},
+			Data: map[string]string{
+				"cmKey": "cmVal",
+			},
+		},
+	)
+
+	av := AssetVersions{}
+	s := store.WithAssetVersions(av).ForNamespace("ns1")
+
+	_, err := s.GetSecretKey(corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Key: "key1"})
+	require.NoError(t, err)
+	_, err = s.GetConfigMapKey(corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cm"}, Key: "cmKey"})
+	require.NoError(t, err)
+	_, err = s.GetSecretKey(corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "key1"})
+	require.Error(t, err)
+
+	require.Equal(t, AssetVersions{
+		secretKey(&metav1.ObjectMeta{Name: "secret", Namespace: "ns1"}):  "1",
+		configMapKey(&metav1.ObjectMeta{Name: "cm", Namespace: "ns1"}):   "1",
+		secretKey(&metav1.ObjectMeta{Name: "missing", Namespace: "ns1"}): "",
+	}, av)
+	require.True(t, store.MatchAssetVersions(av))
+
+	// The original store doesn't record the versions.
+	_, err = store.ForNamespace("ns1").GetSecretKey(corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Key: "key1"})
+	require.NoError(t, err)
+	require.Len(t, av, 3)
+
+	err = store.UpdateObject(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "missing",
+			Namespace:       "ns1",
+			ResourceVersion: "1",
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, store.MatchAssetVersions(av))
+
+	err = store.DeleteObject(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "missing",
+			Namespace: "ns1",
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, store.MatchAssetVersions(av))
+
+	err = store.UpdateObject(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "cm",
+			Namespace:       "ns1",
+			ResourceVersion: "2",
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, store.MatchAssetVersions(av))
+}
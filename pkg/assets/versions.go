@@ -0,0 +1,79 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assets
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// AssetVersions records the resource versions of the secrets and configmaps
+// which have been accessed from the store. An empty version means that the
+// object didn't exist.
+type AssetVersions map[string]string
+
+// record adds the resource version of the object to the map. It is a no-op
+// for a nil map.
+func (av AssetVersions) record(key string, obj any) {
+	if av == nil {
+		return
+	}
+
+	if obj == nil {
+		av[key] = ""
+		return
+	}
+
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+
+	av[key] = objMeta.GetResourceVersion()
+}
+
+// WithAssetVersions returns a store sharing the same objects as s which
+// records into av the resource versions of the objects accessed via
+// ForNamespace().
+func (s *StoreBuilder) WithAssetVersions(av AssetVersions) *StoreBuilder {
+	sb := *s
+	sb.assetVersions = av
+
+	return &sb
+}
+
+// MatchAssetVersions returns true if the resource versions of the objects in
+// the store are equal to the recorded versions.
+func (s *StoreBuilder) MatchAssetVersions(av AssetVersions) bool {
+	for key, version := range av {
+		obj, exists, err := s.objStore.GetByKey(key)
+		if err != nil {
+			return false
+		}
+
+		if !exists {
+			if version != "" {
+				return false
+			}
+			continue
+		}
+
+		objMeta, err := meta.Accessor(obj)
+		if err != nil || objMeta.GetResourceVersion() != version {
+			return false
+		}
+	}
+
+	return true
+}
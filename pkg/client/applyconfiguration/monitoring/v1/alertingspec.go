@@ -16,6 +16,10 @@
 
 package v1
 
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
 // AlertingSpecApplyConfiguration represents a declarative configuration of the AlertingSpec type for use
 // with apply.
 //
@@ -23,6 +27,27 @@ package v1
 type AlertingSpecApplyConfiguration struct {
 	// alertmanagers endpoints where Prometheus should send alerts to.
 	Alertmanagers []AlertmanagerEndpointsApplyConfiguration `json:"alertmanagers,omitempty"`
+	// alertmanagerSelector defines the Alertmanager resources, in the same
+	// namespace as the Prometheus resource, where Prometheus should send alerts to.
+	//
+	// The operator configures the endpoints (service, port, scheme, path
+	// prefix and API version) from the Alertmanager resources and it updates
+	// the configuration when the Alertmanager resources change.
+	//
+	// An empty label selector matches all Alertmanager resources in the
+	// namespace. A null label selector matches none.
+	AlertmanagerSelector *metav1.LabelSelectorApplyConfiguration `json:"alertmanagerSelector,omitempty"`
+	// alertmanagerRefs defines references to Alertmanager resources where
+	// Prometheus should send alerts to.
+	//
+	// The referenced Alertmanager resources must be managed by the same
+	// operator instance. References to non-existing Alertmanager resources
+	// are ignored.
+	AlertmanagerRefs []AlertmanagerReferenceApplyConfiguration `json:"alertmanagerRefs,omitempty"`
+	// alertmanagerTLSConfig defines the TLS configuration used to connect to
+	// the Alertmanager resources selected by `alertmanagerSelector` and
+	// `alertmanagerRefs` which serve their web endpoints over HTTPS.
+	AlertmanagerTLSConfig *SafeTLSConfigApplyConfiguration `json:"alertmanagerTLSConfig,omitempty"`
 }
 
 // AlertingSpecApplyConfiguration constructs a declarative configuration of the AlertingSpec type for use with
@@ -43,3 +68,32 @@ func (b *AlertingSpecApplyConfiguration) WithAlertmanagers(values ...*Alertmanag
 	}
 	return b
 }
+
+// WithAlertmanagerSelector sets the AlertmanagerSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlertmanagerSelector field is set to the value of the last call.
+func (b *AlertingSpecApplyConfiguration) WithAlertmanagerSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertingSpecApplyConfiguration {
+	b.AlertmanagerSelector = value
+	return b
+}
+
+// WithAlertmanagerRefs adds the given value to the AlertmanagerRefs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AlertmanagerRefs field.
+func (b *AlertingSpecApplyConfiguration) WithAlertmanagerRefs(values ...*AlertmanagerReferenceApplyConfiguration) *AlertingSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAlertmanagerRefs")
+		}
+		b.AlertmanagerRefs = append(b.AlertmanagerRefs, *values[i])
+	}
+	return b
+}
+
+// WithAlertmanagerTLSConfig sets the AlertmanagerTLSConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlertmanagerTLSConfig field is set to the value of the last call.
+func (b *AlertingSpecApplyConfiguration) WithAlertmanagerTLSConfig(value *SafeTLSConfigApplyConfiguration) *AlertingSpecApplyConfiguration {
+	b.AlertmanagerTLSConfig = value
+	return b
+}
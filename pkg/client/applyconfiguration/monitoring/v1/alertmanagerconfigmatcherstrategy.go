@@ -29,6 +29,15 @@ type AlertmanagerConfigMatcherStrategyApplyConfiguration struct {
 	//
 	// The default value is `OnNamespace`.
 	Type *monitoringv1.AlertmanagerConfigMatcherStrategyType `json:"type,omitempty"`
+	// label defines the name of the label used by the `OnLabel` strategy.
+	//
+	// The value is read from the labels of the namespace of the
+	// AlertmanagerConfig object and, only if the namespace doesn't have the
+	// label, from the labels of the object itself. The operator rejects
+	// AlertmanagerConfig objects for which no value can be found.
+	//
+	// It is required when type is `OnLabel`.
+	Label *string `json:"label,omitempty"`
 }
 
 // AlertmanagerConfigMatcherStrategyApplyConfiguration constructs a declarative configuration of the AlertmanagerConfigMatcherStrategy type for use with
@@ -44,3 +53,11 @@ func (b *AlertmanagerConfigMatcherStrategyApplyConfiguration) WithType(value mon
 	b.Type = &value
 	return b
 }
+
+// WithLabel sets the Label field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Label field is set to the value of the last call.
+func (b *AlertmanagerConfigMatcherStrategyApplyConfiguration) WithLabel(value string) *AlertmanagerConfigMatcherStrategyApplyConfiguration {
+	b.Label = &value
+	return b
+}
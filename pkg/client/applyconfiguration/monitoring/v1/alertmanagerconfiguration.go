@@ -29,6 +29,18 @@ type AlertmanagerConfigurationApplyConfiguration struct {
 	Global *AlertmanagerGlobalConfigApplyConfiguration `json:"global,omitempty"`
 	// templates defines the custom notification templates.
 	Templates []SecretOrConfigMapApplyConfiguration `json:"templates,omitempty"`
+	// sharedReceivers defines the receivers of the AlertmanagerConfig
+	// resource which can be referenced by the routes of the other
+	// AlertmanagerConfig resources selected by the Alertmanager object.
+	//
+	// The other AlertmanagerConfig resources reference a shared receiver by
+	// declaring its name in `spec.sharedReceivers`.
+	SharedReceivers []SharedReceiverApplyConfiguration `json:"sharedReceivers,omitempty"`
+	// tracing defines the configuration of the OpenTelemetry tracing
+	// exporter for the notification pipelines.
+	//
+	// It requires Alertmanager >= v0.30.0.
+	Tracing *TracingConfigApplyConfiguration `json:"tracing,omitempty"`
 }
 
 // AlertmanagerConfigurationApplyConfiguration constructs a declarative configuration of the AlertmanagerConfiguration type for use with
@@ -65,3 +77,24 @@ func (b *AlertmanagerConfigurationApplyConfiguration) WithTemplates(values ...*S
 	}
 	return b
 }
+
+// WithSharedReceivers adds the given value to the SharedReceivers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SharedReceivers field.
+func (b *AlertmanagerConfigurationApplyConfiguration) WithSharedReceivers(values ...*SharedReceiverApplyConfiguration) *AlertmanagerConfigurationApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSharedReceivers")
+		}
+		b.SharedReceivers = append(b.SharedReceivers, *values[i])
+	}
+	return b
+}
+
+// WithTracing sets the Tracing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tracing field is set to the value of the last call.
+func (b *AlertmanagerConfigurationApplyConfiguration) WithTracing(value *TracingConfigApplyConfiguration) *AlertmanagerConfigurationApplyConfiguration {
+	b.Tracing = value
+	return b
+}
@@ -32,6 +32,18 @@ type ProbeTargetsApplyConfiguration struct {
 	// configuration.
 	// If `staticConfig` is also defined, `staticConfig` takes precedence.
 	Ingress *ProbeTargetIngressApplyConfiguration `json:"ingress,omitempty"`
+	// service defines the Service objects to probe and the relabeling
+	// configuration.
+	// If `staticConfig` or `ingress` is also defined, they take precedence.
+	Service *ProbeTargetServiceApplyConfiguration `json:"service,omitempty"`
+	// httpRoute defines the Gateway API HTTPRoute objects to probe and the
+	// relabeling configuration.
+	// If `staticConfig`, `ingress` or `service` is also defined, they take
+	// precedence.
+	//
+	// It requires the HTTPRoute CRD (`gateway.networking.k8s.io/v1`) to be
+	// installed in the cluster, otherwise the Probe is rejected.
+	HTTPRoute *ProbeTargetHTTPRouteApplyConfiguration `json:"httpRoute,omitempty"`
 }
 
 // ProbeTargetsApplyConfiguration constructs a declarative configuration of the ProbeTargets type for use with
@@ -55,3 +67,19 @@ func (b *ProbeTargetsApplyConfiguration) WithIngress(value *ProbeTargetIngressAp
 	b.Ingress = value
 	return b
 }
+
+// WithService sets the Service field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Service field is set to the value of the last call.
+func (b *ProbeTargetsApplyConfiguration) WithService(value *ProbeTargetServiceApplyConfiguration) *ProbeTargetsApplyConfiguration {
+	b.Service = value
+	return b
+}
+
+// WithHTTPRoute sets the HTTPRoute field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTPRoute field is set to the value of the last call.
+func (b *ProbeTargetsApplyConfiguration) WithHTTPRoute(value *ProbeTargetHTTPRouteApplyConfiguration) *ProbeTargetsApplyConfiguration {
+	b.HTTPRoute = value
+	return b
+}
@@ -0,0 +1,110 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// ProbeTargetServiceApplyConfiguration represents a declarative configuration of the ProbeTargetService type for use
+// with apply.
+//
+// ProbeTargetService defines the set of Service objects considered for probing.
+// The operator configures a target for each port of each service object
+// using the `<scheme>://<service>.<namespace>.svc:<port><path>` URL.
+type ProbeTargetServiceApplyConfiguration struct {
+	// selector to select the Service objects.
+	Selector *metav1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	// namespaceSelector defines from which namespaces to select Service objects.
+	NamespaceSelector *NamespaceSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
+	// port defines the name of the service port to probe.
+	// If empty, all the ports of the selected services are probed.
+	Port *string `json:"port,omitempty"`
+	// scheme defines the HTTP scheme of the probed URL.
+	// Defaults to `http`.
+	Scheme *string `json:"scheme,omitempty"`
+	// path defines the HTTP path of the probed URL.
+	// Defaults to `/`.
+	Path *string `json:"path,omitempty"`
+	// relabelingConfigs to apply to the label set of the target before it gets
+	// scraped.
+	// The original service address is available via the
+	// `__tmp_service_address` label. It can be used to customize the
+	// probed URL.
+	// The original scrape job's name is available via the `__tmp_prometheus_job_name` label.
+	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+	RelabelConfigs []RelabelConfigApplyConfiguration `json:"relabelingConfigs,omitempty"`
+}
+
+// ProbeTargetServiceApplyConfiguration constructs a declarative configuration of the ProbeTargetService type for use with
+// apply.
+func ProbeTargetService() *ProbeTargetServiceApplyConfiguration {
+	return &ProbeTargetServiceApplyConfiguration{}
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *ProbeTargetServiceApplyConfiguration) WithSelector(value *metav1.LabelSelectorApplyConfiguration) *ProbeTargetServiceApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceSelector field is set to the value of the last call.
+func (b *ProbeTargetServiceApplyConfiguration) WithNamespaceSelector(value *NamespaceSelectorApplyConfiguration) *ProbeTargetServiceApplyConfiguration {
+	b.NamespaceSelector = value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *ProbeTargetServiceApplyConfiguration) WithPort(value string) *ProbeTargetServiceApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithScheme sets the Scheme field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scheme field is set to the value of the last call.
+func (b *ProbeTargetServiceApplyConfiguration) WithScheme(value string) *ProbeTargetServiceApplyConfiguration {
+	b.Scheme = &value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *ProbeTargetServiceApplyConfiguration) WithPath(value string) *ProbeTargetServiceApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithRelabelConfigs adds the given value to the RelabelConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RelabelConfigs field.
+func (b *ProbeTargetServiceApplyConfiguration) WithRelabelConfigs(values ...*RelabelConfigApplyConfiguration) *ProbeTargetServiceApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRelabelConfigs")
+		}
+		b.RelabelConfigs = append(b.RelabelConfigs, *values[i])
+	}
+	return b
+}
@@ -49,6 +49,33 @@ type PrometheusSpecApplyConfiguration struct {
 	// however, the feature is not yet fully implemented in this PR. The limitation being:
 	// * Retention duration is not settable, for now, shards are retained forever.
 	ShardRetentionPolicy *ShardRetentionPolicyApplyConfiguration `json:"shardRetentionPolicy,omitempty"`
+	// autoscaling defines the automatic scaling of the number of shards based
+	// on the number of head series.
+	//
+	// When defined, the operator periodically queries the TSDB status of the
+	// Prometheus pods and updates the number of shards (via the scale
+	// subresource) so that each shard holds about
+	// `autoscaling.targetHeadSeries` series.
+	//
+	// Because the data isn't rebalanced between shards, the scaled-down
+	// shards are retained until their data ages out unless
+	// `spec.shardRetentionPolicy.whenScaled` is set to `Delete`. Scaling down
+	// requires the 'PrometheusShardRetentionPolicy' feature gate to be
+	// enabled.
+	//
+	// Autoscaling requires the operator to be able to reach the Prometheus
+	// pods on the web port without authentication. When the web server uses
+	// TLS, the certificate must be referenced by `spec.web.tlsConfig.cert` so
+	// that the operator can verify it.
+	//
+	// (Alpha) Using this field requires the 'PrometheusShardAutoscaling' feature gate to be enabled.
+	Autoscaling *ShardAutoscalingApplyConfiguration `json:"autoscaling,omitempty"`
+	// ruleSharding defines how the PrometheusRule groups are assigned to the
+	// Prometheus shards.
+	//
+	// By default, every shard evaluates all the rule groups against its own
+	// partial data which can produce partial results and duplicated alerts.
+	RuleSharding *RuleShardingStrategyApplyConfiguration `json:"ruleSharding,omitempty"`
 	// disableCompaction when true, the Prometheus compaction is disabled.
 	// When `spec.thanos.objectStorageConfig` or `spec.objectStorageConfigFile` are defined, the operator automatically
 	// disables block compaction to avoid race conditions during block uploads (as the Thanos documentation recommends).
@@ -104,6 +131,21 @@ type PrometheusSpecApplyConfiguration struct {
 	AdditionalAlertManagerConfigs *corev1.SecretKeySelector `json:"additionalAlertManagerConfigs,omitempty"`
 	// remoteRead defines the list of remote read configurations.
 	RemoteRead []RemoteReadSpecApplyConfiguration `json:"remoteRead,omitempty"`
+	// federationSources defines the Prometheus resources from which this
+	// Prometheus federates series through the `/federate` endpoint.
+	//
+	// For each source, the operator generates a scrape job targeting all the
+	// pods (shards and replicas) of the referenced Prometheus resource. It
+	// updates the configuration when the referenced resources change (for
+	// instance when they are scaled).
+	//
+	// The referenced Prometheus resources must be managed by the same
+	// operator instance. References to non-existing Prometheus resources
+	// or to Prometheus resources with `listenLocal: true` are ignored.
+	// Sources with invalid `match` selectors and references to the
+	// Prometheus resource itself are ignored and reported in the
+	// `Reconciled` condition.
+	FederationSources []FederationSourceApplyConfiguration `json:"federationSources,omitempty"`
 	// thanos defines the configuration of the optional Thanos sidecar.
 	Thanos *ThanosSpecApplyConfiguration `json:"thanos,omitempty"`
 	// queryLogFile specifies where the file to which PromQL queries are logged.
@@ -1088,6 +1130,22 @@ func (b *PrometheusSpecApplyConfiguration) WithShardRetentionPolicy(value *Shard
 	return b
 }
 
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithAutoscaling(value *ShardAutoscalingApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
+
+// WithRuleSharding sets the RuleSharding field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RuleSharding field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithRuleSharding(value *RuleShardingStrategyApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.RuleSharding = value
+	return b
+}
+
 // WithDisableCompaction sets the DisableCompaction field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DisableCompaction field is set to the value of the last call.
@@ -1178,6 +1236,19 @@ func (b *PrometheusSpecApplyConfiguration) WithRemoteRead(values ...*RemoteReadS
 	return b
 }
 
+// WithFederationSources adds the given value to the FederationSources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the FederationSources field.
+func (b *PrometheusSpecApplyConfiguration) WithFederationSources(values ...*FederationSourceApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFederationSources")
+		}
+		b.FederationSources = append(b.FederationSources, *values[i])
+	}
+	return b
+}
+
 // WithThanos sets the Thanos field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Thanos field is set to the value of the last call.
@@ -52,6 +52,14 @@ type RuleGroupApplyConfiguration struct {
 	// rule can produce.
 	// Limit is supported starting with Prometheus >= 2.31 and Thanos Ruler >= 0.24.
 	Limit *int `json:"limit,omitempty"`
+	// shard defines the Prometheus shard which evaluates the rule group.
+	//
+	// It is only used by Prometheus resources for which
+	// `spec.ruleSharding.mode` is `Hash` or `FirstShard`. If the value is
+	// greater than or equal to the number of shards, the group is assigned
+	// to the shard equal to the value modulo the number of shards.
+	// The field is ignored for Thanos Ruler.
+	Shard *int32 `json:"shard,omitempty"`
 }
 
 // RuleGroupApplyConfiguration constructs a declarative configuration of the RuleGroup type for use with
@@ -126,3 +134,11 @@ func (b *RuleGroupApplyConfiguration) WithLimit(value int) *RuleGroupApplyConfig
 	b.Limit = &value
 	return b
 }
+
+// WithShard sets the Shard field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Shard field is set to the value of the last call.
+func (b *RuleGroupApplyConfiguration) WithShard(value int32) *RuleGroupApplyConfiguration {
+	b.Shard = &value
+	return b
+}
@@ -0,0 +1,52 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// RuleShardingStrategyApplyConfiguration represents a declarative configuration of the RuleShardingStrategy type for use
+// with apply.
+//
+// RuleShardingStrategy defines how the rule groups are assigned to the shards.
+type RuleShardingStrategyApplyConfiguration struct {
+	// mode defines how the rule groups are assigned to the shards.
+	//
+	// * `All` (default): all the shards evaluate all the rule groups.
+	// * `Hash`: each rule group is evaluated by a single shard, selected from
+	// a hash of the PrometheusRule's namespace and name and the group's name.
+	// * `FirstShard`: the rule groups are only evaluated by the first shard.
+	//
+	// With the `Hash` and `FirstShard` modes, a rule group can be pinned to a
+	// specific shard with the group's `shard` field.
+	Mode *monitoringv1.RuleShardingMode `json:"mode,omitempty"`
+}
+
+// RuleShardingStrategyApplyConfiguration constructs a declarative configuration of the RuleShardingStrategy type for use with
+// apply.
+func RuleShardingStrategy() *RuleShardingStrategyApplyConfiguration {
+	return &RuleShardingStrategyApplyConfiguration{}
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *RuleShardingStrategyApplyConfiguration) WithMode(value monitoringv1.RuleShardingMode) *RuleShardingStrategyApplyConfiguration {
+	b.Mode = &value
+	return b
+}
@@ -18,6 +18,9 @@ package v1
 
 import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // ScrapeClassApplyConfiguration represents a declarative configuration of the ScrapeClass type for use
@@ -30,6 +33,62 @@ type ScrapeClassApplyConfiguration struct {
 	//
 	// Only one scrape class can be set as the default.
 	Default *bool `json:"default,omitempty"`
+	// namespaceSelector defines the namespaces for which the scrape class
+	// applies to the scrape objects that don't configure an explicit scrape
+	// class name. It takes precedence over the default scrape class.
+	//
+	// When several scrape classes select the same namespace, the first one
+	// in the list is used.
+	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
+	// scrapeInterval defines the interval between consecutive scrapes.
+	// It will only apply if the scrape resource doesn't specify any interval.
+	ScrapeInterval *monitoringv1.Duration `json:"scrapeInterval,omitempty"`
+	// scrapeTimeout defines the timeout after which the scrape is ended.
+	// It will only apply if the scrape resource doesn't specify any timeout.
+	ScrapeTimeout *monitoringv1.Duration `json:"scrapeTimeout,omitempty"`
+	// honorLabels defines when the scraped labels take precedence over the
+	// target labels.
+	// It will only apply if the scrape resource doesn't set `honorLabels` to true.
+	HonorLabels *bool `json:"honorLabels,omitempty"`
+	// honorTimestamps defines whether Prometheus preserves the timestamps
+	// when exposed by the target.
+	// It will only apply if the scrape resource doesn't specify any value.
+	HonorTimestamps *bool `json:"honorTimestamps,omitempty"`
+	// params defines optional HTTP URL parameters.
+	// The parameters defined by the scrape resource take precedence over the
+	// parameters with the same name.
+	Params map[string][]string `json:"params,omitempty"`
+	// scheme defines the HTTP scheme to use for scraping.
+	// It will only apply if the scrape resource doesn't specify any scheme.
+	Scheme *monitoringv1.Scheme `json:"scheme,omitempty"`
+	// sampleLimit defines a per-scrape limit on the number of scraped samples
+	// that will be accepted.
+	// It will only apply if the scrape resource doesn't specify any limit.
+	SampleLimit *uint64 `json:"sampleLimit,omitempty"`
+	// targetLimit defines a limit on the number of scraped targets that will
+	// be accepted.
+	// It will only apply if the scrape resource doesn't specify any limit.
+	TargetLimit *uint64 `json:"targetLimit,omitempty"`
+	// labelLimit defines the per-scrape limit on the number of labels that
+	// will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any limit.
+	LabelLimit *uint64 `json:"labelLimit,omitempty"`
+	// labelNameLengthLimit defines the per-scrape limit on the length of
+	// labels name that will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any limit.
+	LabelNameLengthLimit *uint64 `json:"labelNameLengthLimit,omitempty"`
+	// labelValueLengthLimit defines the per-scrape limit on the length of
+	// labels value that will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any limit.
+	LabelValueLengthLimit *uint64 `json:"labelValueLengthLimit,omitempty"`
+	// The native histogram settings apply field by field when the scrape
+	// resource doesn't define them.
+	NativeHistogramConfigApplyConfiguration `json:",inline"`
+	// The proxy settings apply if the scrape resource doesn't configure
+	// any proxy.
+	//
+	// For now the `proxyConnectHeader` field isn't supported.
+	ProxyConfigApplyConfiguration `json:",inline"`
 	// fallbackScrapeProtocol defines the protocol to use if a scrape returns blank, unparseable, or otherwise invalid Content-Type.
 	// It will only apply if the scrape resource doesn't specify any FallbackScrapeProtocol
 	//
@@ -89,6 +148,186 @@ func (b *ScrapeClassApplyConfiguration) WithDefault(value bool) *ScrapeClassAppl
 	return b
 }
 
+// WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceSelector field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *ScrapeClassApplyConfiguration {
+	b.NamespaceSelector = value
+	return b
+}
+
+// WithScrapeInterval sets the ScrapeInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeInterval field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithScrapeInterval(value monitoringv1.Duration) *ScrapeClassApplyConfiguration {
+	b.ScrapeInterval = &value
+	return b
+}
+
+// WithScrapeTimeout sets the ScrapeTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeTimeout field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithScrapeTimeout(value monitoringv1.Duration) *ScrapeClassApplyConfiguration {
+	b.ScrapeTimeout = &value
+	return b
+}
+
+// WithHonorLabels sets the HonorLabels field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HonorLabels field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithHonorLabels(value bool) *ScrapeClassApplyConfiguration {
+	b.HonorLabels = &value
+	return b
+}
+
+// WithHonorTimestamps sets the HonorTimestamps field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HonorTimestamps field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithHonorTimestamps(value bool) *ScrapeClassApplyConfiguration {
+	b.HonorTimestamps = &value
+	return b
+}
+
+// WithParams puts the entries into the Params field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Params field,
+// overwriting an existing map entries in Params field with the same key.
+func (b *ScrapeClassApplyConfiguration) WithParams(entries map[string][]string) *ScrapeClassApplyConfiguration {
+	if b.Params == nil && len(entries) > 0 {
+		b.Params = make(map[string][]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Params[k] = v
+	}
+	return b
+}
+
+// WithScheme sets the Scheme field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scheme field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithScheme(value monitoringv1.Scheme) *ScrapeClassApplyConfiguration {
+	b.Scheme = &value
+	return b
+}
+
+// WithSampleLimit sets the SampleLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SampleLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithSampleLimit(value uint64) *ScrapeClassApplyConfiguration {
+	b.SampleLimit = &value
+	return b
+}
+
+// WithTargetLimit sets the TargetLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithTargetLimit(value uint64) *ScrapeClassApplyConfiguration {
+	b.TargetLimit = &value
+	return b
+}
+
+// WithLabelLimit sets the LabelLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithLabelLimit(value uint64) *ScrapeClassApplyConfiguration {
+	b.LabelLimit = &value
+	return b
+}
+
+// WithLabelNameLengthLimit sets the LabelNameLengthLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelNameLengthLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithLabelNameLengthLimit(value uint64) *ScrapeClassApplyConfiguration {
+	b.LabelNameLengthLimit = &value
+	return b
+}
+
+// WithLabelValueLengthLimit sets the LabelValueLengthLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelValueLengthLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithLabelValueLengthLimit(value uint64) *ScrapeClassApplyConfiguration {
+	b.LabelValueLengthLimit = &value
+	return b
+}
+
+// WithScrapeNativeHistograms sets the ScrapeNativeHistograms field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeNativeHistograms field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithScrapeNativeHistograms(value bool) *ScrapeClassApplyConfiguration {
+	b.NativeHistogramConfigApplyConfiguration.ScrapeNativeHistograms = &value
+	return b
+}
+
+// WithScrapeClassicHistograms sets the ScrapeClassicHistograms field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeClassicHistograms field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithScrapeClassicHistograms(value bool) *ScrapeClassApplyConfiguration {
+	b.NativeHistogramConfigApplyConfiguration.ScrapeClassicHistograms = &value
+	return b
+}
+
+// WithNativeHistogramBucketLimit sets the NativeHistogramBucketLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NativeHistogramBucketLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithNativeHistogramBucketLimit(value uint64) *ScrapeClassApplyConfiguration {
+	b.NativeHistogramConfigApplyConfiguration.NativeHistogramBucketLimit = &value
+	return b
+}
+
+// WithNativeHistogramMinBucketFactor sets the NativeHistogramMinBucketFactor field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NativeHistogramMinBucketFactor field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithNativeHistogramMinBucketFactor(value resource.Quantity) *ScrapeClassApplyConfiguration {
+	b.NativeHistogramConfigApplyConfiguration.NativeHistogramMinBucketFactor = &value
+	return b
+}
+
+// WithConvertClassicHistogramsToNHCB sets the ConvertClassicHistogramsToNHCB field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConvertClassicHistogramsToNHCB field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithConvertClassicHistogramsToNHCB(value bool) *ScrapeClassApplyConfiguration {
+	b.NativeHistogramConfigApplyConfiguration.ConvertClassicHistogramsToNHCB = &value
+	return b
+}
+
+// WithProxyURL sets the ProxyURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyURL field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithProxyURL(value string) *ScrapeClassApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.ProxyURL = &value
+	return b
+}
+
+// WithNoProxy sets the NoProxy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NoProxy field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithNoProxy(value string) *ScrapeClassApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.NoProxy = &value
+	return b
+}
+
+// WithProxyFromEnvironment sets the ProxyFromEnvironment field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyFromEnvironment field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithProxyFromEnvironment(value bool) *ScrapeClassApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.ProxyFromEnvironment = &value
+	return b
+}
+
+// WithProxyConnectHeader puts the entries into the ProxyConnectHeader field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ProxyConnectHeader field,
+// overwriting an existing map entries in ProxyConnectHeader field with the same key.
+func (b *ScrapeClassApplyConfiguration) WithProxyConnectHeader(entries map[string][]corev1.SecretKeySelector) *ScrapeClassApplyConfiguration {
+	if b.ProxyConfigApplyConfiguration.ProxyConnectHeader == nil && len(entries) > 0 {
+		b.ProxyConfigApplyConfiguration.ProxyConnectHeader = make(map[string][]corev1.SecretKeySelector, len(entries))
+	}
+	for k, v := range entries {
+		b.ProxyConfigApplyConfiguration.ProxyConnectHeader[k] = v
+	}
+	return b
+}
+
 // WithFallbackScrapeProtocol sets the FallbackScrapeProtocol field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the FallbackScrapeProtocol field is set to the value of the last call.
@@ -0,0 +1,86 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// ShardAutoscalingApplyConfiguration represents a declarative configuration of the ShardAutoscaling type for use
+// with apply.
+//
+// ShardAutoscaling defines the automatic scaling of the Prometheus shards.
+type ShardAutoscalingApplyConfiguration struct {
+	// minShards defines the minimum number of shards.
+	//
+	// If not defined, the operator assumes 1.
+	MinShards *int32 `json:"minShards,omitempty"`
+	// maxShards defines the maximum number of shards.
+	MaxShards *int32 `json:"maxShards,omitempty"`
+	// targetHeadSeries defines the target number of head series per shard.
+	//
+	// The desired number of shards is the total number of head series
+	// across all the active shards divided by this value (rounded up).
+	TargetHeadSeries *int64 `json:"targetHeadSeries,omitempty"`
+	// scaleDownStabilizationWindow defines the duration for which past
+	// recommendations are considered when scaling down. The operator scales
+	// down to the highest number of shards recommended during the window
+	// which prevents flapping when the number of series fluctuates.
+	//
+	// Scaling up isn't subject to the stabilization window.
+	//
+	// If not defined, the operator assumes 1 hour.
+	ScaleDownStabilizationWindow *monitoringv1.Duration `json:"scaleDownStabilizationWindow,omitempty"`
+}
+
+// ShardAutoscalingApplyConfiguration constructs a declarative configuration of the ShardAutoscaling type for use with
+// apply.
+func ShardAutoscaling() *ShardAutoscalingApplyConfiguration {
+	return &ShardAutoscalingApplyConfiguration{}
+}
+
+// WithMinShards sets the MinShards field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinShards field is set to the value of the last call.
+func (b *ShardAutoscalingApplyConfiguration) WithMinShards(value int32) *ShardAutoscalingApplyConfiguration {
+	b.MinShards = &value
+	return b
+}
+
+// WithMaxShards sets the MaxShards field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxShards field is set to the value of the last call.
+func (b *ShardAutoscalingApplyConfiguration) WithMaxShards(value int32) *ShardAutoscalingApplyConfiguration {
+	b.MaxShards = &value
+	return b
+}
+
+// WithTargetHeadSeries sets the TargetHeadSeries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetHeadSeries field is set to the value of the last call.
+func (b *ShardAutoscalingApplyConfiguration) WithTargetHeadSeries(value int64) *ShardAutoscalingApplyConfiguration {
+	b.TargetHeadSeries = &value
+	return b
+}
+
+// WithScaleDownStabilizationWindow sets the ScaleDownStabilizationWindow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaleDownStabilizationWindow field is set to the value of the last call.
+func (b *ShardAutoscalingApplyConfiguration) WithScaleDownStabilizationWindow(value monitoringv1.Duration) *ShardAutoscalingApplyConfiguration {
+	b.ScaleDownStabilizationWindow = &value
+	return b
+}
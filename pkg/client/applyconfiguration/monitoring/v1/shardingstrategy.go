@@ -25,7 +25,8 @@ import (
 //
 // ShardingStrategy defines the sharding strategy for Prometheus.
 type ShardingStrategyApplyConfiguration struct {
-	// mode defines the sharding mode. Can be 'Address' or 'Topology'.
+	// mode defines the sharding mode. Can be 'Address', 'Topology',
+	// 'Namespace' or 'Monitor'.
 	//
 	// 'Address' is the default mode and distributes targets across shards
 	// based on a hash of the target address.
@@ -34,6 +35,17 @@ type ShardingStrategyApplyConfiguration struct {
 	// specific topology zone and only scrapes targets in that zone.
 	// (Alpha) Using the 'Topology' mode requires the `PrometheusTopologySharding`
 	// feature gate to be enabled.
+	//
+	// 'Namespace' and 'Monitor' assign whole ServiceMonitor, PodMonitor, Probe
+	// and ScrapeConfig resources to shards based on a hash of respectively
+	// their namespace or their namespace and name. Each shard's configuration
+	// only contains the scrape jobs of the resources assigned to it which
+	// reduces the configuration size and the service discovery load.
+	// The additional scrape configurations are still sharded by target address.
+	// (Alpha) Using the 'Namespace' and 'Monitor' modes requires the
+	// `PrometheusResourceSharding` feature gate to be enabled. These modes are
+	// only supported by the Prometheus resource, PrometheusAgent resources
+	// fall back to the 'Address' mode.
 	Mode *monitoringv1.ShardingStrategyMode `json:"mode,omitempty"`
 	// topology defines the configuration for topology-aware sharding.
 	// This field is only valid when mode is set to 'Topology'.
@@ -0,0 +1,60 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// SharedReceiverApplyConfiguration represents a declarative configuration of the SharedReceiver type for use
+// with apply.
+//
+// SharedReceiver defines a receiver which can be referenced by the routes of
+// AlertmanagerConfig resources from other namespaces.
+type SharedReceiverApplyConfiguration struct {
+	// name defines the name of the receiver in the AlertmanagerConfig
+	// resource referenced by `spec.alertmanagerConfiguration.name`.
+	Name *string `json:"name,omitempty"`
+	// namespaceSelector defines the namespaces of the AlertmanagerConfig
+	// resources which are allowed to reference the receiver.
+	//
+	// When not defined, all the AlertmanagerConfig resources selected by the
+	// Alertmanager object can reference the receiver.
+	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
+}
+
+// SharedReceiverApplyConfiguration constructs a declarative configuration of the SharedReceiver type for use with
+// apply.
+func SharedReceiver() *SharedReceiverApplyConfiguration {
+	return &SharedReceiverApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SharedReceiverApplyConfiguration) WithName(value string) *SharedReceiverApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceSelector field is set to the value of the last call.
+func (b *SharedReceiverApplyConfiguration) WithNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *SharedReceiverApplyConfiguration {
+	b.NamespaceSelector = value
+	return b
+}
@@ -160,6 +160,38 @@ type ThanosRulerSpecApplyConfiguration struct {
 	//
 	// This field takes precedence over `alertmanagersUrl`.
 	AlertManagersConfig *corev1.SecretKeySelector `json:"alertmanagersConfig,omitempty"`
+	// alertmanagerSelector defines the Alertmanager resources, in the same
+	// namespace as the ThanosRuler resource, where Thanos Ruler should send
+	// alerts to.
+	//
+	// The operator configures the endpoints (addresses, scheme, path prefix
+	// and API version) from the Alertmanager resources and it updates the
+	// configuration when the Alertmanager resources change.
+	//
+	// An empty label selector matches all Alertmanager resources in the
+	// namespace. A null label selector matches none.
+	//
+	// `alertmanagersConfig` takes precedence over this field and this field
+	// takes precedence over `alertmanagersUrl`.
+	AlertmanagerSelector *metav1.LabelSelectorApplyConfiguration `json:"alertmanagerSelector,omitempty"`
+	// alertmanagerRefs defines references to Alertmanager resources where
+	// Thanos Ruler should send alerts to.
+	//
+	// The referenced Alertmanager resources must be managed by the same
+	// operator instance. References to non-existing Alertmanager resources
+	// are ignored.
+	//
+	// `alertmanagersConfig` takes precedence over this field and this field
+	// takes precedence over `alertmanagersUrl`.
+	AlertmanagerRefs []AlertmanagerReferenceApplyConfiguration `json:"alertmanagerRefs,omitempty"`
+	// alertmanagerTLSConfig defines the TLS configuration used to connect to
+	// the Alertmanager resources selected by `alertmanagerSelector` and
+	// `alertmanagerRefs` which serve their web endpoints over HTTPS.
+	//
+	// The `minVersion` and `maxVersion` fields aren't supported by Thanos
+	// Ruler and they are ignored.
+	//
+	AlertmanagerTLSConfig *SafeTLSConfigApplyConfiguration `json:"alertmanagerTLSConfig,omitempty"`
 	// ruleSelector defines the PrometheusRule objects to be selected for rule evaluation. An empty
 	// label selector matches all objects. A null label selector matches no
 	// objects.
@@ -629,6 +661,35 @@ func (b *ThanosRulerSpecApplyConfiguration) WithAlertManagersConfig(value corev1
 	return b
 }
 
+// WithAlertmanagerSelector sets the AlertmanagerSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlertmanagerSelector field is set to the value of the last call.
+func (b *ThanosRulerSpecApplyConfiguration) WithAlertmanagerSelector(value *metav1.LabelSelectorApplyConfiguration) *ThanosRulerSpecApplyConfiguration {
+	b.AlertmanagerSelector = value
+	return b
+}
+
+// WithAlertmanagerRefs adds the given value to the AlertmanagerRefs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AlertmanagerRefs field.
+func (b *ThanosRulerSpecApplyConfiguration) WithAlertmanagerRefs(values ...*AlertmanagerReferenceApplyConfiguration) *ThanosRulerSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAlertmanagerRefs")
+		}
+		b.AlertmanagerRefs = append(b.AlertmanagerRefs, *values[i])
+	}
+	return b
+}
+
+// WithAlertmanagerTLSConfig sets the AlertmanagerTLSConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlertmanagerTLSConfig field is set to the value of the last call.
+func (b *ThanosRulerSpecApplyConfiguration) WithAlertmanagerTLSConfig(value *SafeTLSConfigApplyConfiguration) *ThanosRulerSpecApplyConfiguration {
+	b.AlertmanagerTLSConfig = value
+	return b
+}
+
 // WithRuleSelector sets the RuleSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RuleSelector field is set to the value of the last call.
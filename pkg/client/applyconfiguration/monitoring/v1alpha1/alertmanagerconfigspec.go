@@ -37,6 +37,28 @@ type AlertmanagerConfigSpecApplyConfiguration struct {
 	InhibitRules []InhibitRuleApplyConfiguration `json:"inhibitRules,omitempty"`
 	// muteTimeIntervals defines the list of MuteTimeInterval specifying when the routes should be muted.
 	MuteTimeIntervals []MuteTimeIntervalApplyConfiguration `json:"muteTimeIntervals,omitempty"`
+	// sharedTimeIntervals defines the names of the time intervals, defined by
+	// the base configuration of the Alertmanager, which can be referenced by
+	// the routes.
+	//
+	// The base configuration is either the Alertmanager configuration secret
+	// or the global AlertmanagerConfig referenced by
+	// `spec.alertmanagerConfiguration` of the Alertmanager resource. The
+	// operator rejects the resource if a time interval isn't defined by the
+	// base configuration.
+	//
+	// The names must not conflict with the names defined by `muteTimeIntervals`.
+	SharedTimeIntervals []string `json:"sharedTimeIntervals,omitempty"`
+	// sharedReceivers defines the names of the receivers, shared by the global
+	// AlertmanagerConfig of the Alertmanager, which can be referenced by the
+	// routes.
+	//
+	// The receivers are shared with `spec.alertmanagerConfiguration.sharedReceivers`
+	// of the Alertmanager resource. The operator rejects the resource if a
+	// receiver isn't shared or isn't allowed for the namespace of the resource.
+	//
+	// The names must not conflict with the names defined by `receivers`.
+	SharedReceivers []string `json:"sharedReceivers,omitempty"`
 }
 
 // AlertmanagerConfigSpecApplyConfiguration constructs a declarative configuration of the AlertmanagerConfigSpec type for use with
@@ -91,3 +113,23 @@ func (b *AlertmanagerConfigSpecApplyConfiguration) WithMuteTimeIntervals(values
 	}
 	return b
 }
+
+// WithSharedTimeIntervals adds the given value to the SharedTimeIntervals field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SharedTimeIntervals field.
+func (b *AlertmanagerConfigSpecApplyConfiguration) WithSharedTimeIntervals(values ...string) *AlertmanagerConfigSpecApplyConfiguration {
+	for i := range values {
+		b.SharedTimeIntervals = append(b.SharedTimeIntervals, values[i])
+	}
+	return b
+}
+
+// WithSharedReceivers adds the given value to the SharedReceivers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SharedReceivers field.
+func (b *AlertmanagerConfigSpecApplyConfiguration) WithSharedReceivers(values ...string) *AlertmanagerConfigSpecApplyConfiguration {
+	for i := range values {
+		b.SharedReceivers = append(b.SharedReceivers, values[i])
+	}
+	return b
+}
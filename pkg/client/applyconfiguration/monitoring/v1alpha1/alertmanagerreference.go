@@ -0,0 +1,52 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AlertmanagerReferenceApplyConfiguration represents a declarative configuration of the AlertmanagerReference type for use
+// with apply.
+//
+// AlertmanagerReference references an Alertmanager resource.
+type AlertmanagerReferenceApplyConfiguration struct {
+	// namespace defines the namespace of the Alertmanager resource.
+	//
+	// When not defined, it defaults to the namespace of the ReceiverTest resource.
+	Namespace *string `json:"namespace,omitempty"`
+	// name defines the name of the Alertmanager resource.
+	Name *string `json:"name,omitempty"`
+}
+
+// AlertmanagerReferenceApplyConfiguration constructs a declarative configuration of the AlertmanagerReference type for use with
+// apply.
+func AlertmanagerReference() *AlertmanagerReferenceApplyConfiguration {
+	return &AlertmanagerReferenceApplyConfiguration{}
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *AlertmanagerReferenceApplyConfiguration) WithNamespace(value string) *AlertmanagerReferenceApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AlertmanagerReferenceApplyConfiguration) WithName(value string) *AlertmanagerReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
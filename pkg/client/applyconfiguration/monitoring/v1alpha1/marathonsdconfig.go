@@ -0,0 +1,168 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MarathonSDConfigApplyConfiguration represents a declarative configuration of the MarathonSDConfig type for use
+// with apply.
+//
+// MarathonSDConfig configurations allow retrieving scrape targets from Marathon's REST API.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#marathon_sd_config
+//
+// Note: The `_file` variants of credential fields (e.g. `auth_token_file`)
+// from the Prometheus configuration are not supported. Use Kubernetes secrets via `authToken` instead.
+type MarathonSDConfigApplyConfiguration struct {
+	// servers defines the list of URLs to be used to contact Marathon servers.
+	Servers []monitoringv1alpha1.URL `json:"servers,omitempty"`
+	// refreshInterval defines the time after which the applications are refreshed.
+	// If not set, Prometheus uses its default value.
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// authToken defines the Secret key containing the token used to
+	// authenticate against the Marathon servers with DC/OS token based
+	// authentication.
+	// Cannot be set at the same time as `basicAuth` or `authorization`.
+	AuthToken *corev1.SecretKeySelector `json:"authToken,omitempty"`
+	// basicAuth defines the information to authenticate against the Marathon servers.
+	// Cannot be set at the same time as `authToken` or `authorization`.
+	BasicAuth *monitoringv1.BasicAuthApplyConfiguration `json:"basicAuth,omitempty"`
+	// authorization defines the header configuration to authenticate against the Marathon servers.
+	// Cannot be set at the same time as `authToken` or `basicAuth`.
+	Authorization                              *monitoringv1.SafeAuthorizationApplyConfiguration `json:"authorization,omitempty"`
+	monitoringv1.ProxyConfigApplyConfiguration `json:",inline"`
+	// tlsConfig defines the TLS configuration to connect to the Marathon servers.
+	TLSConfig *monitoringv1.SafeTLSConfigApplyConfiguration `json:"tlsConfig,omitempty"`
+	// followRedirects defines whether HTTP requests follow HTTP 3xx redirects.
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// enableHTTP2 defines whether to enable HTTP2.
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"`
+}
+
+// MarathonSDConfigApplyConfiguration constructs a declarative configuration of the MarathonSDConfig type for use with
+// apply.
+func MarathonSDConfig() *MarathonSDConfigApplyConfiguration {
+	return &MarathonSDConfigApplyConfiguration{}
+}
+
+// WithServers adds the given value to the Servers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Servers field.
+func (b *MarathonSDConfigApplyConfiguration) WithServers(values ...monitoringv1alpha1.URL) *MarathonSDConfigApplyConfiguration {
+	for i := range values {
+		b.Servers = append(b.Servers, values[i])
+	}
+	return b
+}
+
+// WithRefreshInterval sets the RefreshInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RefreshInterval field is set to the value of the last call.
+func (b *MarathonSDConfigApplyConfiguration) WithRefreshInterval(value v1.Duration) *MarathonSDConfigApplyConfiguration {
+	b.RefreshInterval = &value
+	return b
+}
+
+// WithAuthToken sets the AuthToken field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AuthToken field is set to the value of the last call.
+func (b *MarathonSDConfigApplyConfiguration) WithAuthToken(value corev1.SecretKeySelector) *MarathonSDConfigApplyConfiguration {
+	b.AuthToken = &value
+	return b
+}
+
+// WithBasicAuth sets the BasicAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BasicAuth field is set to the value of the last call.
+func (b *MarathonSDConfigApplyConfiguration) WithBasicAuth(value *monitoringv1.BasicAuthApplyConfiguration) *MarathonSDConfigApplyConfiguration {
+	b.BasicAuth = value
+	return b
+}
+
+// WithAuthorization sets the Authorization field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Authorization field is set to the value of the last call.
+func (b *MarathonSDConfigApplyConfiguration) WithAuthorization(value *monitoringv1.SafeAuthorizationApplyConfiguration) *MarathonSDConfigApplyConfiguration {
+	b.Authorization = value
+	return b
+}
+
+// WithProxyURL sets the ProxyURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyURL field is set to the value of the last call.
+func (b *MarathonSDConfigApplyConfiguration) WithProxyURL(value string) *MarathonSDConfigApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.ProxyURL = &value
+	return b
+}
+
+// WithNoProxy sets the NoProxy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NoProxy field is set to the value of the last call.
+func (b *MarathonSDConfigApplyConfiguration) WithNoProxy(value string) *MarathonSDConfigApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.NoProxy = &value
+	return b
+}
+
+// WithProxyFromEnvironment sets the ProxyFromEnvironment field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyFromEnvironment field is set to the value of the last call.
+func (b *MarathonSDConfigApplyConfiguration) WithProxyFromEnvironment(value bool) *MarathonSDConfigApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.ProxyFromEnvironment = &value
+	return b
+}
+
+// WithProxyConnectHeader puts the entries into the ProxyConnectHeader field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ProxyConnectHeader field,
+// overwriting an existing map entries in ProxyConnectHeader field with the same key.
+func (b *MarathonSDConfigApplyConfiguration) WithProxyConnectHeader(entries map[string][]corev1.SecretKeySelector) *MarathonSDConfigApplyConfiguration {
+	if b.ProxyConfigApplyConfiguration.ProxyConnectHeader == nil && len(entries) > 0 {
+		b.ProxyConfigApplyConfiguration.ProxyConnectHeader = make(map[string][]corev1.SecretKeySelector, len(entries))
+	}
+	for k, v := range entries {
+		b.ProxyConfigApplyConfiguration.ProxyConnectHeader[k] = v
+	}
+	return b
+}
+
+// WithTLSConfig sets the TLSConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLSConfig field is set to the value of the last call.
+func (b *MarathonSDConfigApplyConfiguration) WithTLSConfig(value *monitoringv1.SafeTLSConfigApplyConfiguration) *MarathonSDConfigApplyConfiguration {
+	b.TLSConfig = value
+	return b
+}
+
+// WithFollowRedirects sets the FollowRedirects field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FollowRedirects field is set to the value of the last call.
+func (b *MarathonSDConfigApplyConfiguration) WithFollowRedirects(value bool) *MarathonSDConfigApplyConfiguration {
+	b.FollowRedirects = &value
+	return b
+}
+
+// WithEnableHTTP2 sets the EnableHTTP2 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EnableHTTP2 field is set to the value of the last call.
+func (b *MarathonSDConfigApplyConfiguration) WithEnableHTTP2(value bool) *MarathonSDConfigApplyConfiguration {
+	b.EnableHTTP2 = &value
+	return b
+}
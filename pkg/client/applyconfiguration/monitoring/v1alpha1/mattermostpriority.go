@@ -0,0 +1,63 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// MattermostPriorityApplyConfiguration represents a declarative configuration of the MattermostPriority type for use
+// with apply.
+//
+// MattermostPriority defines the priority of a Mattermost message.
+type MattermostPriorityApplyConfiguration struct {
+	// priority defines the priority label of the message.
+	Priority *string `json:"priority,omitempty"`
+	// requestedAck defines whether the recipients are asked to acknowledge
+	// the message.
+	RequestedAck *bool `json:"requestedAck,omitempty"`
+	// persistentNotifications defines whether the recipients are notified
+	// repeatedly until they acknowledge the message. It only applies to
+	// urgent messages.
+	PersistentNotifications *bool `json:"persistentNotifications,omitempty"`
+}
+
+// MattermostPriorityApplyConfiguration constructs a declarative configuration of the MattermostPriority type for use with
+// apply.
+func MattermostPriority() *MattermostPriorityApplyConfiguration {
+	return &MattermostPriorityApplyConfiguration{}
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *MattermostPriorityApplyConfiguration) WithPriority(value string) *MattermostPriorityApplyConfiguration {
+	b.Priority = &value
+	return b
+}
+
+// WithRequestedAck sets the RequestedAck field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestedAck field is set to the value of the last call.
+func (b *MattermostPriorityApplyConfiguration) WithRequestedAck(value bool) *MattermostPriorityApplyConfiguration {
+	b.RequestedAck = &value
+	return b
+}
+
+// WithPersistentNotifications sets the PersistentNotifications field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PersistentNotifications field is set to the value of the last call.
+func (b *MattermostPriorityApplyConfiguration) WithPersistentNotifications(value bool) *MattermostPriorityApplyConfiguration {
+	b.PersistentNotifications = &value
+	return b
+}
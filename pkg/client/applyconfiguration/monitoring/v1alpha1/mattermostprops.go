@@ -0,0 +1,41 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// MattermostPropsApplyConfiguration represents a declarative configuration of the MattermostProps type for use
+// with apply.
+//
+// MattermostProps defines additional properties of a Mattermost message.
+type MattermostPropsApplyConfiguration struct {
+	// card defines the text displayed in the right-hand sidebar when
+	// clicking on the message "Info" button.
+	Card *string `json:"card,omitempty"`
+}
+
+// MattermostPropsApplyConfiguration constructs a declarative configuration of the MattermostProps type for use with
+// apply.
+func MattermostProps() *MattermostPropsApplyConfiguration {
+	return &MattermostPropsApplyConfiguration{}
+}
+
+// WithCard sets the Card field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Card field is set to the value of the last call.
+func (b *MattermostPropsApplyConfiguration) WithCard(value string) *MattermostPropsApplyConfiguration {
+	b.Card = &value
+	return b
+}
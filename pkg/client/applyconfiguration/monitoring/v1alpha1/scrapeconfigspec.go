@@ -36,6 +36,17 @@ type ScrapeConfigSpecApplyConfiguration struct {
 	JobName *string `json:"jobName,omitempty"`
 	// staticConfigs defines a list of static targets with a common label set.
 	StaticConfigs []StaticConfigApplyConfiguration `json:"staticConfigs,omitempty"`
+	// staticConfigsFrom defines a list of ConfigMap or Secret keys containing
+	// targets in the file service discovery format (either JSON or YAML).
+	//
+	// The operator mounts the referenced keys into the Prometheus pods and
+	// configures a file service discovery for them. Prometheus picks up
+	// changes of the targets without reloading its configuration.
+	//
+	// Because the objects are mounted into the pods, the ScrapeConfig resource
+	// and the objects must be in the same namespace as the Prometheus
+	// resource. The keys must not be empty.
+	StaticConfigsFrom []v1.SecretOrConfigMapApplyConfiguration `json:"staticConfigsFrom,omitempty"`
 	// fileSDConfigs defines a list of file service discovery configurations.
 	FileSDConfigs []FileSDConfigApplyConfiguration `json:"fileSDConfigs,omitempty"`
 	// httpSDConfigs defines a list of HTTP service discovery configurations.
@@ -80,6 +91,20 @@ type ScrapeConfigSpecApplyConfiguration struct {
 	ScalewaySDConfigs []ScalewaySDConfigApplyConfiguration `json:"scalewaySDConfigs,omitempty"`
 	// ionosSDConfigs defines a list of IONOS service discovery configurations.
 	IonosSDConfigs []IonosSDConfigApplyConfiguration `json:"ionosSDConfigs,omitempty"`
+	// vultrSDConfigs defines a list of Vultr service discovery configurations.
+	VultrSDConfigs []VultrSDConfigApplyConfiguration `json:"vultrSDConfigs,omitempty"`
+	// stackitSDConfigs defines a list of STACKIT service discovery configurations.
+	STACKITSDConfigs []STACKITSDConfigApplyConfiguration `json:"stackitSDConfigs,omitempty"`
+	// marathonSDConfigs defines a list of Marathon service discovery configurations.
+	MarathonSDConfigs []MarathonSDConfigApplyConfiguration `json:"marathonSDConfigs,omitempty"`
+	// tritonSDConfigs defines a list of Triton service discovery configurations.
+	TritonSDConfigs []TritonSDConfigApplyConfiguration `json:"tritonSDConfigs,omitempty"`
+	// uyuniSDConfigs defines a list of Uyuni service discovery configurations.
+	UyuniSDConfigs []UyuniSDConfigApplyConfiguration `json:"uyuniSDConfigs,omitempty"`
+	// serversetSDConfigs defines a list of Serverset service discovery configurations.
+	ServersetSDConfigs []ServersetSDConfigApplyConfiguration `json:"serversetSDConfigs,omitempty"`
+	// nerveSDConfigs defines a list of Nerve service discovery configurations.
+	NerveSDConfigs []NerveSDConfigApplyConfiguration `json:"nerveSDConfigs,omitempty"`
 	// relabelings defines how to rewrite the target's labels before scraping.
 	// Prometheus Operator automatically adds relabelings for a few standard Kubernetes fields.
 	// The original scrape job's name is available via the `__tmp_prometheus_job_name` label.
@@ -202,6 +227,19 @@ func (b *ScrapeConfigSpecApplyConfiguration) WithStaticConfigs(values ...*Static
 	return b
 }
 
+// WithStaticConfigsFrom adds the given value to the StaticConfigsFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the StaticConfigsFrom field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithStaticConfigsFrom(values ...*v1.SecretOrConfigMapApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithStaticConfigsFrom")
+		}
+		b.StaticConfigsFrom = append(b.StaticConfigsFrom, *values[i])
+	}
+	return b
+}
+
 // WithFileSDConfigs adds the given value to the FileSDConfigs field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the FileSDConfigs field.
@@ -488,6 +526,97 @@ func (b *ScrapeConfigSpecApplyConfiguration) WithIonosSDConfigs(values ...*Ionos
 	return b
 }
 
+// WithVultrSDConfigs adds the given value to the VultrSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VultrSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithVultrSDConfigs(values ...*VultrSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVultrSDConfigs")
+		}
+		b.VultrSDConfigs = append(b.VultrSDConfigs, *values[i])
+	}
+	return b
+}
+
+// WithSTACKITSDConfigs adds the given value to the STACKITSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the STACKITSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithSTACKITSDConfigs(values ...*STACKITSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSTACKITSDConfigs")
+		}
+		b.STACKITSDConfigs = append(b.STACKITSDConfigs, *values[i])
+	}
+	return b
+}
+
+// WithMarathonSDConfigs adds the given value to the MarathonSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MarathonSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithMarathonSDConfigs(values ...*MarathonSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMarathonSDConfigs")
+		}
+		b.MarathonSDConfigs = append(b.MarathonSDConfigs, *values[i])
+	}
+	return b
+}
+
+// WithTritonSDConfigs adds the given value to the TritonSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TritonSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithTritonSDConfigs(values ...*TritonSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTritonSDConfigs")
+		}
+		b.TritonSDConfigs = append(b.TritonSDConfigs, *values[i])
+	}
+	return b
+}
+
+// WithUyuniSDConfigs adds the given value to the UyuniSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UyuniSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithUyuniSDConfigs(values ...*UyuniSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUyuniSDConfigs")
+		}
+		b.UyuniSDConfigs = append(b.UyuniSDConfigs, *values[i])
+	}
+	return b
+}
+
+// WithServersetSDConfigs adds the given value to the ServersetSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ServersetSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithServersetSDConfigs(values ...*ServersetSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithServersetSDConfigs")
+		}
+		b.ServersetSDConfigs = append(b.ServersetSDConfigs, *values[i])
+	}
+	return b
+}
+
+// WithNerveSDConfigs adds the given value to the NerveSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NerveSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithNerveSDConfigs(values ...*NerveSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNerveSDConfigs")
+		}
+		b.NerveSDConfigs = append(b.NerveSDConfigs, *values[i])
+	}
+	return b
+}
+
 // WithRelabelConfigs adds the given value to the RelabelConfigs field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the RelabelConfigs field.
@@ -0,0 +1,137 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+)
+
+// TritonSDConfigApplyConfiguration represents a declarative configuration of the TritonSDConfig type for use
+// with apply.
+//
+// TritonSDConfig configurations allow retrieving scrape targets from Container Monitor discovery endpoints.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#triton_sd_config
+type TritonSDConfigApplyConfiguration struct {
+	// account defines the account to use for discovering new targets.
+	Account *string `json:"account,omitempty"`
+	// role defines the type of targets to discover: `Container` for the
+	// containers or `CN` for the compute nodes (global zones).
+	// If not set, Prometheus uses its default value (`Container`).
+	// The `CN` role requires Prometheus >= v2.19.0.
+	Role *monitoringv1alpha1.TritonRole `json:"role,omitempty"`
+	// dnsSuffix defines the DNS suffix which should be applied to the targets.
+	DNSSuffix *string `json:"dnsSuffix,omitempty"`
+	// endpoint defines the Triton discovery endpoint (e.g. `cmon.us-east-3b.triton.zone`).
+	Endpoint *string `json:"endpoint,omitempty"`
+	// groups defines a list of groups for which targets are retrieved, only
+	// supported when `role` is `Container`. If empty, all containers
+	// available to the account are retrieved.
+	Groups []string `json:"groups,omitempty"`
+	// port defines the port to use for discovery and metric scraping.
+	Port *int32 `json:"port,omitempty"`
+	// refreshInterval defines the time after which the targets are refreshed.
+	// If not set, Prometheus uses its default value.
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// version defines the Triton discovery API version.
+	// If not set, Prometheus uses its default value.
+	Version *int32 `json:"version,omitempty"`
+	// tlsConfig defines the TLS configuration to connect to the Triton
+	// discovery endpoint, including the client certificate.
+	TLSConfig *monitoringv1.SafeTLSConfigApplyConfiguration `json:"tlsConfig,omitempty"`
+}
+
+// TritonSDConfigApplyConfiguration constructs a declarative configuration of the TritonSDConfig type for use with
+// apply.
+func TritonSDConfig() *TritonSDConfigApplyConfiguration {
+	return &TritonSDConfigApplyConfiguration{}
+}
+
+// WithAccount sets the Account field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Account field is set to the value of the last call.
+func (b *TritonSDConfigApplyConfiguration) WithAccount(value string) *TritonSDConfigApplyConfiguration {
+	b.Account = &value
+	return b
+}
+
+// WithRole sets the Role field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Role field is set to the value of the last call.
+func (b *TritonSDConfigApplyConfiguration) WithRole(value monitoringv1alpha1.TritonRole) *TritonSDConfigApplyConfiguration {
+	b.Role = &value
+	return b
+}
+
+// WithDNSSuffix sets the DNSSuffix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNSSuffix field is set to the value of the last call.
+func (b *TritonSDConfigApplyConfiguration) WithDNSSuffix(value string) *TritonSDConfigApplyConfiguration {
+	b.DNSSuffix = &value
+	return b
+}
+
+// WithEndpoint sets the Endpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Endpoint field is set to the value of the last call.
+func (b *TritonSDConfigApplyConfiguration) WithEndpoint(value string) *TritonSDConfigApplyConfiguration {
+	b.Endpoint = &value
+	return b
+}
+
+// WithGroups adds the given value to the Groups field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Groups field.
+func (b *TritonSDConfigApplyConfiguration) WithGroups(values ...string) *TritonSDConfigApplyConfiguration {
+	for i := range values {
+		b.Groups = append(b.Groups, values[i])
+	}
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *TritonSDConfigApplyConfiguration) WithPort(value int32) *TritonSDConfigApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithRefreshInterval sets the RefreshInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RefreshInterval field is set to the value of the last call.
+func (b *TritonSDConfigApplyConfiguration) WithRefreshInterval(value v1.Duration) *TritonSDConfigApplyConfiguration {
+	b.RefreshInterval = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *TritonSDConfigApplyConfiguration) WithVersion(value int32) *TritonSDConfigApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithTLSConfig sets the TLSConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLSConfig field is set to the value of the last call.
+func (b *TritonSDConfigApplyConfiguration) WithTLSConfig(value *monitoringv1.SafeTLSConfigApplyConfiguration) *TritonSDConfigApplyConfiguration {
+	b.TLSConfig = value
+	return b
+}
@@ -0,0 +1,171 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	applyconfigurationmonitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// UyuniSDConfigApplyConfiguration represents a declarative configuration of the UyuniSDConfig type for use
+// with apply.
+//
+// UyuniSDConfig configurations allow retrieving scrape targets from the Uyuni API.
+// The operator requires Prometheus >= v2.31.0.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#uyuni_sd_config
+type UyuniSDConfigApplyConfiguration struct {
+	// server defines the URL of the Uyuni server.
+	Server *monitoringv1alpha1.URL `json:"server,omitempty"`
+	// username defines the Secret key containing the username to authenticate against the Uyuni API.
+	Username *v1.SecretKeySelector `json:"username,omitempty"`
+	// password defines the Secret key containing the password to authenticate against the Uyuni API.
+	Password *v1.SecretKeySelector `json:"password,omitempty"`
+	// entitlement defines the entitlement string to filter the systems.
+	// If not set, Prometheus uses its default value.
+	Entitlement *string `json:"entitlement,omitempty"`
+	// separator defines the string by which Uyuni group names are joined into the groups label.
+	// If not set, Prometheus uses its default value.
+	Separator *string `json:"separator,omitempty"`
+	// refreshInterval defines the time after which the systems are refreshed.
+	// If not set, Prometheus uses its default value.
+	RefreshInterval                                              *monitoringv1.Duration `json:"refreshInterval,omitempty"`
+	applyconfigurationmonitoringv1.ProxyConfigApplyConfiguration `json:",inline"`
+	// tlsConfig defines the TLS configuration to connect to the Uyuni API.
+	TLSConfig *applyconfigurationmonitoringv1.SafeTLSConfigApplyConfiguration `json:"tlsConfig,omitempty"`
+	// followRedirects defines whether HTTP requests follow HTTP 3xx redirects.
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// enableHTTP2 defines whether to enable HTTP2.
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"`
+}
+
+// UyuniSDConfigApplyConfiguration constructs a declarative configuration of the UyuniSDConfig type for use with
+// apply.
+func UyuniSDConfig() *UyuniSDConfigApplyConfiguration {
+	return &UyuniSDConfigApplyConfiguration{}
+}
+
+// WithServer sets the Server field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Server field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithServer(value monitoringv1alpha1.URL) *UyuniSDConfigApplyConfiguration {
+	b.Server = &value
+	return b
+}
+
+// WithUsername sets the Username field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Username field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithUsername(value v1.SecretKeySelector) *UyuniSDConfigApplyConfiguration {
+	b.Username = &value
+	return b
+}
+
+// WithPassword sets the Password field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Password field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithPassword(value v1.SecretKeySelector) *UyuniSDConfigApplyConfiguration {
+	b.Password = &value
+	return b
+}
+
+// WithEntitlement sets the Entitlement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Entitlement field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithEntitlement(value string) *UyuniSDConfigApplyConfiguration {
+	b.Entitlement = &value
+	return b
+}
+
+// WithSeparator sets the Separator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Separator field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithSeparator(value string) *UyuniSDConfigApplyConfiguration {
+	b.Separator = &value
+	return b
+}
+
+// WithRefreshInterval sets the RefreshInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RefreshInterval field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithRefreshInterval(value monitoringv1.Duration) *UyuniSDConfigApplyConfiguration {
+	b.RefreshInterval = &value
+	return b
+}
+
+// WithProxyURL sets the ProxyURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyURL field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithProxyURL(value string) *UyuniSDConfigApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.ProxyURL = &value
+	return b
+}
+
+// WithNoProxy sets the NoProxy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NoProxy field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithNoProxy(value string) *UyuniSDConfigApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.NoProxy = &value
+	return b
+}
+
+// WithProxyFromEnvironment sets the ProxyFromEnvironment field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyFromEnvironment field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithProxyFromEnvironment(value bool) *UyuniSDConfigApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.ProxyFromEnvironment = &value
+	return b
+}
+
+// WithProxyConnectHeader puts the entries into the ProxyConnectHeader field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ProxyConnectHeader field,
+// overwriting an existing map entries in ProxyConnectHeader field with the same key.
+func (b *UyuniSDConfigApplyConfiguration) WithProxyConnectHeader(entries map[string][]v1.SecretKeySelector) *UyuniSDConfigApplyConfiguration {
+	if b.ProxyConfigApplyConfiguration.ProxyConnectHeader == nil && len(entries) > 0 {
+		b.ProxyConfigApplyConfiguration.ProxyConnectHeader = make(map[string][]v1.SecretKeySelector, len(entries))
+	}
+	for k, v := range entries {
+		b.ProxyConfigApplyConfiguration.ProxyConnectHeader[k] = v
+	}
+	return b
+}
+
+// WithTLSConfig sets the TLSConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLSConfig field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithTLSConfig(value *applyconfigurationmonitoringv1.SafeTLSConfigApplyConfiguration) *UyuniSDConfigApplyConfiguration {
+	b.TLSConfig = value
+	return b
+}
+
+// WithFollowRedirects sets the FollowRedirects field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FollowRedirects field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithFollowRedirects(value bool) *UyuniSDConfigApplyConfiguration {
+	b.FollowRedirects = &value
+	return b
+}
+
+// WithEnableHTTP2 sets the EnableHTTP2 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EnableHTTP2 field is set to the value of the last call.
+func (b *UyuniSDConfigApplyConfiguration) WithEnableHTTP2(value bool) *UyuniSDConfigApplyConfiguration {
+	b.EnableHTTP2 = &value
+	return b
+}
@@ -35,6 +35,12 @@ type TimePeriodApplyConfiguration struct {
 	Months []monitoringv1beta1.MonthRange `json:"months,omitempty"`
 	// years defines a list of YearRange
 	Years []monitoringv1beta1.YearRange `json:"years,omitempty"`
+	// location defines the time zone in which the time interval is evaluated,
+	// expressed as an IANA Time Zone Database name (e.g. "Europe/Paris").
+	// When not defined, the time interval is evaluated in UTC.
+	//
+	// It requires Alertmanager >= 0.25.0.
+	Location *string `json:"location,omitempty"`
 }
 
 // TimePeriodApplyConfiguration constructs a declarative configuration of the TimePeriod type for use with
@@ -98,3 +104,11 @@ func (b *TimePeriodApplyConfiguration) WithYears(values ...monitoringv1beta1.Yea
 	}
 	return b
 }
+
+// WithLocation sets the Location field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Location field is set to the value of the last call.
+func (b *TimePeriodApplyConfiguration) WithLocation(value string) *TimePeriodApplyConfiguration {
+	b.Location = &value
+	return b
+}
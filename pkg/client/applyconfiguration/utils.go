@@ -48,6 +48,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.AlertmanagerGlobalConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerLimitsSpec"):
 		return &monitoringv1.AlertmanagerLimitsSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AlertmanagerReference"):
+		return &monitoringv1.AlertmanagerReferenceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerSpec"):
 		return &monitoringv1.AlertmanagerSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerStatus"):
@@ -94,6 +96,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.EndpointApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Exemplars"):
 		return &monitoringv1.ExemplarsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("FederationSource"):
+		return &monitoringv1.FederationSourceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GlobalJiraConfig"):
 		return &monitoringv1.GlobalJiraConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GlobalMattermostConfig"):
@@ -158,10 +162,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.ProberSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProbeSpec"):
 		return &monitoringv1.ProbeSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProbeTargetHTTPRoute"):
+		return &monitoringv1.ProbeTargetHTTPRouteApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProbeTargetIngress"):
 		return &monitoringv1.ProbeTargetIngressApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProbeTargets"):
 		return &monitoringv1.ProbeTargetsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProbeTargetService"):
+		return &monitoringv1.ProbeTargetServiceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProbeTargetStaticConfig"):
 		return &monitoringv1.ProbeTargetStaticConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Prometheus"):
@@ -202,6 +210,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.RulesApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RulesAlert"):
 		return &monitoringv1.RulesAlertApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RuleShardingStrategy"):
+		return &monitoringv1.RuleShardingStrategyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RuntimeConfig"):
 		return &monitoringv1.RuntimeConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SafeAuthorization"):
@@ -216,12 +226,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.ServiceMonitorApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ServiceMonitorSpec"):
 		return &monitoringv1.ServiceMonitorSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ShardAutoscaling"):
+		return &monitoringv1.ShardAutoscalingApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ShardingStrategy"):
 		return &monitoringv1.ShardingStrategyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ShardRetentionPolicy"):
 		return &monitoringv1.ShardRetentionPolicyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ShardStatus"):
 		return &monitoringv1.ShardStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SharedReceiver"):
+		return &monitoringv1.SharedReceiverApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Sigv4"):
 		return &monitoringv1.Sigv4ApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("StatefulSetUpdateStrategy"):
@@ -266,6 +280,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.AlertmanagerConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerConfigSpec"):
 		return &monitoringv1alpha1.AlertmanagerConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerReference"):
+		return &monitoringv1alpha1.AlertmanagerReferenceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AttachMetadata"):
 		return &monitoringv1alpha1.AttachMetadataApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AzureSDConfig"):
@@ -304,10 +320,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.HTTPConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HTTPSDConfig"):
 		return &monitoringv1alpha1.HTTPSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IncidentioConfig"):
+		return &monitoringv1alpha1.IncidentioConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("InhibitRule"):
 		return &monitoringv1alpha1.InhibitRuleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IonosSDConfig"):
 		return &monitoringv1alpha1.IonosSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JiraConfig"):
+		return &monitoringv1alpha1.JiraConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JiraField"):
+		return &monitoringv1alpha1.JiraFieldApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("K8SSelectorConfig"):
 		return &monitoringv1alpha1.K8SSelectorConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("KeyValue"):
@@ -320,8 +342,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.LightSailSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LinodeSDConfig"):
 		return &monitoringv1alpha1.LinodeSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MarathonSDConfig"):
+		return &monitoringv1alpha1.MarathonSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Matcher"):
 		return &monitoringv1alpha1.MatcherApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MattermostAttachment"):
+		return &monitoringv1alpha1.MattermostAttachmentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MattermostConfig"):
+		return &monitoringv1alpha1.MattermostConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MattermostField"):
+		return &monitoringv1alpha1.MattermostFieldApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MattermostPriority"):
+		return &monitoringv1alpha1.MattermostPriorityApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MattermostProps"):
+		return &monitoringv1alpha1.MattermostPropsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MSTeamsConfig"):
 		return &monitoringv1alpha1.MSTeamsConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MSTeamsV2Config"):
@@ -330,6 +364,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.MuteTimeIntervalApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceDiscovery"):
 		return &monitoringv1alpha1.NamespaceDiscoveryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NerveSDConfig"):
+		return &monitoringv1alpha1.NerveSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NomadSDConfig"):
 		return &monitoringv1alpha1.NomadSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackSDConfig"):
@@ -356,6 +392,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.PushoverConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Receiver"):
 		return &monitoringv1alpha1.ReceiverApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReceiverTest"):
+		return &monitoringv1alpha1.ReceiverTestApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReceiverTestResult"):
+		return &monitoringv1alpha1.ReceiverTestResultApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReceiverTestSpec"):
+		return &monitoringv1alpha1.ReceiverTestSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReceiverTestStatus"):
+		return &monitoringv1alpha1.ReceiverTestStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RocketChatActionConfig"):
 		return &monitoringv1alpha1.RocketChatActionConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RocketChatConfig"):
@@ -370,6 +414,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.ScrapeConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ScrapeConfigSpec"):
 		return &monitoringv1alpha1.ScrapeConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServersetSDConfig"):
+		return &monitoringv1alpha1.ServersetSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SlackAction"):
 		return &monitoringv1alpha1.SlackActionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SlackConfig"):
@@ -380,6 +426,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.SlackFieldApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SNSConfig"):
 		return &monitoringv1alpha1.SNSConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("STACKITSDConfig"):
+		return &monitoringv1alpha1.STACKITSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("StaticConfig"):
 		return &monitoringv1alpha1.StaticConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TelegramConfig"):
@@ -388,8 +436,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.TimeIntervalApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TimeRange"):
 		return &monitoringv1alpha1.TimeRangeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TritonSDConfig"):
+		return &monitoringv1alpha1.TritonSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UyuniSDConfig"):
+		return &monitoringv1alpha1.UyuniSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VictorOpsConfig"):
 		return &monitoringv1alpha1.VictorOpsConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VultrSDConfig"):
+		return &monitoringv1alpha1.VultrSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("WebexConfig"):
 		return &monitoringv1alpha1.WebexConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("WebhookConfig"):
@@ -412,12 +466,28 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1beta1.EmailThreadingConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("HTTPConfig"):
 		return &monitoringv1beta1.HTTPConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("IncidentioConfig"):
+		return &monitoringv1beta1.IncidentioConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("InhibitRule"):
 		return &monitoringv1beta1.InhibitRuleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("JiraConfig"):
+		return &monitoringv1beta1.JiraConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("JiraField"):
+		return &monitoringv1beta1.JiraFieldApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("KeyValue"):
 		return &monitoringv1beta1.KeyValueApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Matcher"):
 		return &monitoringv1beta1.MatcherApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MattermostAttachment"):
+		return &monitoringv1beta1.MattermostAttachmentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MattermostConfig"):
+		return &monitoringv1beta1.MattermostConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MattermostField"):
+		return &monitoringv1beta1.MattermostFieldApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MattermostPriority"):
+		return &monitoringv1beta1.MattermostPriorityApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MattermostProps"):
+		return &monitoringv1beta1.MattermostPropsApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MSTeamsConfig"):
 		return &monitoringv1beta1.MSTeamsConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MSTeamsV2Config"):
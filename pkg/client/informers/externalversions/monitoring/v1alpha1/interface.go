@@ -26,6 +26,8 @@ type Interface interface {
 	AlertmanagerConfigs() AlertmanagerConfigInformer
 	// PrometheusAgents returns a PrometheusAgentInformer.
 	PrometheusAgents() PrometheusAgentInformer
+	// ReceiverTests returns a ReceiverTestInformer.
+	ReceiverTests() ReceiverTestInformer
 	// ScrapeConfigs returns a ScrapeConfigInformer.
 	ScrapeConfigs() ScrapeConfigInformer
 }
@@ -51,6 +53,11 @@ func (v *version) PrometheusAgents() PrometheusAgentInformer {
 	return &prometheusAgentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ReceiverTests returns a ReceiverTestInformer.
+func (v *version) ReceiverTests() ReceiverTestInformer {
+	return &receiverTestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ScrapeConfigs returns a ScrapeConfigInformer.
 func (v *version) ScrapeConfigs() ScrapeConfigInformer {
 	return &scrapeConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -32,6 +32,14 @@ type PrometheusAgentListerExpansion interface{}
 // PrometheusAgentNamespaceLister.
 type PrometheusAgentNamespaceListerExpansion interface{}
 
+// ReceiverTestListerExpansion allows custom methods to be added to
+// ReceiverTestLister.
+type ReceiverTestListerExpansion interface{}
+
+// ReceiverTestNamespaceListerExpansion allows custom methods to be added to
+// ReceiverTestNamespaceLister.
+type ReceiverTestNamespaceListerExpansion interface{}
+
 // ScrapeConfigListerExpansion allows custom methods to be added to
 // ScrapeConfigLister.
 type ScrapeConfigListerExpansion interface{}
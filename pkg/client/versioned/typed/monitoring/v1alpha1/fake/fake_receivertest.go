@@ -0,0 +1,51 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	typedmonitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/typed/monitoring/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeReceiverTests implements ReceiverTestInterface
+type fakeReceiverTests struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.ReceiverTest, *v1alpha1.ReceiverTestList, *monitoringv1alpha1.ReceiverTestApplyConfiguration]
+	Fake *FakeMonitoringV1alpha1
+}
+
+func newFakeReceiverTests(fake *FakeMonitoringV1alpha1, namespace string) typedmonitoringv1alpha1.ReceiverTestInterface {
+	return &fakeReceiverTests{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.ReceiverTest, *v1alpha1.ReceiverTestList, *monitoringv1alpha1.ReceiverTestApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("receivertests"),
+			v1alpha1.SchemeGroupVersion.WithKind("ReceiverTest"),
+			func() *v1alpha1.ReceiverTest { return &v1alpha1.ReceiverTest{} },
+			func() *v1alpha1.ReceiverTestList { return &v1alpha1.ReceiverTestList{} },
+			func(dst, src *v1alpha1.ReceiverTestList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.ReceiverTestList) []*v1alpha1.ReceiverTest {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.ReceiverTestList, items []*v1alpha1.ReceiverTest) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
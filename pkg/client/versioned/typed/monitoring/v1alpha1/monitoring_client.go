@@ -28,6 +28,7 @@ type MonitoringV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AlertmanagerConfigsGetter
 	PrometheusAgentsGetter
+	ReceiverTestsGetter
 	ScrapeConfigsGetter
 }
 
@@ -44,6 +45,10 @@ func (c *MonitoringV1alpha1Client) PrometheusAgents(namespace string) Prometheus
 	return newPrometheusAgents(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) ReceiverTests(namespace string) ReceiverTestInterface {
+	return newReceiverTests(c, namespace)
+}
+
 func (c *MonitoringV1alpha1Client) ScrapeConfigs(namespace string) ScrapeConfigInterface {
 	return newScrapeConfigs(c, namespace)
 }
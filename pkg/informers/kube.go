@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
@@ -69,6 +71,26 @@ func NewMetadataInformerFactory(
 	return ret
 }
 
+// NewDynamicInformerFactory creates dynamicinformer factories for resources
+// which aren't known at compile time (e.g. third-party custom resources)
+// for the given allowed, and denied namespaces (these parameters being mutually exclusive).
+// dynamicClient, defaultResync, and tweakListOptions are passed to the underlying informer factory.
+func NewDynamicInformerFactory(
+	allowNamespaces, denyNamespaces map[string]struct{},
+	dynamicClient dynamic.Interface,
+	defaultResync time.Duration,
+	tweakListOptions func(*metav1.ListOptions),
+) FactoriesForNamespaces {
+	tweaks, namespaces := newInformerOptions(allowNamespaces, denyNamespaces, tweakListOptions)
+
+	ret := dynamicInformersForNamespace{}
+	for _, namespace := range namespaces {
+		ret[namespace] = dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, defaultResync, namespace, tweaks)
+	}
+
+	return ret
+}
+
 type kubeInformersForNamespaces map[string]informers.SharedInformerFactory
 
 func (i kubeInformersForNamespaces) Namespaces() sets.Set[string] {
@@ -88,3 +110,13 @@ func (i metadataInformersForNamespace) Namespaces() sets.Set[string] {
 func (i metadataInformersForNamespace) ForResource(namespace string, resource schema.GroupVersionResource) (InformLister, error) {
 	return i[namespace].ForResource(resource), nil
 }
+
+type dynamicInformersForNamespace map[string]dynamicinformer.DynamicSharedInformerFactory
+
+func (i dynamicInformersForNamespace) Namespaces() sets.Set[string] {
+	return sets.KeySet(i)
+}
+
+func (i dynamicInformersForNamespace) ForResource(namespace string, resource schema.GroupVersionResource) (InformLister, error) {
+	return i[namespace].ForResource(resource), nil
+}
@@ -0,0 +1,230 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/blang/semver/v4"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+)
+
+const (
+	// AlertmanagerGoverningServiceName is the name of the governing service
+	// for the Alertmanager resources which don't define a custom service.
+	AlertmanagerGoverningServiceName = "alertmanager-operated"
+
+	// AlertmanagerDefaultPortName is the default name of the Alertmanager
+	// web port.
+	AlertmanagerDefaultPortName = "web"
+)
+
+// AlertmanagerEndpoint describes how to send alerts to the pods of an
+// Alertmanager resource.
+type AlertmanagerEndpoint struct {
+	// Namespace and Name identify the Alertmanager resource.
+	Namespace string
+	Name      string
+
+	// ServiceName is the name of the governing service.
+	ServiceName string
+	// PortName is the name of the web port in the governing service.
+	PortName string
+
+	Scheme     monitoringv1.Scheme
+	PathPrefix string
+	APIVersion monitoringv1.AlertmanagerAPIVersion
+
+	// Hosts contains the stable network names of the pods
+	// (`<pod>.<service>.<namespace>.svc`).
+	Hosts []string
+}
+
+// NewAlertmanagerEndpoint returns the endpoint of the given Alertmanager
+// resource.
+func NewAlertmanagerEndpoint(am *monitoringv1.Alertmanager) AlertmanagerEndpoint {
+	// Assume the latest version if the version can't be parsed.
+	version, err := semver.ParseTolerant(StringValOrDefault(am.Spec.Version, DefaultAlertmanagerVersion))
+	if err != nil {
+		version, _ = semver.ParseTolerant(DefaultAlertmanagerVersion)
+	}
+
+	ep := AlertmanagerEndpoint{
+		Namespace:   am.Namespace,
+		Name:        am.Name,
+		ServiceName: ptr.Deref(am.Spec.ServiceName, AlertmanagerGoverningServiceName),
+		PortName:    StringValOrDefault(am.Spec.PortName, AlertmanagerDefaultPortName),
+		Scheme:      monitoringv1.SchemeHTTP,
+		PathPrefix:  StringValOrDefault(am.Spec.RoutePrefix, "/"),
+		APIVersion:  monitoringv1.AlertmanagerAPIVersion2,
+	}
+
+	if am.Spec.Web != nil && am.Spec.Web.TLSConfig != nil && version.GTE(semver.MustParse("0.22.0")) {
+		ep.Scheme = monitoringv1.SchemeHTTPS
+	}
+
+	// The v2 API is available since v0.16.0.
+	if version.LT(semver.MustParse("0.16.0")) {
+		ep.APIVersion = monitoringv1.AlertmanagerAPIVersion1
+	}
+
+	for i := range am.ExpectedReplicas() {
+		ep.Hosts = append(ep.Hosts, fmt.Sprintf("alertmanager-%s-%d.%s.%s.svc", am.Name, i, ep.ServiceName, am.Namespace))
+	}
+
+	return ep
+}
+
+// ResolveAlertmanagerPort returns the number of the web port of the
+// Alertmanager pods.
+//
+// The port is looked up by name in the governing service. Because the pod
+// names resolve to the pod IPs, a named target port is resolved from the
+// container ports of the Alertmanager StatefulSet. The Service and StatefulSet
+// objects are read from the svcInfs and ssetInfs informers.
+func ResolveAlertmanagerPort(svcInfs, ssetInfs *informers.ForResource, ep AlertmanagerEndpoint) (int32, error) {
+	svcKey := ep.Namespace + "/" + ep.ServiceName
+	obj, err := svcInfs.Get(svcKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get service %s: %w", svcKey, err)
+	}
+	svc := obj.(*corev1.Service)
+
+	i := slices.IndexFunc(svc.Spec.Ports, func(p corev1.ServicePort) bool { return p.Name == ep.PortName })
+	if i < 0 {
+		return 0, fmt.Errorf("service %s has no port named %q", svcKey, ep.PortName)
+	}
+	port := svc.Spec.Ports[i]
+
+	switch {
+	case port.TargetPort.Type == intstr.Int && port.TargetPort.IntVal == 0:
+		return port.Port, nil
+	case port.TargetPort.Type == intstr.Int:
+		return port.TargetPort.IntVal, nil
+	}
+
+	stsKey := fmt.Sprintf("%s/alertmanager-%s", ep.Namespace, ep.Name)
+	obj, err = ssetInfs.Get(stsKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get statefulset %s: %w", stsKey, err)
+	}
+	sts := obj.(*appsv1.StatefulSet)
+
+	for _, c := range sts.Spec.Template.Spec.Containers {
+		for _, cp := range c.Ports {
+			if cp.Name == port.TargetPort.StrVal {
+				return cp.ContainerPort, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("statefulset %s has no container port named %q", stsKey, port.TargetPort.StrVal)
+}
+
+// SelectAlertmanagers returns the Alertmanager resources matching the label
+// selector in the given namespace and the Alertmanager resources referenced
+// by refs, sorted by namespace and name.
+//
+// References to Alertmanager resources which don't exist are ignored.
+func SelectAlertmanagers(
+	infs *informers.ForResource,
+	namespace string,
+	selector *metav1.LabelSelector,
+	refs []monitoringv1.AlertmanagerReference,
+) ([]*monitoringv1.Alertmanager, error) {
+	selected := map[string]*monitoringv1.Alertmanager{}
+
+	if selector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Alertmanager selector: %w", err)
+		}
+
+		err = infs.ListAllByNamespace(namespace, sel, func(obj any) {
+			am := obj.(*monitoringv1.Alertmanager)
+			selected[am.Namespace+"/"+am.Name] = am.DeepCopy()
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Alertmanager resources: %w", err)
+		}
+	}
+
+	for _, ref := range refs {
+		key := ptr.Deref(ref.Namespace, namespace) + "/" + ref.Name
+		if _, found := selected[key]; found {
+			continue
+		}
+
+		am, err := GetObjectFromKey[*monitoringv1.Alertmanager](infs, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Alertmanager %q: %w", key, err)
+		}
+
+		if am == nil {
+			continue
+		}
+
+		selected[key] = am
+	}
+
+	ams := make([]*monitoringv1.Alertmanager, 0, len(selected))
+	for _, am := range selected {
+		if am.DeletionTimestamp != nil {
+			continue
+		}
+
+		ams = append(ams, am)
+	}
+
+	slices.SortFunc(ams, func(a, b *monitoringv1.Alertmanager) int {
+		return cmp.Or(
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	return ams, nil
+}
+
+// SelectsAlertmanagersInNamespace returns true if the label selector
+// (applied to the given namespace) or the references can match Alertmanager
+// resources from the amNamespace namespace.
+func SelectsAlertmanagersInNamespace(
+	namespace string,
+	selector *metav1.LabelSelector,
+	refs []monitoringv1.AlertmanagerReference,
+	amNamespace string,
+) bool {
+	if selector != nil && namespace == amNamespace {
+		return true
+	}
+
+	for _, ref := range refs {
+		if ptr.Deref(ref.Namespace, namespace) == amNamespace {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,270 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+)
+
+func TestNewAlertmanagerEndpoint(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		spec     monitoringv1.AlertmanagerSpec
+		expected AlertmanagerEndpoint
+	}{
+		{
+			name: "default",
+			expected: AlertmanagerEndpoint{
+				Namespace:   "ns",
+				Name:        "main",
+				ServiceName: "alertmanager-operated",
+				PortName:    "web",
+				Scheme:      monitoringv1.SchemeHTTP,
+				PathPrefix:  "/",
+				APIVersion:  monitoringv1.AlertmanagerAPIVersion2,
+				Hosts:       []string{"alertmanager-main-0.alertmanager-operated.ns.svc"},
+			},
+		},
+		{
+			name: "custom service, port and route prefix",
+			spec: monitoringv1.AlertmanagerSpec{
+				Replicas:    ptr.To(int32(2)),
+				ServiceName: ptr.To("alertmanager-main"),
+				PortName:    "http-web",
+				RoutePrefix: "/alertmanager",
+			},
+			expected: AlertmanagerEndpoint{
+				Namespace:   "ns",
+				Name:        "main",
+				ServiceName: "alertmanager-main",
+				PortName:    "http-web",
+				Scheme:      monitoringv1.SchemeHTTP,
+				PathPrefix:  "/alertmanager",
+				APIVersion:  monitoringv1.AlertmanagerAPIVersion2,
+				Hosts: []string{
+					"alertmanager-main-0.alertmanager-main.ns.svc",
+					"alertmanager-main-1.alertmanager-main.ns.svc",
+				},
+			},
+		},
+		{
+			name: "web TLS",
+			spec: monitoringv1.AlertmanagerSpec{
+				Web: &monitoringv1.AlertmanagerWebSpec{
+					WebConfigFileFields: monitoringv1.WebConfigFileFields{
+						TLSConfig: &monitoringv1.WebTLSConfig{},
+					},
+				},
+			},
+			expected: AlertmanagerEndpoint{
+				Namespace:   "ns",
+				Name:        "main",
+				ServiceName: "alertmanager-operated",
+				PortName:    "web",
+				Scheme:      monitoringv1.SchemeHTTPS,
+				PathPrefix:  "/",
+				APIVersion:  monitoringv1.AlertmanagerAPIVersion2,
+				Hosts:       []string{"alertmanager-main-0.alertmanager-operated.ns.svc"},
+			},
+		},
+		{
+			name: "old version",
+			spec: monitoringv1.AlertmanagerSpec{
+				Version: "v0.15.3",
+				Web: &monitoringv1.AlertmanagerWebSpec{
+					WebConfigFileFields: monitoringv1.WebConfigFileFields{
+						TLSConfig: &monitoringv1.WebTLSConfig{},
+					},
+				},
+			},
+			expected: AlertmanagerEndpoint{
+				Namespace:   "ns",
+				Name:        "main",
+				ServiceName: "alertmanager-operated",
+				PortName:    "web",
+				Scheme:      monitoringv1.SchemeHTTP,
+				PathPrefix:  "/",
+				APIVersion:  monitoringv1.AlertmanagerAPIVersion1,
+				Hosts:       []string{"alertmanager-main-0.alertmanager-operated.ns.svc"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "ns"},
+				Spec:       tc.spec,
+			}
+
+			require.Equal(t, tc.expected, NewAlertmanagerEndpoint(am))
+		})
+	}
+}
+
+func TestResolveAlertmanagerPort(t *testing.T) {
+	ep := AlertmanagerEndpoint{
+		Namespace:   "ns",
+		Name:        "main",
+		ServiceName: "alertmanager-operated",
+		PortName:    "web",
+	}
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-main", Namespace: "ns"},
+		Spec: appsv1.StatefulSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "alertmanager",
+							Ports: []corev1.ContainerPort{{Name: "web", ContainerPort: 8080}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		port     corev1.ServicePort
+		objects  []runtime.Object
+		expected int32
+		err      bool
+	}{
+		{
+			name:     "named target port",
+			port:     corev1.ServicePort{Name: "web", Port: 9093, TargetPort: intstr.FromString("web")},
+			objects:  []runtime.Object{sts},
+			expected: 8080,
+		},
+		{
+			name:     "numeric target port",
+			port:     corev1.ServicePort{Name: "web", Port: 80, TargetPort: intstr.FromInt32(9095)},
+			expected: 9095,
+		},
+		{
+			name:     "no target port",
+			port:     corev1.ServicePort{Name: "web", Port: 9094},
+			expected: 9094,
+		},
+		{
+			name: "missing port",
+			port: corev1.ServicePort{Name: "http", Port: 9093},
+			err:  true,
+		},
+		{
+			name: "missing statefulset",
+			port: corev1.ServicePort{Name: "web", Port: 9093, TargetPort: intstr.FromString("web")},
+			err:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-operated", Namespace: "ns"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{tc.port},
+				},
+			}
+
+			ifs := informers.NewKubeInformerFactories(
+				map[string]struct{}{metav1.NamespaceAll: {}},
+				nil,
+				fake.NewClientset(append(tc.objects, svc)...),
+				0,
+				nil,
+			)
+			svcInfs, err := informers.NewInformersForResource(ifs, corev1.SchemeGroupVersion.WithResource(string(corev1.ResourceServices)))
+			require.NoError(t, err)
+			ssetInfs, err := informers.NewInformersForResource(ifs, appsv1.SchemeGroupVersion.WithResource("statefulsets"))
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			svcInfs.Start(ctx.Done())
+			ssetInfs.Start(ctx.Done())
+			require.True(t, cache.WaitForCacheSync(ctx.Done(), svcInfs.HasSynced, ssetInfs.HasSynced))
+
+			port, err := ResolveAlertmanagerPort(svcInfs, ssetInfs, ep)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, port)
+		})
+	}
+}
+
+func TestSelectsAlertmanagersInNamespace(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		selector    *metav1.LabelSelector
+		refs        []monitoringv1.AlertmanagerReference
+		amNamespace string
+		expected    bool
+	}{
+		{
+			name:        "nothing selected",
+			amNamespace: "ns",
+		},
+		{
+			name:        "selector in same namespace",
+			selector:    &metav1.LabelSelector{},
+			amNamespace: "ns",
+			expected:    true,
+		},
+		{
+			name:        "selector in other namespace",
+			selector:    &metav1.LabelSelector{},
+			amNamespace: "other",
+		},
+		{
+			name:        "reference without namespace",
+			refs:        []monitoringv1.AlertmanagerReference{{Name: "main"}},
+			amNamespace: "ns",
+			expected:    true,
+		},
+		{
+			name:        "reference with namespace",
+			refs:        []monitoringv1.AlertmanagerReference{{Namespace: ptr.To("other"), Name: "main"}},
+			amNamespace: "other",
+			expected:    true,
+		},
+		{
+			name:        "reference to another namespace",
+			refs:        []monitoringv1.AlertmanagerReference{{Namespace: ptr.To("other"), Name: "main"}},
+			amNamespace: "ns",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, SelectsAlertmanagersInNamespace("ns", tc.selector, tc.refs, tc.amNamespace))
+		})
+	}
+}
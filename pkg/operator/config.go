@@ -21,6 +21,7 @@ import (
 	"maps"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	corev1 "k8s.io/api/core/v1"
@@ -106,6 +107,14 @@ func DefaultConfig(cpu, memory string) Config {
 				description: "Enables the zone aware sharding for Prometheus",
 				enabled:     false,
 			},
+			PrometheusResourceShardingFeature: FeatureGate{
+				description: "Enables the assignment of monitoring resources to Prometheus shards",
+				enabled:     false,
+			},
+			PrometheusShardAutoscalingFeature: FeatureGate{
+				description: "Enables the automatic scaling of Prometheus shards based on the number of head series",
+				enabled:     false,
+			},
 			PrometheusShardRetentionPolicyFeature: FeatureGate{
 				description: "Enables shard retention policy for Prometheus",
 				enabled:     false,
@@ -144,6 +153,11 @@ type ContainerConfig struct {
 	MemoryLimits   Quantity `hash:"string"`
 	Image          string
 	EnableProbes   bool
+
+	// EnableDelegatedAuth protects the metrics endpoint of the container
+	// with the Kubernetes TokenReview and SubjectAccessReview APIs.
+	EnableDelegatedAuth   bool
+	DelegatedAuthCacheTTL time.Duration
 }
 
 func (cc ContainerConfig) ResourceRequirements() corev1.ResourceRequirements {
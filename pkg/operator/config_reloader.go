@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strconv"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -61,7 +62,11 @@ type ConfigReloader struct {
 	config             ContainerConfig
 	webConfigFile      string
 	configFile         string
+	configFileParts    string
+	configFileChecksum string
 	configEnvsubstFile string
+	configDir          string
+	configDirOutput    string
 	imagePullPolicy    corev1.PullPolicy
 	listenLocal        bool
 	localHost          string
@@ -77,6 +82,8 @@ type ConfigReloader struct {
 	watchedDirectories []string
 	useSignal          bool
 	withNodeNameEnv    bool
+	delegatedAuth      bool
+	delegatedAuthTTL   time.Duration
 }
 
 type ReloaderOption = func(*ConfigReloader)
@@ -116,6 +123,29 @@ func ConfigFile(configFile string) ReloaderOption {
 	}
 }
 
+// ConfigFileParts sets the configFileParts option for the config-reloader
+// container. The pattern is a glob pattern matching the files which are
+// concatenated into the config file and checksumFile is the file containing
+// the SHA256 checksum of the concatenated content. The reloader doesn't
+// update the config file until the parts match the checksum.
+func ConfigFileParts(pattern, checksumFile string) ReloaderOption {
+	return func(c *ConfigReloader) {
+		c.configFileParts = pattern
+		c.configFileChecksum = checksumFile
+	}
+}
+
+// ConfigDirectory sets the configDir and configDirOutput options for the
+// config-reloader container. The files of dir are decompressed if needed,
+// their environment variables are substituted and the result is written to
+// outputDir.
+func ConfigDirectory(dir, outputDir string) ReloaderOption {
+	return func(c *ConfigReloader) {
+		c.configDir = dir
+		c.configDirOutput = outputDir
+	}
+}
+
 // ConfigEnvsubstFile sets the configEnvsubstFile option for the config-reloader container.
 func ConfigEnvsubstFile(configEnvsubstFile string) ReloaderOption {
 	return func(c *ConfigReloader) {
@@ -124,9 +154,24 @@ func ConfigEnvsubstFile(configEnvsubstFile string) ReloaderOption {
 }
 
 // ReloaderConfig sets the config option for the config-reloader container.
+// It also enables the delegated authentication and authorization when
+// configured.
 func ReloaderConfig(rc ContainerConfig) ReloaderOption {
 	return func(c *ConfigReloader) {
 		c.config = rc
+		if rc.EnableDelegatedAuth {
+			DelegatedAuth(rc.DelegatedAuthCacheTTL)(c)
+		}
+	}
+}
+
+// DelegatedAuth enables the delegated authentication and authorization of
+// the requests to the metrics endpoint of the config-reloader container. A
+// zero cacheTTL keeps the default TTL of the config reloader.
+func DelegatedAuth(cacheTTL time.Duration) ReloaderOption {
+	return func(c *ConfigReloader) {
+		c.delegatedAuth = true
+		c.delegatedAuthTTL = cacheTTL
 	}
 }
 
@@ -286,6 +331,14 @@ func CreateConfigReloader(name string, options ...ReloaderOption) corev1.Contain
 		args = append(args, fmt.Sprintf("--web-config-file=%s", configReloader.webConfigFile))
 	}
 
+	// The init container doesn't expose the metrics endpoint.
+	if configReloader.delegatedAuth && !configReloader.initContainer {
+		args = append(args, "--web-enable-delegated-auth")
+		if configReloader.delegatedAuthTTL > 0 {
+			args = append(args, fmt.Sprintf("--web-delegated-auth-cache-ttl=%s", configReloader.delegatedAuthTTL))
+		}
+	}
+
 	if configReloader.useSignal {
 		args = append(args, "--reload-method=signal")
 		if len(configReloader.runtimeInfoURL.String()) > 0 {
@@ -311,6 +364,14 @@ func CreateConfigReloader(name string, options ...ReloaderOption) corev1.Contain
 		//
 		// Hack applied here as all reloader configurations should flow through this path.
 		confDir := filepath.Dir(configReloader.configFile)
+		if len(configReloader.configFileParts) > 0 {
+			// The config file is assembled from the parts by the reloader.
+			args = append(args, fmt.Sprintf("--config-file-parts=%s", configReloader.configFileParts))
+			if len(configReloader.configFileChecksum) > 0 {
+				args = append(args, fmt.Sprintf("--config-file-parts-checksum=%s", configReloader.configFileChecksum))
+			}
+			confDir = filepath.Dir(configReloader.configFileParts)
+		}
 		if !slices.Contains(configReloader.watchedDirectories, confDir) {
 			configReloader.watchedDirectories = append(configReloader.watchedDirectories, confDir)
 		}
@@ -320,6 +381,11 @@ func CreateConfigReloader(name string, options ...ReloaderOption) corev1.Contain
 		args = append(args, fmt.Sprintf("--config-envsubst-file=%s", configReloader.configEnvsubstFile))
 	}
 
+	if len(configReloader.configDir) > 0 {
+		args = append(args, fmt.Sprintf("--config-dir=%s", configReloader.configDir))
+		args = append(args, fmt.Sprintf("--config-dir-output=%s", configReloader.configDirOutput))
+	}
+
 	if len(configReloader.watchedDirectories) > 0 {
 		for _, directory := range configReloader.watchedDirectories {
 			args = append(args, fmt.Sprintf("--watched-dir=%s", directory))
@@ -20,6 +20,7 @@ import (
 	"slices"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -99,6 +100,23 @@ func TestCreateInitConfigReloaderEnableProbes(t *testing.T) {
 	}
 }
 
+func TestCreateConfigReloaderDelegatedAuth(t *testing.T) {
+	reloaderConfigCopy := reloaderConfig
+	reloaderConfigCopy.EnableDelegatedAuth = true
+	reloaderConfigCopy.DelegatedAuthCacheTTL = 2 * time.Minute
+
+	container := CreateConfigReloader("config-reloader", ReloaderConfig(reloaderConfigCopy))
+	assert.Contains(t, container.Args, "--web-enable-delegated-auth")
+	assert.Contains(t, container.Args, "--web-delegated-auth-cache-ttl=2m0s")
+
+	// The init container doesn't serve the metrics endpoint.
+	container = CreateConfigReloader("init-config-reloader", ReloaderConfig(reloaderConfigCopy), InitContainer())
+	assert.NotContains(t, container.Args, "--web-enable-delegated-auth")
+
+	container = CreateConfigReloader("config-reloader", ReloaderConfig(reloaderConfig))
+	assert.NotContains(t, container.Args, "--web-enable-delegated-auth")
+}
+
 func TestCreateInitConfigReloader(t *testing.T) {
 	initContainerName := "init-config-reloader"
 	expectedImagePullPolicy := corev1.PullAlways
@@ -156,6 +174,8 @@ func TestCreateConfigReloader(t *testing.T) {
 	configFile := "configFile"
 	webConfigFile := "webConfigFile"
 	configEnvsubstFile := "configEnvsubstFile"
+	configDir := "configDir"
+	configDirOutput := "configDirOutput"
 	watchedDirectories := []string{"directory1", "directory2"}
 	shard := int32(1)
 	expectedImagePullPolicy := corev1.PullAlways
@@ -173,6 +193,7 @@ func TestCreateConfigReloader(t *testing.T) {
 		LogLevel(logLevel),
 		ConfigFile(configFile),
 		ConfigEnvsubstFile(configEnvsubstFile),
+		ConfigDirectory(configDir, configDirOutput),
 		WatchedDirectories(watchedDirectories),
 		WebConfigFile(webConfigFile),
 		Shard(shard),
@@ -200,6 +221,12 @@ func TestCreateConfigReloader(t *testing.T) {
 	if !slices.Contains(container.Args, "--config-envsubst-file=configEnvsubstFile") {
 		t.Errorf("Expected '--config-envsubst-file=%s' not found in %s", configEnvsubstFile, container.Args)
 	}
+	if !slices.Contains(container.Args, "--config-dir=configDir") {
+		t.Errorf("Expected '--config-dir=%s' not found in %s", configDir, container.Args)
+	}
+	if !slices.Contains(container.Args, "--config-dir-output=configDirOutput") {
+		t.Errorf("Expected '--config-dir-output=%s' not found in %s", configDirOutput, container.Args)
+	}
 	if !slices.Contains(container.Args, "--web-config-file=webConfigFile") {
 		t.Errorf("Expected '--web-config-file=%s' not found in %s", webConfigFile, container.Args)
 	}
@@ -29,6 +29,12 @@ const (
 	// PrometheusTopologyShardingFeature enables the zone-aware sharding for Prometheus.
 	PrometheusTopologyShardingFeature FeatureGateName = "PrometheusTopologySharding"
 
+	// PrometheusResourceShardingFeature enables the assignment of monitoring resources to Prometheus shards.
+	PrometheusResourceShardingFeature FeatureGateName = "PrometheusResourceSharding"
+
+	// PrometheusShardAutoscalingFeature enables the automatic scaling of Prometheus shards.
+	PrometheusShardAutoscalingFeature FeatureGateName = "PrometheusShardAutoscaling"
+
 	// PrometheusShardRetentionPolicyFeature enables the shard retention policy for Prometheus.
 	PrometheusShardRetentionPolicyFeature FeatureGateName = "PrometheusShardRetentionPolicy"
 
@@ -166,6 +166,23 @@ func (rt *ReconciliationTracker) SetReasonAndMessage(key string, reason, message
 	rt.statusByObject[key] = rs
 }
 
+// AddReasonAndMessage is similar to SetReasonAndMessage but it doesn't discard
+// the message which may have been set before: the new reason takes precedence
+// and the messages are joined.
+func (rt *ReconciliationTracker) AddReasonAndMessage(key string, reason, message string) {
+	rt.init()
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+
+	rs := rt.statusByObject[key]
+	if rs.message != "" {
+		message = message + "; " + rs.message
+	}
+	rs.reason = reason
+	rs.message = message
+	rt.statusByObject[key] = rs
+}
+
 // GetStatus returns the last reconciliation status for the given object.
 // The second value indicates whether the object is known or not.
 func (rt *ReconciliationTracker) getStatus(k string) (ReconciliationStatus, bool) {
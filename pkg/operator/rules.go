@@ -76,14 +76,61 @@ type PrometheusRuleSelector struct {
 }
 
 type PrometheusRuleSelection struct {
-	selection TypedResourcesSelection[*monitoringv1.PrometheusRule] // PrometheusRules selected.
-	ruleFiles map[string]string                                     // Map of rule configuration files serialized to the Prometheus format (key=filename).
+	selection   TypedResourcesSelection[*monitoringv1.PrometheusRule] // PrometheusRules selected.
+	ruleFiles   map[string]string                                     // Map of rule configuration files serialized to the Prometheus format (key=filename).
+	ruleSources map[string]*monitoringv1.PrometheusRule               // Map of PrometheusRules from which the rule configuration files are generated (key=filename).
 }
 
 func (prs *PrometheusRuleSelection) RuleFiles() map[string]string {
 	return prs.ruleFiles
 }
 
+// RuleGroupShardFunc returns the shard to which the i-th group of the
+// PrometheusRule is assigned.
+type RuleGroupShardFunc func(rule *monitoringv1.PrometheusRule, i int) int32
+
+// RuleFilesByShard splits the rule configuration files by shard. Each rule
+// file only contains the rule groups assigned to the shard and rule files
+// without any group are omitted.
+func (prs *PrometheusRuleSelection) RuleFilesByShard(shards int32, shardFn RuleGroupShardFunc) ([]map[string]string, error) {
+	res := make([]map[string]string, shards)
+	for i := range res {
+		res[i] = map[string]string{}
+	}
+
+	for filename, content := range prs.ruleFiles {
+		var spec monitoringv1.PrometheusRuleSpec
+		if err := yaml.Unmarshal([]byte(content), &spec); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal rule file %q: %w", filename, err)
+		}
+
+		groups := make([][]monitoringv1.RuleGroup, shards)
+		for i, g := range spec.Groups {
+			shard := shardFn(prs.ruleSources[filename], i)
+			if shard < 0 || shard >= shards {
+				return nil, fmt.Errorf("rule file %q: invalid shard %d for group %q", filename, shard, g.Name)
+			}
+
+			groups[shard] = append(groups[shard], g)
+		}
+
+		for shard := range shards {
+			if len(groups[shard]) == 0 {
+				continue
+			}
+
+			b, err := yaml.Marshal(monitoringv1.PrometheusRuleSpec{Groups: groups[shard]})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal rule file %q: %w", filename, err)
+			}
+
+			res[shard][filename] = string(b)
+		}
+	}
+
+	return res, nil
+}
+
 func (prs *PrometheusRuleSelection) Selected() TypedResourcesSelection[*monitoringv1.PrometheusRule] {
 	return prs.selection
 }
@@ -166,7 +213,17 @@ func (prs *PrometheusRuleSelector) sanitizePrometheusRulesSpec(promRuleSpec moni
 		component = "Thanos"
 	}
 
+	// Copy the groups to avoid modifying the PrometheusRule resource.
+	promRuleSpec.Groups = slices.Clone(promRuleSpec.Groups)
+
 	for i := range promRuleSpec.Groups {
+		// The shard assignment is only used by the operator for Prometheus
+		// resources since Thanos Ruler doesn't support sharding.
+		if promRuleSpec.Groups[i].Shard != nil && prs.ruleFormat == ThanosFormat {
+			logger.Warn("ignoring `shard` not supported by Thanos", "group", promRuleSpec.Groups[i].Name)
+		}
+		promRuleSpec.Groups[i].Shard = nil
+
 		if promRuleSpec.Groups[i].Limit != nil && prs.version.LT(minVersionLimits) {
 			promRuleSpec.Groups[i].Limit = nil
 			logger.Warn(fmt.Sprintf("ignoring `limit` not supported by %s", component), "minimum_version", minVersionLimits)
@@ -205,6 +262,10 @@ func ValidateRule(promRuleSpec monitoringv1.PrometheusRuleSpec, validationScheme
 		// partial_response_strategy field.
 		promRuleSpec.Groups[i].PartialResponseStrategy = ""
 
+		// The shard assignment isn't part of the upstream Prometheus rule
+		// format.
+		promRuleSpec.Groups[i].Shard = nil
+
 		// Empty durations need to be translated to nil to be omitted from the
 		// YAML output otherwise the generated configuration will not be valid.
 		if promRuleSpec.Groups[i].Interval != nil && *promRuleSpec.Groups[i].Interval == "" {
@@ -256,6 +317,7 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (PrometheusRuleSe
 
 	var (
 		marshalRules    = make(map[string]string, len(promRules))
+		ruleSources     = make(map[string]*monitoringv1.PrometheusRule, len(promRules))
 		rules           = make(TypedResourcesSelection[*monitoringv1.PrometheusRule], len(promRules))
 		namespacedNames = make([]string, 0, len(promRules))
 	)
@@ -287,6 +349,7 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (PrometheusRuleSe
 			reason = InvalidConfigurationEvent
 		} else {
 			marshalRules[ruleName] = content
+			ruleSources[ruleName] = promRule
 			namespacedNames = append(namespacedNames, fmt.Sprintf("%s/%s", promRule.Namespace, promRule.Name))
 		}
 
@@ -306,8 +369,9 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (PrometheusRuleSe
 	)
 
 	return PrometheusRuleSelection{
-		selection: rules,
-		ruleFiles: marshalRules,
+		selection:   rules,
+		ruleFiles:   marshalRules,
+		ruleSources: ruleSources,
 	}, nil
 }
 
@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 )
@@ -50,6 +51,7 @@ func TestMakeRulesConfigMaps(t *testing.T) {
 	t.Run("shouldDropKeepFiringForFieldForUnsupportedPrometheusVersion", shouldDropKeepFiringForFieldForUnsupportedPrometheusVersion)
 	t.Run("shouldDropGroupLabelsForUnsupportedPrometheusVersion", shouldDropGroupLabelsForUnsupportedPrometheusVersion)
 	t.Run("shouldAcceptRuleWithGroupLabels", shouldAcceptRuleWithGroupLabels)
+	t.Run("shouldDropShardField", shouldDropShardField)
 
 	// Thanos features
 	t.Run("shouldAcceptRuleWithValidPartialResponseStrategyValue", shouldAcceptRuleWithValidPartialResponseStrategyValue)
@@ -125,6 +127,36 @@ func shouldAcceptValidRule(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func shouldDropShardField(t *testing.T) {
+	rules := &monitoringv1.PrometheusRule{
+		Spec: monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{
+			{
+				Name:  "group",
+				Shard: ptr.To(int32(1)),
+				Rules: []monitoringv1.Rule{
+					{
+						Alert: "alert",
+						Expr:  intstr.FromString("vector(1)"),
+					},
+				},
+			},
+		}},
+	}
+
+	for _, format := range []RuleConfigurationFormat{PrometheusFormat, ThanosFormat} {
+		pr := newRuleSelectorForConfigGeneration(format, semver.MustParse("3.0.0"))
+		content, err := pr.generateRulesConfiguration(rules)
+		require.NoError(t, err)
+		require.NotContains(t, content, "shard")
+	}
+
+	// The PrometheusRule resource isn't modified.
+	require.Equal(t, ptr.To(int32(1)), rules.Spec.Groups[0].Shard)
+
+	// The upstream validation ignores the shard field.
+	require.Empty(t, ValidateRule(rules.Spec, model.UTF8Validation, parser.Options{}))
+}
+
 func shouldAcceptRulesWithEmptyDurations(t *testing.T) {
 	durationPtr := func(d string) *monitoringv1.Duration {
 		v := monitoringv1.Duration(d)
@@ -830,3 +862,57 @@ func TestPrometheusRuleSync(t *testing.T) {
 		)
 	})
 }
+
+func TestRuleFilesByShard(t *testing.T) {
+	rule := &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rule",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{
+			{
+				Name:  "group-0",
+				Rules: []monitoringv1.Rule{{Alert: "alert0", Expr: intstr.FromString("vector(0)")}},
+			},
+			{
+				Name:  "group-1",
+				Shard: ptr.To(int32(1)),
+				Rules: []monitoringv1.Rule{{Alert: "alert1", Expr: intstr.FromString("vector(1)")}},
+			},
+		}},
+	}
+
+	pr := newRuleSelectorForConfigGeneration(PrometheusFormat, semver.MustParse("3.0.0"))
+	content, err := pr.generateRulesConfiguration(rule)
+	require.NoError(t, err)
+
+	prs := PrometheusRuleSelection{
+		ruleFiles:   map[string]string{"default-rule.yaml": content},
+		ruleSources: map[string]*monitoringv1.PrometheusRule{"default-rule.yaml": rule},
+	}
+
+	// Assign the groups to the pinned shard or to the shard 0.
+	shardFn := func(rule *monitoringv1.PrometheusRule, i int) int32 {
+		return ptr.Deref(rule.Spec.Groups[i].Shard, 0)
+	}
+
+	files, err := prs.RuleFilesByShard(3, shardFn)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+
+	require.Contains(t, files[0]["default-rule.yaml"], "group-0")
+	require.NotContains(t, files[0]["default-rule.yaml"], "group-1")
+	require.Contains(t, files[1]["default-rule.yaml"], "group-1")
+	require.NotContains(t, files[1]["default-rule.yaml"], "group-0")
+	require.Empty(t, files[2])
+
+	// All the groups assigned to the same shard.
+	files, err = prs.RuleFilesByShard(2, func(*monitoringv1.PrometheusRule, int) int32 { return 0 })
+	require.NoError(t, err)
+	require.Equal(t, content, files[0]["default-rule.yaml"])
+	require.Empty(t, files[1])
+
+	// Invalid shard.
+	_, err = prs.RuleFilesByShard(1, shardFn)
+	require.Error(t, err)
+}
@@ -39,6 +39,22 @@ type ShardedSecret struct {
 	template     *corev1.Secret
 	data         map[string][]byte
 	secretShards []*corev1.Secret
+
+	// unsuffixedFirstShard is true when the first Secret uses the name of
+	// the template.
+	unsuffixedFirstShard bool
+}
+
+// ShardedSecretOption configures a ShardedSecret.
+type ShardedSecretOption func(*ShardedSecret)
+
+// WithUnsuffixedFirstShard tells that the first Secret uses the name of the
+// template without index suffix. It preserves the name of a Secret which
+// existed before it was sharded.
+func WithUnsuffixedFirstShard() ShardedSecretOption {
+	return func(s *ShardedSecret) {
+		s.unsuffixedFirstShard = true
+	}
 }
 
 // updateSecrets updates the concrete Secrets from the stored data.
@@ -111,9 +127,19 @@ func (s *ShardedSecret) cleanupExcessSecretShards(ctx context.Context, sClient t
 }
 
 func (s *ShardedSecret) secretNameAt(index int) string {
+	if index == 0 && s.unsuffixedFirstShard {
+		return s.template.Name
+	}
+
 	return fmt.Sprintf("%s-%d", s.template.Name, index)
 }
 
+// Len returns the number of Secrets.
+// It must be called after UpdateSecrets().
+func (s *ShardedSecret) Len() int {
+	return len(s.secretShards)
+}
+
 // Hash implements the Hashable interface from github.com/mitchellh/hashstructure.
 func (s *ShardedSecret) Hash() (uint64, error) {
 	return uint64(len(s.secretShards)), nil
@@ -143,15 +169,35 @@ func (s *ShardedSecret) Volume(name string) corev1.Volume {
 	return volume
 }
 
-func ReconcileShardedSecret(ctx context.Context, data map[string][]byte, client kubernetes.Interface, template *corev1.Secret) (*ShardedSecret, error) {
+func ReconcileShardedSecret(ctx context.Context, data map[string][]byte, client kubernetes.Interface, template *corev1.Secret, opts ...ShardedSecretOption) (*ShardedSecret, error) {
 	shardedSecret := &ShardedSecret{
 		template: template,
 		data:     data,
 	}
+	for _, opt := range opts {
+		opt(shardedSecret)
+	}
 
 	if err := shardedSecret.updateSecrets(ctx, client.CoreV1().Secrets(template.Namespace)); err != nil {
-		return nil, fmt.Errorf("failed to update the TLS secrets: %w", err)
+		return nil, fmt.Errorf("failed to update the secrets: %w", err)
 	}
 
 	return shardedSecret, nil
 }
+
+// DeleteShardedSecret removes the Secrets created by ReconcileShardedSecret()
+// for the given template.
+func DeleteShardedSecret(ctx context.Context, client kubernetes.Interface, template *corev1.Secret, opts ...ShardedSecretOption) error {
+	shardedSecret := &ShardedSecret{
+		template: template,
+	}
+	for _, opt := range opts {
+		opt(shardedSecret)
+	}
+
+	if err := shardedSecret.cleanupExcessSecretShards(ctx, client.CoreV1().Secrets(template.Namespace), -1); err != nil {
+		return fmt.Errorf("failed to delete the secrets: %w", err)
+	}
+
+	return nil
+}
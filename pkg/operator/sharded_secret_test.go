@@ -15,10 +15,13 @@
 package operator
 
 import (
+	"context"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestShardedSecret(t *testing.T) {
@@ -87,3 +90,81 @@ func TestShardedSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteShardedSecret(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientset(
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns"}},
+	)
+	template := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "secret",
+			Namespace: "ns",
+		},
+	}
+
+	_, err := ReconcileShardedSecret(ctx, map[string][]byte{
+		"one": make([]byte, MaxSecretDataSizeBytes-3),
+		"two": []byte("data"),
+	}, client, template)
+	require.NoError(t, err)
+
+	secrets, err := client.CoreV1().Secrets("ns").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, secrets.Items, 3)
+
+	require.NoError(t, DeleteShardedSecret(ctx, client, template))
+
+	secrets, err = client.CoreV1().Secrets("ns").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, secrets.Items, 1)
+	require.Equal(t, "other", secrets.Items[0].Name)
+
+	// Deleting non-existing secrets is a no-op.
+	require.NoError(t, DeleteShardedSecret(ctx, client, template))
+}
+
+func TestUnsuffixedFirstShard(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientset()
+	template := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "secret",
+			Namespace: "ns",
+		},
+	}
+
+	s, err := ReconcileShardedSecret(ctx, map[string][]byte{
+		"one": make([]byte, MaxSecretDataSizeBytes-3),
+		"two": []byte("data"),
+	}, client, template, WithUnsuffixedFirstShard())
+	require.NoError(t, err)
+
+	var names []string
+	for _, src := range s.Volume("config").Projected.Sources {
+		names = append(names, src.Secret.Name)
+	}
+	require.Equal(t, []string{"secret", "secret-1"}, names)
+
+	for _, name := range names {
+		_, err := client.CoreV1().Secrets("ns").Get(ctx, name, metav1.GetOptions{})
+		require.NoError(t, err)
+	}
+
+	// The excess shards are removed but not the first Secret.
+	_, err = ReconcileShardedSecret(ctx, map[string][]byte{
+		"two": []byte("data"),
+	}, client, template, WithUnsuffixedFirstShard())
+	require.NoError(t, err)
+
+	secrets, err := client.CoreV1().Secrets("ns").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, secrets.Items, 1)
+	require.Equal(t, "secret", secrets.Items[0].Name)
+
+	require.NoError(t, DeleteShardedSecret(ctx, client, template, WithUnsuffixedFirstShard()))
+
+	secrets, err = client.CoreV1().Secrets("ns").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Empty(t, secrets.Items)
+}
@@ -31,6 +31,17 @@ const (
 	// DeprecatedFieldsInUseReason is used in status conditions to indicate that
 	// the resource uses deprecated fields.
 	DeprecatedFieldsInUseReason = "DeprecatedFieldsInUse"
+
+	// ConfigurationSizeNearLimitReason is used in status conditions to
+	// indicate that the generated configuration is close to or above the
+	// maximum size of a Secret.
+	ConfigurationSizeNearLimitReason = "ConfigurationSizeNearLimit"
+
+	// AlertmanagerResourcesUnsupportedReason is used in status conditions to
+	// indicate that the Alertmanager selectors and references are ignored
+	// because the operator doesn't watch Alertmanager resources.
+	AlertmanagerResourcesUnsupportedReason  = "AlertmanagerResourcesUnsupported"
+	AlertmanagerResourcesUnsupportedMessage = "the Alertmanager selectors and references are ignored because the operator can't watch Alertmanager resources (check that the Alertmanager CRD is installed and the operator has the required permissions)."
 )
 
 // StatusGetter represents a workload resource implementing the interface
@@ -23,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8s"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
@@ -34,6 +35,8 @@ func makeDaemonSet(
 	config prompkg.Config,
 	cg *prompkg.ConfigGenerator,
 	tlsSecrets *operator.ShardedSecret,
+	scrapeConfigSecrets *operator.ShardedSecret,
+	staticConfigsFrom []monitoringv1.SecretOrConfigMap,
 ) (*appsv1.DaemonSet, error) {
 	cpf := p.GetCommonPrometheusFields()
 	objMeta := p.GetObjectMeta()
@@ -46,7 +49,7 @@ func makeDaemonSet(
 	// We set some defaults if some fields are not present, and we want those fields set in the original Prometheus object before building the DaemonSetSpec.
 	p.SetCommonPrometheusFields(cpf)
 
-	spec, err := makeDaemonSetSpec(p, config, cg, tlsSecrets)
+	spec, err := makeDaemonSetSpec(p, config, cg, tlsSecrets, scrapeConfigSecrets, staticConfigsFrom)
 	if err != nil {
 		return nil, fmt.Errorf("make DaemonSet spec: %w", err)
 	}
@@ -79,6 +82,8 @@ func makeDaemonSetSpec(
 	c prompkg.Config,
 	cg *prompkg.ConfigGenerator,
 	tlsSecrets *operator.ShardedSecret,
+	scrapeConfigSecrets *operator.ShardedSecret,
+	staticConfigsFrom []monitoringv1.SecretOrConfigMap,
 ) (*appsv1.DaemonSetSpec, error) {
 	cpf := p.GetCommonPrometheusFields()
 
@@ -93,7 +98,7 @@ func makeDaemonSetSpec(
 
 	promArgs := buildAgentArgs(cg, cpf.WALCompression)
 
-	volumes, promVolumeMounts, err := prompkg.BuildCommonVolumes(p, tlsSecrets, false)
+	volumes, promVolumeMounts, err := prompkg.BuildCommonVolumes(p, prompkg.ConfigSecretName(p), tlsSecrets, staticConfigsFrom, false)
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +137,20 @@ func makeDaemonSetSpec(
 
 	var watchedDirectories []string
 
+	reloaderOpts := []operator.ReloaderOption{
+		operator.WithDaemonSetMode(),
+	}
+
+	// When the configuration is too large for a single Secret, the scrape
+	// configurations are stored in separate files which the config-reloader
+	// decompresses and writes to the config-out volume.
+	if scrapeConfigSecrets != nil {
+		vol, mount, opt := prompkg.ScrapeConfigFilesVolume(scrapeConfigSecrets)
+		volumes = append(volumes, vol)
+		configReloaderVolumeMounts = append(configReloaderVolumeMounts, mount)
+		reloaderOpts = append(reloaderOpts, opt)
+	}
+
 	operatorInitContainers = append(operatorInitContainers,
 		prompkg.BuildConfigReloader(
 			p,
@@ -139,7 +158,7 @@ func makeDaemonSetSpec(
 			true,
 			configReloaderVolumeMounts,
 			watchedDirectories,
-			operator.WithDaemonSetMode(),
+			reloaderOpts...,
 		),
 	)
 
@@ -180,8 +199,7 @@ func makeDaemonSetSpec(
 			false,
 			configReloaderVolumeMounts,
 			watchedDirectories,
-			operator.WebConfigFile(configReloaderWebConfigFile),
-			operator.WithDaemonSetMode(),
+			append(reloaderOpts, operator.WebConfigFile(configReloaderWebConfigFile))...,
 		),
 	}, additionalContainers...)
 
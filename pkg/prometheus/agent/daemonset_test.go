@@ -103,7 +103,9 @@ func makeDaemonSetFromPrometheus(p monitoringv1alpha1.PrometheusAgent) (*appsv1.
 		&p,
 		defaultTestConfig,
 		cg,
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil,
+		nil)
 }
 
 func TestPodTopologySpreadConstraintWithAdditionalLabelsForDaemonSet(t *testing.T) {
@@ -29,6 +29,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
@@ -61,6 +62,7 @@ type Operator struct {
 	kclient  kubernetes.Interface
 	mdClient metadata.Interface
 	mclient  monitoringclient.Interface
+	dclient  dynamic.Interface
 
 	logger *slog.Logger
 
@@ -71,25 +73,28 @@ type Operator struct {
 	nsPromInf cache.SharedIndexInformer
 	nsMonInf  cache.SharedIndexInformer
 
-	promInfs  *informers.ForResource
-	smonInfs  *informers.ForResource
-	pmonInfs  *informers.ForResource
-	probeInfs *informers.ForResource
-	sconInfs  *informers.ForResource
-	cmapInfs  *informers.ForResource
-	secrInfs  *informers.ForResource
-	ssetInfs  *informers.ForResource
-	dsetInfs  *informers.ForResource
+	promInfs      *informers.ForResource
+	smonInfs      *informers.ForResource
+	pmonInfs      *informers.ForResource
+	probeInfs     *informers.ForResource
+	sconInfs      *informers.ForResource
+	httpRouteInfs *informers.ForResource
+	cmapInfs      *informers.ForResource
+	secrInfs      *informers.ForResource
+	ssetInfs      *informers.ForResource
+	dsetInfs      *informers.ForResource
 
 	rr *operator.ResourceReconciler
 
 	metrics         *operator.Metrics
 	reconciliations *operator.ReconciliationTracker
+	scrapeJobCache  *prompkg.ScrapeJobCache
 
 	config prompkg.Config
 
 	endpointSliceSupported bool // Whether the Kubernetes API supports the EndpointSlice kind.
 	scrapeConfigSupported  bool
+	httpRouteSupported     bool
 	canReadStorageClass    bool
 
 	newEventRecorder operator.NewEventRecorderFunc
@@ -119,6 +124,14 @@ func WithScrapeConfig() ControllerOption {
 	}
 }
 
+// WithHTTPRoute tells that the Kubernetes API supports the Gateway API
+// HTTPRoute resource.
+func WithHTTPRoute() ControllerOption {
+	return func(o *Operator) {
+		o.httpRouteSupported = true
+	}
+}
+
 // WithStorageClassValidation tells that the controller should verify that the
 // Prometheus spec references a valid StorageClass name.
 func WithStorageClassValidation() ControllerOption {
@@ -154,6 +167,11 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
 	}
 
+	dclient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating dynamic client failed: %w", err)
+	}
+
 	// All the metrics exposed by the controller get the controller="prometheus-agent" label.
 	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "prometheus-agent"}, r)
 
@@ -161,6 +179,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		kclient:  client,
 		mdClient: mdClient,
 		mclient:  mclient,
+		dclient:  dclient,
 		logger:   logger,
 		config: prompkg.Config{
 			LocalHost:                  c.LocalHost,
@@ -172,6 +191,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		},
 		metrics:                      operator.NewMetrics(r),
 		reconciliations:              &operator.ReconciliationTracker{},
+		scrapeJobCache:               prompkg.NewScrapeJobCache(),
 		controllerID:                 c.ControllerID,
 		newEventRecorder:             c.EventRecorderFactory(client, controllerName),
 		configResourcesStatusEnabled: c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature),
@@ -279,6 +299,22 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		}
 	}
 
+	if o.httpRouteSupported {
+		o.httpRouteInfs, err = informers.NewInformersForResource(
+			informers.NewDynamicInformerFactory(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				dclient,
+				resyncPeriod,
+				nil,
+			),
+			prompkg.HTTPRouteGroupVersionResource,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating httproutes informers: %w", err)
+		}
+	}
+
 	allowList := c.Namespaces.PrometheusAllowList
 	if c.WatchObjectRefsInAllNamespaces {
 		allowList = operator.MergeAllowLists(
@@ -423,6 +459,9 @@ func (c *Operator) Run(ctx context.Context) error {
 	if c.scrapeConfigSupported {
 		go c.sconInfs.Start(ctx.Done())
 	}
+	if c.httpRouteSupported {
+		go c.httpRouteInfs.Start(ctx.Done())
+	}
 	go c.cmapInfs.Start(ctx.Done())
 	go c.secrInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
@@ -477,6 +516,7 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"PodMonitor", c.pmonInfs},
 		{"Probe", c.probeInfs},
 		{"ScrapeConfig", c.sconInfs},
+		{"HTTPRoute", c.httpRouteInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
@@ -579,6 +619,22 @@ func (c *Operator) addHandlers() {
 		))
 	}
 
+	if c.httpRouteInfs != nil {
+		c.httpRouteInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			"HTTPRoute",
+			c.enqueueForMonitorNamespace,
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
 	hasRefFunc := operator.HasReferenceFunc(
 		c.promInfs,
 		c.reconciliations,
@@ -630,6 +686,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if p == nil {
 		c.reconciliations.ForgetObject(key)
+		c.scrapeJobCache.Forget(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
@@ -651,6 +708,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	// Check if the Agent instance is marked for deletion.
 	if c.rr.DeletionInProgress(p) {
 		c.reconciliations.ForgetObject(key)
+		c.scrapeJobCache.Forget(key)
 		return nil
 	}
 
@@ -663,10 +721,14 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return fmt.Errorf("feature gate for Prometheus Agent's DaemonSet mode is not enabled")
 	}
 
+	if ss := p.Spec.ShardingStrategy; prompkg.IsResourceShardingMode(ss) {
+		return fmt.Errorf("sharding mode %q isn't supported by Prometheus Agent", *ss.Mode)
+	}
+
 	// Generate the configuration data.
 	var (
 		assetStore = assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
-		opts       = []prompkg.ConfigGeneratorOption{}
+		opts       = []prompkg.ConfigGeneratorOption{prompkg.WithScrapeJobCache(c.scrapeJobCache)}
 	)
 	if c.endpointSliceSupported {
 		opts = append(opts, prompkg.WithEndpointSliceSupport())
@@ -683,7 +745,8 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return err
 	}
 
-	if err := c.createOrUpdateConfigurationSecret(ctx, logger, p, cg, assetStore); err != nil {
+	scrapeConfigSecrets, staticConfigsFrom, err := c.createOrUpdateConfigurationSecret(ctx, logger, key, p, cg, assetStore)
+	if err != nil {
 		return fmt.Errorf("creating config failed: %w", err)
 	}
 	c.reconciliations.UpdateReferenceTracker(key, assetStore.RefTracker())
@@ -699,19 +762,19 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	switch ptr.Deref(p.Spec.Mode, "") {
 	case monitoringv1alpha1.DaemonSetPrometheusAgentMode:
-		err = c.syncDaemonSet(ctx, key, p, cg, tlsAssets)
+		err = c.syncDaemonSet(ctx, key, p, cg, tlsAssets, scrapeConfigSecrets, staticConfigsFrom)
 	default:
 		if err := operator.CheckStorageClass(ctx, c.canReadStorageClass, c.kclient, p.Spec.Storage); err != nil {
 			return err
 		}
 
-		err = c.syncStatefulSet(ctx, key, p, cg, tlsAssets)
+		err = c.syncStatefulSet(ctx, key, p, cg, tlsAssets, scrapeConfigSecrets, staticConfigsFrom)
 	}
 
 	return err
 }
 
-func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, tlsAssets *operator.ShardedSecret) error {
+func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, tlsAssets, scrapeConfigSecrets *operator.ShardedSecret, staticConfigsFrom []monitoringv1.SecretOrConfigMap) error {
 	logger := c.logger.With("key", key)
 
 	dsetClient := c.kclient.AppsV1().DaemonSets(p.Namespace)
@@ -728,7 +791,9 @@ func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv
 		p,
 		c.config,
 		cg,
-		tlsAssets)
+		tlsAssets,
+		scrapeConfigSecrets,
+		staticConfigsFrom)
 	if err != nil {
 		return fmt.Errorf("making daemonset failed: %w", err)
 	}
@@ -769,7 +834,7 @@ func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv
 	return nil
 }
 
-func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, tlsAssets *operator.ShardedSecret) error {
+func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, tlsAssets, scrapeConfigSecrets *operator.ShardedSecret, staticConfigsFrom []monitoringv1.SecretOrConfigMap) error {
 	logger := c.logger.With("key", key)
 
 	if p.Spec.ServiceName != nil {
@@ -826,7 +891,7 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 			}
 		}
 
-		newSSetInputHash, err := createSSetInputHash(*p, c.config, tlsAssets, existingStatefulSet.Spec)
+		newSSetInputHash, err := createSSetInputHash(*p, c.config, tlsAssets, scrapeConfigSecrets, staticConfigsFrom, existingStatefulSet.Spec)
 		if err != nil {
 			return err
 		}
@@ -838,7 +903,9 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 			cg,
 			newSSetInputHash,
 			int32(shard),
-			tlsAssets)
+			tlsAssets,
+			scrapeConfigSecrets,
+			staticConfigsFrom)
 		if err != nil {
 			return fmt.Errorf("making statefulset failed: %w", err)
 		}
@@ -905,32 +972,49 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 	return nil
 }
 
-func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, store *assets.StoreBuilder) error {
-	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p))
+// createOrUpdateConfigurationSecret generates the Prometheus Agent
+// configuration and stores it into Secrets. It returns the Secrets holding
+// the scrape configuration files when the configuration is too large for a
+// single Secret.
+func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, key string, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, store *assets.StoreBuilder) (*operator.ShardedSecret, []monitoringv1.SecretOrConfigMap, error) {
+	var rsOpts []prompkg.ResourceSelectorOption
+	if c.httpRouteInfs != nil {
+		rsOpts = append(rsOpts, prompkg.WithHTTPRouteSupport())
+	}
+
+	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p), rsOpts...)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	smons, err := resourceSelector.SelectServiceMonitors(ctx, c.smonInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting ServiceMonitors failed: %w", err)
+		return nil, nil, fmt.Errorf("selecting ServiceMonitors failed: %w", err)
 	}
 
 	pmons, err := resourceSelector.SelectPodMonitors(ctx, c.pmonInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting PodMonitors failed: %w", err)
+		return nil, nil, fmt.Errorf("selecting PodMonitors failed: %w", err)
 	}
 
 	bmons, err := resourceSelector.SelectProbes(ctx, c.probeInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting Probes failed: %w", err)
+		return nil, nil, fmt.Errorf("selecting Probes failed: %w", err)
+	}
+
+	if c.httpRouteInfs != nil {
+		probeHTTPRoutes, err := resourceSelector.SelectProbeHTTPRoutes(bmons, c.httpRouteInfs.ListAllByNamespace)
+		if err != nil {
+			return nil, nil, fmt.Errorf("selecting HTTPRoutes failed: %w", err)
+		}
+		prompkg.WithProbeHTTPRoutes(probeHTTPRoutes)(cg)
 	}
 
 	var scrapeConfigs operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
 	if c.sconInfs != nil {
 		scrapeConfigs, err = resourceSelector.SelectScrapeConfigs(ctx, c.sconInfs.ListAllByNamespace)
 		if err != nil {
-			return fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+			return nil, nil, fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
 		}
 	}
 
@@ -939,21 +1023,21 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 	}
 
 	if err := cg.AddRemoteWriteToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if err := prompkg.AddAPIServerConfigToStore(ctx, store, p.GetNamespace(), p.Spec.APIServerConfig); err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if err := prompkg.AddScrapeClassesToStore(ctx, store, p.GetNamespace(), p.Spec.ScrapeClasses); err != nil {
-		return fmt.Errorf("failed to process scrape classes: %w", err)
+		return nil, nil, fmt.Errorf("failed to process scrape classes: %w", err)
 	}
 
 	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 	additionalScrapeConfigs, err := k8s.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+		return nil, nil, fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
 	}
 
 	// Update secret based on the most recent configuration.
@@ -966,20 +1050,54 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		additionalScrapeConfigs,
 	)
 	if err != nil {
-		return fmt.Errorf("generating config failed: %w", err)
+		return nil, nil, fmt.Errorf("generating config failed: %w", err)
+	}
+
+	workloads, err := c.configurationWorkloads(key, p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filesExist, err := prompkg.ScrapeConfigFilesSecretsExist(c.secrInfs, p, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scrapeConfigSecrets, err := prompkg.ReconcileConfigurationSecrets(ctx, logger, c.kclient, c.reconciliations, p, c.config, cg, 0, conf, workloads, filesExist)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Compress config to avoid 1mb secret limit for a while
-	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
+	return scrapeConfigSecrets, prompkg.StaticConfigsFrom(scrapeConfigs.ValidResources()), nil
+}
+
+// configurationWorkloads returns the state of the existing StatefulSets or
+// DaemonSet which load the configuration.
+func (c *Operator) configurationWorkloads(key string, p *monitoringv1alpha1.PrometheusAgent) ([]prompkg.WorkloadState, error) {
+	if ptr.Deref(p.Spec.Mode, "") == monitoringv1alpha1.DaemonSetPrometheusAgentMode {
+		obj, err := c.dsetInfs.Get(keyToDaemonSetKey(p, key))
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("retrieving daemonset failed: %w", err)
+		}
+
+		return []prompkg.WorkloadState{prompkg.DaemonSetState(obj.(*appsv1.DaemonSet))}, nil
+	}
+
+	var workloads []prompkg.WorkloadState
+	err := c.ssetInfs.ListAllByNamespace(p.Namespace, labels.SelectorFromSet(labels.Set{prompkg.PrometheusNameLabelName: p.Name, prompkg.PrometheusModeLabelName: prometheusMode}), func(obj any) {
+		workloads = append(workloads, prompkg.StatefulSetState(obj.(*appsv1.StatefulSet)))
+	})
 	if err != nil {
-		return fmt.Errorf("creating compressed secret failed: %w", err)
+		return nil, fmt.Errorf("listing StatefulSet resources failed: %w", err)
 	}
 
-	logger.Debug("updating Prometheus configuration secret")
-	return k8s.CreateOrUpdateSecret(ctx, sClient, s)
+	return workloads, nil
 }
 
-func createSSetInputHash(p monitoringv1alpha1.PrometheusAgent, c prompkg.Config, tlsAssets *operator.ShardedSecret, ssSpec appsv1.StatefulSetSpec) (string, error) {
+func createSSetInputHash(p monitoringv1alpha1.PrometheusAgent, c prompkg.Config, tlsAssets, scrapeConfigSecrets *operator.ShardedSecret, staticConfigsFrom []monitoringv1.SecretOrConfigMap, ssSpec appsv1.StatefulSetSpec) (string, error) {
 	var http2 *bool
 	if p.Spec.Web != nil && p.Spec.Web.HTTPConfig != nil {
 		http2 = p.Spec.Web.HTTPConfig.HTTP2
@@ -998,6 +1116,8 @@ func createSSetInputHash(p monitoringv1alpha1.PrometheusAgent, c prompkg.Config,
 		Config                prompkg.Config
 		StatefulSetSpec       appsv1.StatefulSetSpec
 		ShardedSecret         *operator.ShardedSecret
+		ScrapeConfigSecrets   *operator.ShardedSecret
+		StaticConfigsFrom     []monitoringv1.SecretOrConfigMap
 	}{
 		PrometheusLabels:      p.Labels,
 		PrometheusAnnotations: p.Annotations,
@@ -1006,6 +1126,8 @@ func createSSetInputHash(p monitoringv1alpha1.PrometheusAgent, c prompkg.Config,
 		Config:                c,
 		StatefulSetSpec:       ssSpec,
 		ShardedSecret:         tlsAssets,
+		ScrapeConfigSecrets:   scrapeConfigSecrets,
+		StaticConfigsFrom:     staticConfigsFrom,
 	},
 		nil,
 	)
@@ -1209,12 +1331,22 @@ func (c *Operator) handleMonitorNamespaceUpdate(oldo, curo any) {
 	err := c.promInfs.ListAll(labels.Everything(), func(obj any) {
 		p := obj.(*monitoringv1alpha1.PrometheusAgent)
 
-		for name, selector := range map[string]*metav1.LabelSelector{
+		selectors := map[string]*metav1.LabelSelector{
 			"PodMonitors":     p.Spec.PodMonitorNamespaceSelector,
 			"Probes":          p.Spec.ProbeNamespaceSelector,
 			"ScrapeConfigs":   p.Spec.ScrapeConfigNamespaceSelector,
 			"ServiceMonitors": p.Spec.ServiceMonitorNamespaceSelector,
-		} {
+		}
+
+		// The namespace labels also determine the scrape class of the
+		// resources.
+		for _, sc := range p.Spec.ScrapeClasses {
+			if sc.NamespaceSelector != nil {
+				selectors["ScrapeClass/"+sc.Name] = sc.NamespaceSelector
+			}
+		}
+
+		for name, selector := range selectors {
 
 			sync, err := k8s.LabelSelectionHasChanged(old.Labels, cur.Labels, selector)
 			if err != nil {
@@ -43,6 +43,8 @@ func makeStatefulSet(
 	inputHash string,
 	shard int32,
 	tlsSecrets *operator.ShardedSecret,
+	scrapeConfigSecrets *operator.ShardedSecret,
+	staticConfigsFrom []monitoringv1.SecretOrConfigMap,
 ) (*appsv1.StatefulSet, error) {
 	cpf := p.GetCommonPrometheusFields()
 	objMeta := p.GetObjectMeta()
@@ -56,7 +58,7 @@ func makeStatefulSet(
 	// We need to re-set the common fields because cpf is only a copy of the original object.
 	// We set some defaults if some fields are not present, and we want those fields set in the original Prometheus object before building the StatefulSetSpec.
 	p.SetCommonPrometheusFields(cpf)
-	spec, err := makeStatefulSetSpec(p, config, cg, shard, tlsSecrets)
+	spec, err := makeStatefulSetSpec(p, config, cg, shard, tlsSecrets, scrapeConfigSecrets, staticConfigsFrom)
 	if err != nil {
 		return nil, fmt.Errorf("make StatefulSet spec: %w", err)
 	}
@@ -139,6 +141,8 @@ func makeStatefulSetSpec(
 	cg *prompkg.ConfigGenerator,
 	shard int32,
 	tlsSecrets *operator.ShardedSecret,
+	scrapeConfigSecrets *operator.ShardedSecret,
+	staticConfigsFrom []monitoringv1.SecretOrConfigMap,
 ) (*appsv1.StatefulSetSpec, error) {
 	cpf := p.GetCommonPrometheusFields()
 
@@ -153,7 +157,7 @@ func makeStatefulSetSpec(
 
 	promArgs := buildAgentArgs(cg, cpf.WALCompression)
 
-	volumes, promVolumeMounts, err := prompkg.BuildCommonVolumes(p, tlsSecrets, true)
+	volumes, promVolumeMounts, err := prompkg.BuildCommonVolumes(p, prompkg.ConfigSecretName(p), tlsSecrets, staticConfigsFrom, true)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +218,16 @@ func makeStatefulSetSpec(
 	if topologyZone != "" {
 		reloaderOpts = append(reloaderOpts, operator.InzoneShard(ptr.To(cg.InzoneShardForShard(shard))))
 	}
+
+	// When the configuration is too large for a single Secret, the scrape
+	// configurations are stored in separate files which the config-reloader
+	// decompresses and writes to the config-out volume.
+	if scrapeConfigSecrets != nil {
+		vol, mount, opt := prompkg.ScrapeConfigFilesVolume(scrapeConfigSecrets)
+		volumes = append(volumes, vol)
+		configReloaderVolumeMounts = append(configReloaderVolumeMounts, mount)
+		reloaderOpts = append(reloaderOpts, opt)
+	}
 	operatorInitContainers = append(operatorInitContainers,
 		prompkg.BuildConfigReloader(
 			p,
@@ -15,6 +15,7 @@
 package prometheusagent
 
 import (
+	"context"
 	"slices"
 	"strings"
 	"testing"
@@ -25,6 +26,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -138,7 +140,9 @@ func makeStatefulSetFromPrometheus(p monitoringv1alpha1.PrometheusAgent) (*appsv
 		cg,
 		"abc",
 		0,
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil,
+		nil)
 }
 
 func TestPodTopologySpreadConstraintWithAdditionalLabels(t *testing.T) {
@@ -566,6 +570,8 @@ func TestConfigReloaderTopologyZoneEnvVar(t *testing.T) {
 				"",
 				tc.shardIndex,
 				&operator.ShardedSecret{},
+				nil,
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -597,3 +603,55 @@ func TestConfigReloaderTopologyZoneEnvVar(t *testing.T) {
 		})
 	}
 }
+
+func TestScrapeConfigFiles(t *testing.T) {
+	p := monitoringv1alpha1.PrometheusAgent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+	}
+
+	cg, err := prompkg.NewConfigGenerator(prompkg.NewLogger(), &p)
+	require.NoError(t, err)
+
+	scrapeConfigSecrets, err := operator.ReconcileShardedSecret(
+		context.Background(),
+		map[string][]byte{"scrape-configs-0000.yaml": []byte("data")},
+		fake.NewClientset(),
+		prompkg.NewScrapeConfigFilesSecret(&p, defaultTestConfig, 0),
+	)
+	require.NoError(t, err)
+
+	sset, err := makeStatefulSet("test", &p, defaultTestConfig, cg, "", 0, &operator.ShardedSecret{}, scrapeConfigSecrets, nil)
+	require.NoError(t, err)
+
+	dset, err := makeDaemonSet(&p, defaultTestConfig, cg, &operator.ShardedSecret{}, scrapeConfigSecrets, nil)
+	require.NoError(t, err)
+
+	for _, spec := range []corev1.PodSpec{sset.Spec.Template.Spec, dset.Spec.Template.Spec} {
+		require.Contains(t, spec.Volumes, corev1.Volume{
+			Name: "scrape-config-files",
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: prompkg.ScrapeConfigFilesSecretName(&p, 0) + "-0"}}},
+					},
+				},
+			},
+		})
+
+		var reloaders int
+		for _, c := range append(spec.InitContainers, spec.Containers...) {
+			if c.Name != "config-reloader" && c.Name != "init-config-reloader" {
+				continue
+			}
+
+			reloaders++
+			require.Contains(t, c.VolumeMounts, corev1.VolumeMount{Name: "scrape-config-files", MountPath: "/etc/prometheus/scrape_config_files"})
+			require.Contains(t, c.Args, "--config-dir=/etc/prometheus/scrape_config_files")
+			require.Contains(t, c.Args, "--config-dir-output=/etc/prometheus/config_out/scrape_config_files")
+		}
+		require.Equal(t, 2, reloaders)
+	}
+}
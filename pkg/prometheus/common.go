@@ -48,6 +48,7 @@ const (
 	ConfigFilename         = "prometheus.yaml.gz"
 	ConfigEnvsubstFilename = "prometheus.env.yaml"
 	DefaultPortName        = "web"
+	DefaultWebPort         = 9090
 	DefaultLogFileVolume   = "log-file"
 	DefaultLogDirectory    = "/var/log/prometheus"
 
@@ -141,6 +142,12 @@ func compress(data []byte) ([]byte, error) {
 }
 
 func MakeConfigurationSecret(p monitoringv1.PrometheusInterface, config Config, data []byte) (*corev1.Secret, error) {
+	return MakeConfigurationSecretForShard(p, config, 0, data)
+}
+
+// MakeConfigurationSecretForShard returns the configuration secret of the
+// given shard.
+func MakeConfigurationSecretForShard(p monitoringv1.PrometheusInterface, config Config, shard int32, data []byte) (*corev1.Secret, error) {
 	promConfig, err := compress(data)
 	if err != nil {
 		return nil, err
@@ -157,7 +164,7 @@ func MakeConfigurationSecret(p monitoringv1.PrometheusInterface, config Config,
 		operator.WithLabels(config.Labels),
 		operator.WithAnnotations(config.Annotations),
 		operator.WithManagingOwner(p),
-		operator.WithName(ConfigSecretName(p)),
+		operator.WithName(ConfigSecretNameForShard(p, shard)),
 	)
 
 	return s, nil
@@ -167,6 +174,21 @@ func ConfigSecretName(p monitoringv1.PrometheusInterface) string {
 	return PrefixedName(p)
 }
 
+// ConfigSecretNameForShard returns the name of the configuration secret for
+// the given shard. It is only relevant when the monitoring resources are
+// assigned to shards, otherwise all shards share the same configuration
+// secret.
+func ConfigSecretNameForShard(p monitoringv1.PrometheusInterface, shard int32) string {
+	return prometheusNameByShard(p, shard)
+}
+
+// PrefixedNameForShard returns the prefixed name of the resource for the given
+// shard (e.g. "prometheus-<name>" for shard 0 and "prometheus-<name>-shard-1"
+// for shard 1).
+func PrefixedNameForShard(p monitoringv1.PrometheusInterface, shard int32) string {
+	return prometheusNameByShard(p, shard)
+}
+
 func TLSAssetsSecretName(p monitoringv1.PrometheusInterface) string {
 	return fmt.Sprintf("%s-tls-assets", PrefixedName(p))
 }
@@ -217,7 +239,7 @@ func logFilePath(logFile string) string {
 }
 
 // BuildCommonVolumes returns a set of volumes to be mounted on the spec that are common between Prometheus Server and Agent.
-func BuildCommonVolumes(p monitoringv1.PrometheusInterface, tlsSecrets *operator.ShardedSecret, statefulSet bool) ([]corev1.Volume, []corev1.VolumeMount, error) {
+func BuildCommonVolumes(p monitoringv1.PrometheusInterface, configSecretName string, tlsSecrets *operator.ShardedSecret, staticConfigsFrom []monitoringv1.SecretOrConfigMap, statefulSet bool) ([]corev1.Volume, []corev1.VolumeMount, error) {
 	cpf := p.GetCommonPrometheusFields()
 
 	volumes := []corev1.Volume{
@@ -225,7 +247,7 @@ func BuildCommonVolumes(p monitoringv1.PrometheusInterface, tlsSecrets *operator
 			Name: "config",
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
-					SecretName: ConfigSecretName(p),
+					SecretName: configSecretName,
 				},
 			},
 		},
@@ -312,6 +334,14 @@ func BuildCommonVolumes(p monitoringv1.PrometheusInterface, tlsSecrets *operator
 		})
 	}
 
+	// Mount the static configs of the ScrapeConfig resources
+	staticConfigsVolumes, staticConfigsMounts, err := buildStaticConfigsFromVolumes(staticConfigsFrom)
+	if err != nil {
+		return nil, nil, err
+	}
+	volumes = append(volumes, staticConfigsVolumes...)
+	promVolumeMounts = append(promVolumeMounts, staticConfigsMounts...)
+
 	// scrape failure log file
 	if cpf.ScrapeFailureLogFile != nil && UsesDefaultFileVolume(*cpf.ScrapeFailureLogFile) {
 		volumes = append(volumes, corev1.Volume{
@@ -443,7 +473,7 @@ func MakeContainerPorts(cpf monitoringv1.CommonPrometheusFields) []corev1.Contai
 	return []corev1.ContainerPort{
 		{
 			Name:          cpf.PortName,
-			ContainerPort: 9090,
+			ContainerPort: DefaultWebPort,
 			Protocol:      corev1.ProtocolTCP,
 		},
 	}
@@ -493,7 +523,7 @@ func BuildStatefulSetService(name string, selector map[string]string, p monitori
 			Ports: []corev1.ServicePort{
 				{
 					Name:       portName,
-					Port:       9090,
+					Port:       DefaultWebPort,
 					TargetPort: intstr.FromString(portName),
 				},
 			},
@@ -0,0 +1,231 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"strconv"
+
+	"github.com/prometheus/prometheus/promql/parser"
+	"gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+// FederationEndpoint describes how to federate series from the pods of a
+// Prometheus resource.
+type FederationEndpoint struct {
+	// Namespace and Name identify the Prometheus resource.
+	Namespace string
+	Name      string
+
+	Scheme     monitoringv1.Scheme
+	PathPrefix string
+
+	// Addresses contains the stable network addresses of the pods for all
+	// the shards (`<pod>.<service>.<namespace>.svc:<port>`).
+	Addresses []string
+}
+
+// NewFederationEndpoint returns the federation endpoint of the given
+// Prometheus resource. The serviceName argument is the name of the governing
+// service used when the resource doesn't define a custom service.
+func NewFederationEndpoint(p *monitoringv1.Prometheus, serviceName string) FederationEndpoint {
+	cpf := p.GetCommonPrometheusFields()
+
+	ep := FederationEndpoint{
+		Namespace:  p.Namespace,
+		Name:       p.Name,
+		Scheme:     monitoringv1.SchemeHTTP,
+		PathPrefix: cpf.WebRoutePrefix(),
+	}
+
+	if cpf.Web != nil && cpf.Web.TLSConfig != nil {
+		ep.Scheme = monitoringv1.SchemeHTTPS
+	}
+
+	svc := ptr.Deref(cpf.ServiceName, serviceName)
+	port := webPort(cpf)
+	replicas := *ReplicasNumberPtr(p)
+	for _, ssetName := range ExpectedStatefulSetShardNames(p) {
+		for i := range replicas {
+			ep.Addresses = append(ep.Addresses, net.JoinHostPort(
+				fmt.Sprintf("%s-%d.%s.%s.svc", ssetName, i, svc, p.Namespace),
+				port,
+			))
+		}
+	}
+
+	return ep
+}
+
+// webPort returns the port on which the web server of the Prometheus pods
+// listens. It is the default port unless the listen address is overridden by
+// the additional arguments.
+func webPort(cpf monitoringv1.CommonPrometheusFields) string {
+	for _, arg := range cpf.AdditionalArgs {
+		if arg.Name != "web.listen-address" {
+			continue
+		}
+
+		if _, port, err := net.SplitHostPort(arg.Value); err == nil && port != "" {
+			return port
+		}
+	}
+
+	return strconv.Itoa(DefaultWebPort)
+}
+
+// FederationSourceKey returns the namespace/name key of the Prometheus
+// resource referenced by the federation source.
+func FederationSourceKey(namespace string, src monitoringv1.FederationSource) string {
+	return ptr.Deref(src.Namespace, namespace) + "/" + src.Name
+}
+
+// ValidateFederationSource checks that the match selectors of the
+// federation source are valid series selectors.
+func ValidateFederationSource(src monitoringv1.FederationSource) error {
+	p := parser.NewParser(parser.Options{})
+	for _, m := range src.Match {
+		if _, err := p.ParseMetricSelector(m); err != nil {
+			return fmt.Errorf("invalid match selector %q: %w", m, err)
+		}
+	}
+
+	return nil
+}
+
+// AddFederationSourcesToStore loads the assets referenced by the federation
+// sources into the store.
+func AddFederationSourcesToStore(ctx context.Context, store *assets.StoreBuilder, namespace string, srcs []monitoringv1.FederationSource) error {
+	for i, src := range srcs {
+		if err := store.AddSafeTLSConfig(ctx, namespace, src.TLSConfig); err != nil {
+			return fmt.Errorf("federationSources[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// appendFederationConfigs appends one scrape configuration per federation
+// source which resolves to a Prometheus resource.
+func (cg *ConfigGenerator) appendFederationConfigs(
+	scrapeConfigs []yaml.MapSlice,
+	srcs []monitoringv1.FederationSource,
+	store *assets.StoreBuilder,
+	shards int32,
+	jobs *scrapeJobSession,
+) []yaml.MapSlice {
+	// With resource sharding, the federation jobs are only assigned to the
+	// first shard.
+	if cg.IsResourceShardingActive() && ptr.Deref(cg.shard, 0) != 0 {
+		return scrapeConfigs
+	}
+
+	namespace := cg.prom.GetObjectMeta().GetNamespace()
+
+	for i, src := range srcs {
+		key := FederationSourceKey(namespace, src)
+		ep, found := cg.federationEndpoints[key]
+		if !found {
+			cg.logger.Debug("skipping federation source without endpoint", "prometheus", key)
+			continue
+		}
+
+		// Another source may resolve to the same endpoint hence the
+		// validation of the source itself.
+		if err := ValidateFederationSource(src); err != nil {
+			cg.logger.Warn("skipping invalid federation source", "prometheus", key, "err", err)
+			continue
+		}
+
+		scrapeConfigs = append(scrapeConfigs,
+			jobs.mustJob(
+				fmt.Sprintf("federate/%s/%s/%d", ep.Namespace, ep.Name, i),
+				// The federation sources are part of the Prometheus resource
+				// which isn't covered by the session's fingerprint.
+				metav1.ObjectMeta{},
+				struct {
+					Source   monitoringv1.FederationSource
+					Endpoint FederationEndpoint
+				}{
+					Source:   src,
+					Endpoint: ep,
+				},
+				store,
+				func(store *assets.StoreBuilder) yaml.MapSlice {
+					return cg.generateFederationConfig(i, src, ep, store.ForNamespace(namespace), shards)
+				},
+			),
+		)
+	}
+
+	return scrapeConfigs
+}
+
+func (cg *ConfigGenerator) generateFederationConfig(
+	i int,
+	src monitoringv1.FederationSource,
+	ep FederationEndpoint,
+	s assets.StoreGetter,
+	shards int32,
+) yaml.MapSlice {
+	cfg := yaml.MapSlice{
+		{
+			Key:   "job_name",
+			Value: fmt.Sprintf("federate/%s/%s/%d", ep.Namespace, ep.Name, i),
+		},
+	}
+	cfg = cg.AddHonorLabels(cfg, true)
+
+	if src.ScrapeInterval != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: *src.ScrapeInterval})
+	}
+
+	if src.ScrapeTimeout != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: *src.ScrapeTimeout})
+	}
+
+	cfg = append(cfg,
+		yaml.MapItem{Key: "metrics_path", Value: path.Join(ep.PathPrefix, "/federate")},
+		yaml.MapItem{Key: "params", Value: yaml.MapSlice{{Key: "match[]", Value: src.Match}}},
+		yaml.MapItem{Key: "scheme", Value: ep.Scheme.String()},
+	)
+
+	cfg = cg.addSafeTLStoYaml(cfg, s, src.TLSConfig)
+
+	cfg = append(cfg, yaml.MapItem{
+		Key: "static_configs",
+		Value: []yaml.MapSlice{
+			{
+				{Key: "targets", Value: ep.Addresses},
+			},
+		},
+	})
+
+	relabelings := initRelabelings()
+	if !cg.IsResourceShardingActive() {
+		relabelings = cg.appendShardingRelabelingWithAddress(relabelings, shards)
+	}
+	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
+
+	return cfg
+}
@@ -0,0 +1,154 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestNewFederationEndpoint(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		cpf      monitoringv1.CommonPrometheusFields
+		expected FederationEndpoint
+	}{
+		{
+			name: "default",
+			expected: FederationEndpoint{
+				Namespace:  "ns",
+				Name:       "zone-a",
+				Scheme:     monitoringv1.SchemeHTTP,
+				PathPrefix: "/",
+				Addresses:  []string{"prometheus-zone-a-0.prometheus-operated.ns.svc:9090"},
+			},
+		},
+		{
+			name: "shards and replicas",
+			cpf: monitoringv1.CommonPrometheusFields{
+				Shards:   ptr.To(int32(2)),
+				Replicas: ptr.To(int32(2)),
+			},
+			expected: FederationEndpoint{
+				Namespace:  "ns",
+				Name:       "zone-a",
+				Scheme:     monitoringv1.SchemeHTTP,
+				PathPrefix: "/",
+				Addresses: []string{
+					"prometheus-zone-a-0.prometheus-operated.ns.svc:9090",
+					"prometheus-zone-a-1.prometheus-operated.ns.svc:9090",
+					"prometheus-zone-a-shard-1-0.prometheus-operated.ns.svc:9090",
+					"prometheus-zone-a-shard-1-1.prometheus-operated.ns.svc:9090",
+				},
+			},
+		},
+		{
+			name: "custom listen address",
+			cpf: monitoringv1.CommonPrometheusFields{
+				AdditionalArgs: []monitoringv1.Argument{
+					{Name: "web.listen-address", Value: ":8080"},
+				},
+			},
+			expected: FederationEndpoint{
+				Namespace:  "ns",
+				Name:       "zone-a",
+				Scheme:     monitoringv1.SchemeHTTP,
+				PathPrefix: "/",
+				Addresses:  []string{"prometheus-zone-a-0.prometheus-operated.ns.svc:8080"},
+			},
+		},
+		{
+			name: "zero replicas",
+			cpf: monitoringv1.CommonPrometheusFields{
+				Replicas: ptr.To(int32(0)),
+			},
+			expected: FederationEndpoint{
+				Namespace:  "ns",
+				Name:       "zone-a",
+				Scheme:     monitoringv1.SchemeHTTP,
+				PathPrefix: "/",
+			},
+		},
+		{
+			name: "custom service, route prefix and web TLS",
+			cpf: monitoringv1.CommonPrometheusFields{
+				ServiceName: ptr.To("prometheus-zone-a"),
+				RoutePrefix: "/prometheus",
+				Web: &monitoringv1.PrometheusWebSpec{
+					WebConfigFileFields: monitoringv1.WebConfigFileFields{
+						TLSConfig: &monitoringv1.WebTLSConfig{},
+					},
+				},
+			},
+			expected: FederationEndpoint{
+				Namespace:  "ns",
+				Name:       "zone-a",
+				Scheme:     monitoringv1.SchemeHTTPS,
+				PathPrefix: "/prometheus",
+				Addresses:  []string{"prometheus-zone-a-0.prometheus-zone-a.ns.svc:9090"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "zone-a",
+					Namespace: "ns",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: tc.cpf,
+				},
+			}
+
+			require.Equal(t, tc.expected, NewFederationEndpoint(p, "prometheus-operated"))
+		})
+	}
+}
+
+func TestValidateFederationSource(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		match []string
+		err   bool
+	}{
+		{
+			name:  "valid selectors",
+			match: []string{`{__name__=~"job:.*"}`, `up{job="prometheus"}`},
+		},
+		{
+			name:  "invalid selector",
+			match: []string{`{__name__=~"job:.*"}`, `sum(up)`},
+			err:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateFederationSource(monitoringv1.FederationSource{
+				Name:  "zone-a",
+				Match: tc.match,
+			})
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
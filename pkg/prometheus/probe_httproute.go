@@ -0,0 +1,174 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// HTTPRouteGroupVersionResource is the resource of the Gateway API HTTPRoute
+// objects which can be selected by the Probe resources.
+var HTTPRouteGroupVersionResource = schema.GroupVersionResource{
+	Group:    "gateway.networking.k8s.io",
+	Version:  "v1",
+	Resource: "httproutes",
+}
+
+// HTTPRouteTarget represents the URLs to probe for a given HTTPRoute object.
+type HTTPRouteTarget struct {
+	Namespace string
+	Name      string
+	URLs      []string
+}
+
+// SelectProbeHTTPRoutes returns the HTTPRoute targets of the valid Probe
+// resources which select HTTPRoute objects. The result is indexed by the
+// Probe's namespace and name.
+func (rs *ResourceSelector) SelectProbeHTTPRoutes(probes operator.TypedResourcesSelection[*monitoringv1.Probe], listFn ListAllByNamespaceFn) (map[string][]HTTPRouteTarget, error) {
+	res := make(map[string][]HTTPRouteTarget)
+
+	for key, probe := range probes.ValidResources() {
+		if !selectsHTTPRoutes(probe) {
+			continue
+		}
+
+		targets := probe.Spec.Targets
+		selector, err := metav1.LabelSelectorAsSelector(&targets.HTTPRoute.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("probe %s: invalid HTTPRoute selector: %w", key, err)
+		}
+
+		namespaces := getNamespacesFromNamespaceSelector(rs.p, targets.HTTPRoute.NamespaceSelector, probe.Namespace)
+		if len(namespaces) == 0 {
+			namespaces = []string{metav1.NamespaceAll}
+		}
+
+		var routes []HTTPRouteTarget
+		for _, ns := range namespaces {
+			err := listFn(ns, selector, func(o any) {
+				u, ok := o.(*unstructured.Unstructured)
+				if !ok {
+					return
+				}
+
+				urls := httpRouteURLs(u, targets.HTTPRoute.Scheme)
+				if len(urls) == 0 {
+					rs.l.Debug("skipping HTTPRoute without probable hostname", "probe", key, "httproute", u.GetNamespace()+"/"+u.GetName())
+					return
+				}
+
+				routes = append(routes, HTTPRouteTarget{
+					Namespace: u.GetNamespace(),
+					Name:      u.GetName(),
+					URLs:      urls,
+				})
+			})
+			if err != nil {
+				return nil, fmt.Errorf("probe %s: failed to list HTTPRoutes in namespace %q: %w", key, ns, err)
+			}
+		}
+
+		// Sort the routes to generate a stable configuration.
+		slices.SortFunc(routes, func(a, b HTTPRouteTarget) int {
+			return cmp.Or(
+				strings.Compare(a.Namespace, b.Namespace),
+				strings.Compare(a.Name, b.Name),
+			)
+		})
+		res[key] = routes
+	}
+
+	return res, nil
+}
+
+// selectsHTTPRoutes returns true if the targets of the Probe object are
+// HTTPRoute objects. The static config, ingress and service targets take
+// precedence over the HTTPRoute targets.
+func selectsHTTPRoutes(probe *monitoringv1.Probe) bool {
+	targets := probe.Spec.Targets
+	return targets.HTTPRoute != nil && targets.StaticConfig == nil && targets.Ingress == nil && targets.Service == nil
+}
+
+// httpRouteURLs returns the URLs of an HTTPRoute object. The URLs are the
+// combination of the route's hostnames with the Exact and PathPrefix path
+// matches of the route's rules. The root path is used when a rule has no
+// path match. Wildcard hostnames (e.g. "*.example.com") can't be probed and
+// are ignored.
+func httpRouteURLs(u *unstructured.Unstructured, scheme string) []string {
+	hostnames, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "hostnames")
+	hostnames = slices.DeleteFunc(hostnames, func(h string) bool {
+		return strings.HasPrefix(h, "*")
+	})
+	if len(hostnames) == 0 {
+		return nil
+	}
+
+	var paths []string
+	rules, _, _ := unstructured.NestedSlice(u.Object, "spec", "rules")
+	for _, r := range rules {
+		rule, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		matches, _, _ := unstructured.NestedSlice(rule, "matches")
+		if len(matches) == 0 {
+			paths = append(paths, "/")
+			continue
+		}
+
+		for _, m := range matches {
+			match, ok := m.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			pathType, _, _ := unstructured.NestedString(match, "path", "type")
+			value, _, _ := unstructured.NestedString(match, "path", "value")
+			switch pathType {
+			case "", "PathPrefix":
+				// PathPrefix is the default match type.
+				paths = append(paths, cmp.Or(value, "/"))
+			case "Exact":
+				paths = append(paths, value)
+			}
+		}
+	}
+
+	if len(paths) == 0 {
+		paths = []string{"/"}
+	}
+
+	scheme = cmp.Or(scheme, "https")
+	urls := make([]string, 0, len(hostnames)*len(paths))
+	for _, h := range hostnames {
+		for _, p := range paths {
+			urls = append(urls, fmt.Sprintf("%s://%s%s", scheme, h, p))
+		}
+	}
+
+	slices.Sort(urls)
+	return slices.Compact(urls)
+}
@@ -0,0 +1,343 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func newHTTPRoute(namespace, name string, lbls map[string]string, spec map[string]any) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"spec":       spec,
+		},
+	}
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	u.SetLabels(lbls)
+
+	return u
+}
+
+func TestHTTPRouteURLs(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		spec     map[string]any
+		scheme   string
+		expected []string
+	}{
+		{
+			name: "no hostname",
+			spec: map[string]any{
+				"rules": []any{
+					map[string]any{},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "no rule",
+			spec: map[string]any{
+				"hostnames": []any{"example.com"},
+			},
+			expected: []string{"https://example.com/"},
+		},
+		{
+			name: "rule without match",
+			spec: map[string]any{
+				"hostnames": []any{"example.com", "foo.example.com"},
+				"rules": []any{
+					map[string]any{},
+				},
+			},
+			scheme:   "http",
+			expected: []string{"http://example.com/", "http://foo.example.com/"},
+		},
+		{
+			name: "path matches",
+			spec: map[string]any{
+				"hostnames": []any{"example.com"},
+				"rules": []any{
+					map[string]any{
+						"matches": []any{
+							map[string]any{
+								"path": map[string]any{
+									"type":  "Exact",
+									"value": "/healthz",
+								},
+							},
+							map[string]any{
+								"path": map[string]any{
+									"type":  "PathPrefix",
+									"value": "/api",
+								},
+							},
+							map[string]any{
+								"path": map[string]any{
+									"type":  "RegularExpression",
+									"value": "/v[0-9]+",
+								},
+							},
+						},
+					},
+					map[string]any{
+						"matches": []any{
+							map[string]any{
+								"path": map[string]any{
+									"type":  "PathPrefix",
+									"value": "/api",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{"https://example.com/api", "https://example.com/healthz"},
+		},
+		{
+			name: "wildcard hostname",
+			spec: map[string]any{
+				"hostnames": []any{"*.example.com", "example.com"},
+			},
+			expected: []string{"https://example.com/"},
+		},
+		{
+			name: "only wildcard hostnames",
+			spec: map[string]any{
+				"hostnames": []any{"*.example.com"},
+			},
+			expected: nil,
+		},
+		{
+			name: "header match only",
+			spec: map[string]any{
+				"hostnames": []any{"example.com"},
+				"rules": []any{
+					map[string]any{
+						"matches": []any{
+							map[string]any{
+								"headers": []any{
+									map[string]any{
+										"name":  "version",
+										"value": "2",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{"https://example.com/"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			urls := httpRouteURLs(newHTTPRoute("default", "route", nil, tc.spec), tc.scheme)
+			require.Equal(t, tc.expected, urls)
+		})
+	}
+}
+
+func TestSelectProbeHTTPRoutes(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, route := range []*unstructured.Unstructured{
+		newHTTPRoute("default", "route1", map[string]string{"probe": "true"}, map[string]any{"hostnames": []any{"a.example.com"}}),
+		newHTTPRoute("default", "route2", map[string]string{"probe": "false"}, map[string]any{"hostnames": []any{"b.example.com"}}),
+		newHTTPRoute("test", "route3", map[string]string{"probe": "true"}, map[string]any{"hostnames": []any{"c.example.com"}}),
+		newHTTPRoute("test", "route4", map[string]string{"probe": "true"}, map[string]any{}),
+		newHTTPRoute("other", "route5", map[string]string{"probe": "true"}, map[string]any{"hostnames": []any{"d.example.com"}}),
+	} {
+		require.NoError(t, indexer.Add(route))
+	}
+
+	listFn := func(namespace string, selector labels.Selector, appendFn cache.AppendFunc) error {
+		return cache.ListAllByNamespace(indexer, namespace, selector, appendFn)
+	}
+
+	newProbe := func(name string, targets monitoringv1.ProbeTargets) *monitoringv1.Probe {
+		return &monitoringv1.Probe{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: monitoringv1.ProbeSpec{
+				Targets: targets,
+			},
+		}
+	}
+	httpRoute := func(nsSelector monitoringv1.NamespaceSelector) *monitoringv1.ProbeTargetHTTPRoute {
+		return &monitoringv1.ProbeTargetHTTPRoute{
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"probe": "true"},
+			},
+			NamespaceSelector: nsSelector,
+		}
+	}
+
+	probes := operator.TypedResourcesSelection[*monitoringv1.Probe]{
+		"default/same-namespace": operator.NewTypedConfigurationResource(
+			newProbe("same-namespace", monitoringv1.ProbeTargets{HTTPRoute: httpRoute(monitoringv1.NamespaceSelector{})}),
+			nil, "", 1,
+		),
+		"default/match-names": operator.NewTypedConfigurationResource(
+			newProbe("match-names", monitoringv1.ProbeTargets{HTTPRoute: httpRoute(monitoringv1.NamespaceSelector{MatchNames: []string{"test", "default"}})}),
+			nil, "", 1,
+		),
+		"default/any": operator.NewTypedConfigurationResource(
+			newProbe("any", monitoringv1.ProbeTargets{HTTPRoute: httpRoute(monitoringv1.NamespaceSelector{Any: true})}),
+			nil, "", 1,
+		),
+		"default/ingress": operator.NewTypedConfigurationResource(
+			newProbe("ingress", monitoringv1.ProbeTargets{
+				Ingress:   &monitoringv1.ProbeTargetIngress{},
+				HTTPRoute: httpRoute(monitoringv1.NamespaceSelector{Any: true}),
+			}),
+			nil, "", 1,
+		),
+		"default/invalid": operator.NewTypedConfigurationResource(
+			newProbe("invalid", monitoringv1.ProbeTargets{HTTPRoute: httpRoute(monitoringv1.NamespaceSelector{Any: true})}),
+			errors.New("invalid"), operator.InvalidConfiguration, 1,
+		),
+	}
+
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+	}
+	cs := fake.NewClientset()
+	rs, err := NewResourceSelector(
+		newLogger(),
+		p,
+		assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1()),
+		nil,
+		operator.NewMetrics(prometheus.NewPedanticRegistry()),
+		operator.NewFakeRecorder(1, p),
+	)
+	require.NoError(t, err)
+
+	routes, err := rs.SelectProbeHTTPRoutes(probes, listFn)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string][]HTTPRouteTarget{
+		"default/same-namespace": {
+			{Namespace: "default", Name: "route1", URLs: []string{"https://a.example.com/"}},
+		},
+		"default/match-names": {
+			{Namespace: "default", Name: "route1", URLs: []string{"https://a.example.com/"}},
+			{Namespace: "test", Name: "route3", URLs: []string{"https://c.example.com/"}},
+		},
+		"default/any": {
+			{Namespace: "default", Name: "route1", URLs: []string{"https://a.example.com/"}},
+			{Namespace: "other", Name: "route5", URLs: []string{"https://d.example.com/"}},
+			{Namespace: "test", Name: "route3", URLs: []string{"https://c.example.com/"}},
+		},
+	}, routes)
+
+	// The namespace selectors are ignored when the Prometheus resource
+	// ignores them.
+	p.Spec.IgnoreNamespaceSelectors = true
+	routes, err = rs.SelectProbeHTTPRoutes(probes, listFn)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string][]HTTPRouteTarget{
+		"default/same-namespace": {
+			{Namespace: "default", Name: "route1", URLs: []string{"https://a.example.com/"}},
+		},
+		"default/match-names": {
+			{Namespace: "default", Name: "route1", URLs: []string{"https://a.example.com/"}},
+		},
+		"default/any": {
+			{Namespace: "default", Name: "route1", URLs: []string{"https://a.example.com/"}},
+		},
+	}, routes)
+}
+
+func TestCheckProbeHTTPRouteSupport(t *testing.T) {
+	probe := &monitoringv1.Probe{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "probe",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.ProbeSpec{
+			ProberSpec: monitoringv1.ProberSpec{
+				URL: "blackbox-exporter:9115",
+			},
+			Targets: monitoringv1.ProbeTargets{
+				HTTPRoute: &monitoringv1.ProbeTargetHTTPRoute{},
+			},
+		},
+	}
+
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		opts    []ResourceSelectorOption
+		wantErr bool
+	}{
+		{
+			name:    "HTTPRoute not supported",
+			wantErr: true,
+		},
+		{
+			name: "HTTPRoute supported",
+			opts: []ResourceSelectorOption{WithHTTPRouteSupport()},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := fake.NewClientset()
+			rs, err := NewResourceSelector(
+				newLogger(),
+				p,
+				assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1()),
+				nil,
+				operator.NewMetrics(prometheus.NewPedanticRegistry()),
+				operator.NewFakeRecorder(1, p),
+				tc.opts...,
+			)
+			require.NoError(t, err)
+
+			err = rs.checkProbe(context.Background(), probe)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
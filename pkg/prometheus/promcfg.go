@@ -32,6 +32,7 @@ import (
 
 	"github.com/alecthomas/units"
 	"github.com/blang/semver/v4"
+	"github.com/cespare/xxhash/v2"
 	"github.com/prometheus/common/model"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
@@ -53,6 +54,7 @@ const (
 	kubernetesSDRoleEndpointSlice = "endpointslice"
 	kubernetesSDRolePod           = "pod"
 	kubernetesSDRoleIngress       = "ingress"
+	kubernetesSDRoleService       = "service"
 
 	defaultPrometheusExternalLabelName   = "prometheus"
 	defaultReplicaExternalLabelName      = "prometheus_replica"
@@ -85,8 +87,14 @@ type ConfigGenerator struct {
 	defaultScrapeClassName      string
 	daemonSet                   bool
 	prometheusTopologySharding  bool
+	prometheusResourceSharding  bool
 	prometheusRetentionPolicies bool
 	inlineTLSConfig             bool
+	shard                       *int32
+	alertmanagerEndpoints       []operator.AlertmanagerEndpoint
+	probeHTTPRoutes             map[string][]HTTPRouteTarget
+	federationEndpoints         map[string]FederationEndpoint
+	scrapeJobCache              *ScrapeJobCache
 
 	bypassVersionCheck bool
 }
@@ -111,12 +119,51 @@ func WithPrometheusTopologySharding() ConfigGeneratorOption {
 	}
 }
 
+func WithPrometheusResourceSharding() ConfigGeneratorOption {
+	return func(cg *ConfigGenerator) {
+		cg.prometheusResourceSharding = true
+	}
+}
+
 func WithPrometheusRetentionPolicies() ConfigGeneratorOption {
 	return func(cg *ConfigGenerator) {
 		cg.prometheusRetentionPolicies = true
 	}
 }
 
+// WithAlertmanagerEndpoints configures the endpoints of the Alertmanager
+// resources selected by the Prometheus resource.
+func WithAlertmanagerEndpoints(eps []operator.AlertmanagerEndpoint) ConfigGeneratorOption {
+	return func(cg *ConfigGenerator) {
+		cg.alertmanagerEndpoints = eps
+	}
+}
+
+// WithProbeHTTPRoutes configures the HTTPRoute targets resolved for the
+// Probe resources, indexed by the Probe's namespace and name.
+func WithProbeHTTPRoutes(routes map[string][]HTTPRouteTarget) ConfigGeneratorOption {
+	return func(cg *ConfigGenerator) {
+		cg.probeHTTPRoutes = routes
+	}
+}
+
+// WithFederationEndpoints configures the endpoints of the Prometheus
+// resources referenced as federation sources, indexed by the referenced
+// resource's namespace and name.
+func WithFederationEndpoints(eps map[string]FederationEndpoint) ConfigGeneratorOption {
+	return func(cg *ConfigGenerator) {
+		cg.federationEndpoints = eps
+	}
+}
+
+// WithScrapeJobCache configures the cache used to avoid generating again the
+// scrape jobs which haven't changed.
+func WithScrapeJobCache(c *ScrapeJobCache) ConfigGeneratorOption {
+	return func(cg *ConfigGenerator) {
+		cg.scrapeJobCache = c
+	}
+}
+
 func WithInlineTLSConfig() ConfigGeneratorOption {
 	return func(cg *ConfigGenerator) {
 		cg.inlineTLSConfig = true
@@ -234,6 +281,26 @@ func getScrapeClassConfig(p monitoringv1.PrometheusInterface) (map[string]monito
 			return nil, "", fmt.Errorf("invalid authorization for scrapeClass %s: %w", scrapeClass.Name, err)
 		}
 
+		if err := scrapeClass.ProxyConfig.Validate(); err != nil {
+			return nil, "", fmt.Errorf("invalid proxy config for scrapeClass %s: %w", scrapeClass.Name, err)
+		}
+
+		if len(scrapeClass.ProxyConnectHeader) > 0 {
+			return nil, "", fmt.Errorf("invalid proxy config for scrapeClass %s: proxyConnectHeader isn't supported", scrapeClass.Name)
+		}
+
+		if scrapeInterval := ptr.Deref(scrapeClass.ScrapeInterval, cpf.ScrapeInterval); scrapeClass.ScrapeTimeout != nil && scrapeInterval != "" {
+			if err := CompareScrapeTimeoutToScrapeInterval(*scrapeClass.ScrapeTimeout, scrapeInterval); err != nil {
+				return nil, "", fmt.Errorf("invalid scrape timeout for scrapeClass %s: %w", scrapeClass.Name, err)
+			}
+		}
+
+		if scrapeClass.NamespaceSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(scrapeClass.NamespaceSelector); err != nil {
+				return nil, "", fmt.Errorf("invalid namespace selector for scrapeClass %s: %w", scrapeClass.Name, err)
+			}
+		}
+
 		if ptr.Deref(scrapeClass.Default, false) {
 			if defaultScrapeClass != "" {
 				return nil, "", fmt.Errorf("multiple default scrape classes defined")
@@ -253,24 +320,32 @@ func (cg *ConfigGenerator) Version() semver.Version {
 	return cg.version
 }
 
+// clone returns a shallow copy of the ConfigGenerator.
+func (cg *ConfigGenerator) clone() *ConfigGenerator {
+	c := *cg
+	return &c
+}
+
 // WithKeyVals returns a new ConfigGenerator with the same characteristics as
 // the current object, expect that the keyvals are appended to the existing
 // logger.
 func (cg *ConfigGenerator) WithKeyVals(keyvals ...any) *ConfigGenerator {
-	return &ConfigGenerator{
-		logger:                      cg.logger.With(keyvals...),
-		version:                     cg.version,
-		notCompatible:               cg.notCompatible,
-		prom:                        cg.prom,
-		endpointSliceSupported:      cg.endpointSliceSupported,
-		scrapeClasses:               cg.scrapeClasses,
-		defaultScrapeClassName:      cg.defaultScrapeClassName,
-		daemonSet:                   cg.daemonSet,
-		prometheusTopologySharding:  cg.prometheusTopologySharding,
-		prometheusRetentionPolicies: cg.prometheusRetentionPolicies,
-		inlineTLSConfig:             cg.inlineTLSConfig,
-		bypassVersionCheck:          cg.bypassVersionCheck,
-	}
+	c := cg.clone()
+	c.logger = cg.logger.With(keyvals...)
+
+	return c
+}
+
+// ForShard returns a new ConfigGenerator with the same characteristics as the
+// current object, except that it generates the configuration of the given
+// shard. It only matters when resource sharding is active since the
+// configuration is otherwise the same for all shards.
+func (cg *ConfigGenerator) ForShard(shard int32) *ConfigGenerator {
+	c := cg.clone()
+	c.logger = cg.logger.With("shard", shard)
+	c.shard = ptr.To(shard)
+
+	return c
 }
 
 // WithMinimumVersion returns a new ConfigGenerator that does nothing (except
@@ -283,20 +358,11 @@ func (cg *ConfigGenerator) WithMinimumVersion(version string) *ConfigGenerator {
 	}
 
 	if cg.version.LT(semver.MustParse(version)) {
-		return &ConfigGenerator{
-			logger:                      cg.logger.With("minimum_version", version),
-			version:                     cg.version,
-			notCompatible:               true,
-			prom:                        cg.prom,
-			endpointSliceSupported:      cg.endpointSliceSupported,
-			scrapeClasses:               cg.scrapeClasses,
-			defaultScrapeClassName:      cg.defaultScrapeClassName,
-			daemonSet:                   cg.daemonSet,
-			prometheusTopologySharding:  cg.prometheusTopologySharding,
-			prometheusRetentionPolicies: cg.prometheusRetentionPolicies,
-			inlineTLSConfig:             cg.inlineTLSConfig,
-			bypassVersionCheck:          cg.bypassVersionCheck,
-		}
+		c := cg.clone()
+		c.logger = cg.logger.With("minimum_version", version)
+		c.notCompatible = true
+
+		return c
 	}
 
 	return cg
@@ -312,20 +378,11 @@ func (cg *ConfigGenerator) WithMaximumVersion(version string) *ConfigGenerator {
 	}
 
 	if cg.version.GTE(semver.MustParse(version)) {
-		return &ConfigGenerator{
-			logger:                      cg.logger.With("maximum_version", version),
-			version:                     cg.version,
-			notCompatible:               true,
-			prom:                        cg.prom,
-			endpointSliceSupported:      cg.endpointSliceSupported,
-			scrapeClasses:               cg.scrapeClasses,
-			defaultScrapeClassName:      cg.defaultScrapeClassName,
-			daemonSet:                   cg.daemonSet,
-			prometheusTopologySharding:  cg.prometheusTopologySharding,
-			prometheusRetentionPolicies: cg.prometheusRetentionPolicies,
-			inlineTLSConfig:             cg.inlineTLSConfig,
-			bypassVersionCheck:          cg.bypassVersionCheck,
-		}
+		c := cg.clone()
+		c.logger = cg.logger.With("maximum_version", version)
+		c.notCompatible = true
+
+		return c
 	}
 
 	return cg
@@ -617,6 +674,96 @@ func mergeFallbackScrapeProtocolWithScrapeClass(fallbackScrapeProtocol *monitori
 	return fallbackScrapeProtocol
 }
 
+func mergeScrapeIntervalWithScrapeClass(scrapeInterval monitoringv1.Duration, scrapeClass monitoringv1.ScrapeClass) monitoringv1.Duration {
+	if scrapeInterval == "" {
+		return ptr.Deref(scrapeClass.ScrapeInterval, "")
+	}
+
+	return scrapeInterval
+}
+
+func mergeScrapeTimeoutWithScrapeClass(scrapeTimeout monitoringv1.Duration, scrapeClass monitoringv1.ScrapeClass) monitoringv1.Duration {
+	if scrapeTimeout == "" {
+		return ptr.Deref(scrapeClass.ScrapeTimeout, "")
+	}
+
+	return scrapeTimeout
+}
+
+func mergeHonorLabelsWithScrapeClass(honorLabels bool, scrapeClass monitoringv1.ScrapeClass) bool {
+	return honorLabels || ptr.Deref(scrapeClass.HonorLabels, false)
+}
+
+func mergeHonorTimestampsWithScrapeClass(honorTimestamps *bool, scrapeClass monitoringv1.ScrapeClass) *bool {
+	if honorTimestamps == nil {
+		return scrapeClass.HonorTimestamps
+	}
+
+	return honorTimestamps
+}
+
+func mergeSchemeWithScrapeClass(scheme *monitoringv1.Scheme, scrapeClass monitoringv1.ScrapeClass) *monitoringv1.Scheme {
+	if scheme == nil {
+		return scrapeClass.Scheme
+	}
+
+	return scheme
+}
+
+// mergeParamsWithScrapeClass returns the union of the scrape object's
+// parameters and the scrape class parameters. The scrape object's parameters
+// take precedence.
+func mergeParamsWithScrapeClass(params map[string][]string, scrapeClass monitoringv1.ScrapeClass) map[string][]string {
+	if len(scrapeClass.Params) == 0 {
+		return params
+	}
+
+	merged := maps.Clone(scrapeClass.Params)
+	maps.Copy(merged, params)
+
+	return merged
+}
+
+func mergeLimitWithScrapeClass(limit *uint64, scrapeClassLimit *uint64) *uint64 {
+	if limit == nil {
+		return scrapeClassLimit
+	}
+
+	return limit
+}
+
+func mergeNativeHistogramConfigWithScrapeClass(nhc monitoringv1.NativeHistogramConfig, scrapeClass monitoringv1.ScrapeClass) monitoringv1.NativeHistogramConfig {
+	if nhc.ScrapeNativeHistograms == nil {
+		nhc.ScrapeNativeHistograms = scrapeClass.ScrapeNativeHistograms
+	}
+
+	if nhc.ScrapeClassicHistograms == nil {
+		nhc.ScrapeClassicHistograms = scrapeClass.ScrapeClassicHistograms
+	}
+
+	if nhc.NativeHistogramBucketLimit == nil {
+		nhc.NativeHistogramBucketLimit = scrapeClass.NativeHistogramBucketLimit
+	}
+
+	if nhc.NativeHistogramMinBucketFactor == nil {
+		nhc.NativeHistogramMinBucketFactor = scrapeClass.NativeHistogramMinBucketFactor
+	}
+
+	if nhc.ConvertClassicHistogramsToNHCB == nil {
+		nhc.ConvertClassicHistogramsToNHCB = scrapeClass.ConvertClassicHistogramsToNHCB
+	}
+
+	return nhc
+}
+
+func mergeProxyConfigWithScrapeClass(proxyConfig monitoringv1.ProxyConfig, scrapeClass monitoringv1.ScrapeClass) monitoringv1.ProxyConfig {
+	if reflect.ValueOf(proxyConfig).IsZero() {
+		return scrapeClass.ProxyConfig
+	}
+
+	return proxyConfig
+}
+
 func (cg *ConfigGenerator) addBasicAuthToYaml(
 	cfg yaml.MapSlice,
 	store assets.StoreGetter,
@@ -1018,14 +1165,26 @@ func (cg *ConfigGenerator) GenerateServerConfiguration(
 		shards          = shardsNumber(cg.prom)
 	)
 
-	scrapeConfigs = cg.appendServiceMonitorConfigs(scrapeConfigs, sMons, apiserverConfig, store, shards)
-	scrapeConfigs = cg.appendPodMonitorConfigs(scrapeConfigs, pMons, apiserverConfig, store, shards)
-	scrapeConfigs = cg.appendProbeConfigs(scrapeConfigs, probes, apiserverConfig, store, shards)
-	scrapeConfigs, err := cg.appendScrapeConfigs(scrapeConfigs, sCons, store, shards)
+	if cg.IsResourceShardingActive() {
+		// Only keep the resources assigned to the current shard.
+		sMons = resourcesForShard(cg, sMons)
+		pMons = resourcesForShard(cg, pMons)
+		probes = resourcesForShard(cg, probes)
+		sCons = resourcesForShard(cg, sCons)
+	}
+
+	jobs := cg.newScrapeJobSession()
+	scrapeConfigs = cg.appendServiceMonitorConfigs(scrapeConfigs, sMons, apiserverConfig, store, shards, jobs)
+	scrapeConfigs = cg.appendPodMonitorConfigs(scrapeConfigs, pMons, apiserverConfig, store, shards, jobs)
+	scrapeConfigs = cg.appendProbeConfigs(scrapeConfigs, probes, apiserverConfig, store, shards, jobs)
+	scrapeConfigs, err := cg.appendScrapeConfigs(scrapeConfigs, sCons, store, shards, jobs)
 	if err != nil {
 		return nil, fmt.Errorf("generate scrape configs: %w", err)
 	}
 
+	scrapeConfigs = cg.appendFederationConfigs(scrapeConfigs, p.Spec.FederationSources, store, shards, jobs)
+	jobs.commit(cg)
+
 	scrapeConfigs, err = cg.appendAdditionalScrapeConfigs(scrapeConfigs, additionalScrapeConfigs, shards)
 	if err != nil {
 		return nil, fmt.Errorf("generate additional scrape configs: %w", err)
@@ -1384,8 +1543,8 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 			Value: fmt.Sprintf("podMonitor/%s/%s/%d", m.Namespace, m.Name, i),
 		},
 	}
-	cfg = cg.AddHonorLabels(cfg, ep.HonorLabels)
-	cfg = cg.AddHonorTimestamps(cfg, ep.HonorTimestamps)
+	cfg = cg.AddHonorLabels(cfg, mergeHonorLabelsWithScrapeClass(ep.HonorLabels, scrapeClass))
+	cfg = cg.AddHonorTimestamps(cfg, mergeHonorTimestampsWithScrapeClass(ep.HonorTimestamps, scrapeClass))
 	cfg = cg.AddTrackTimestampsStaleness(cfg, ep.TrackTimestampsStaleness)
 
 	attachMetaConfig := mergeAttachMetadataWithScrapeClass(m.Spec.AttachMetadata, scrapeClass, "2.35.0")
@@ -1404,20 +1563,20 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 			attachMetaConfig,
 			cg.withK8SRoleSelectorConfig(m.Spec.Selector, m.Spec.SelectorMechanism, roleSelectors)))
 
-	if ep.Interval != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: ep.Interval})
+	if interval := mergeScrapeIntervalWithScrapeClass(ep.Interval, scrapeClass); interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: interval})
 	}
-	if ep.ScrapeTimeout != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: ep.ScrapeTimeout})
+	if scrapeTimeout := mergeScrapeTimeoutWithScrapeClass(ep.ScrapeTimeout, scrapeClass); scrapeTimeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: scrapeTimeout})
 	}
 	if ep.Path != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: ep.Path})
 	}
-	if ep.Params != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "params", Value: ep.Params})
+	if params := mergeParamsWithScrapeClass(ep.Params, scrapeClass); params != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "params", Value: params})
 	}
-	if ep.Scheme != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: ep.Scheme.String()})
+	if scheme := mergeSchemeWithScrapeClass(ep.Scheme, scrapeClass); scheme != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: scheme.String()})
 	}
 
 	cfg = cg.addHTTPConfigToYAML(cfg, s, &ep.HTTPConfig, scrapeClass)
@@ -1437,7 +1596,7 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 	cfg = cg.addBasicAuthToYaml(cfg, s, ep.BasicAuth)
 	cfg = cg.addOAuth2ToYaml(cfg, s, ep.OAuth2)
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, ep.ProxyConfig)
+	cfg = cg.addProxyConfigtoYaml(cfg, s, mergeProxyConfigWithScrapeClass(ep.ProxyConfig, scrapeClass))
 
 	cfg = cg.addAuthorizationToYaml(cfg, s, mergeSafeAuthorizationWithScrapeClass(ep.Authorization, scrapeClass))
 
@@ -1585,20 +1744,21 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 	labeler := namespacelabeler.New(cpf.EnforcedNamespaceLabel, cpf.ExcludedFromEnforcement, false)
 	relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, ep.RelabelConfigs))...)
 
-	// DaemonSet mode doesn't support sharding.
-	if !cg.daemonSet {
+	// DaemonSet mode doesn't support sharding and the targets aren't sharded
+	// when the resources are assigned to shards.
+	if !cg.daemonSet && !cg.IsResourceShardingActive() {
 		relabelings = cg.appendShardingRelabelingWithAddress(relabelings, shards)
 	}
 
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, m.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, m.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, m.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, m.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, m.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(m.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(m.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, m.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, m.Spec.NativeHistogramConfig)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(m.Spec.NativeHistogramConfig, scrapeClass))
 	cfg = cg.addScrapeProtocols(cfg, m.Spec.ScrapeProtocols)
 	cfg = cg.addFallbackScrapeProtocol(cfg, mergeFallbackScrapeProtocolWithScrapeClass(m.Spec.FallbackScrapeProtocol, scrapeClass))
 
@@ -1641,14 +1801,14 @@ func (cg *ConfigGenerator) generateProbeConfig(
 
 	cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: m.Spec.ProberSpec.Path})
 
-	if m.Spec.Interval != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: m.Spec.Interval})
+	if interval := mergeScrapeIntervalWithScrapeClass(m.Spec.Interval, scrapeClass); interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: interval})
 	}
-	if m.Spec.ScrapeTimeout != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: m.Spec.ScrapeTimeout})
+	if scrapeTimeout := mergeScrapeTimeoutWithScrapeClass(m.Spec.ScrapeTimeout, scrapeClass); scrapeTimeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: scrapeTimeout})
 	}
-	if m.Spec.ProberSpec.Scheme != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: m.Spec.ProberSpec.Scheme.String()})
+	if scheme := mergeSchemeWithScrapeClass(m.Spec.ProberSpec.Scheme, scrapeClass); scheme != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: scheme.String()})
 	}
 
 	var paramsMapSlice yaml.MapSlice
@@ -1664,18 +1824,27 @@ func (cg *ConfigGenerator) generateProbeConfig(
 		paramsMapSlice = append(paramsMapSlice, yaml.MapItem{Key: p.Name, Value: p.Values})
 	}
 
+	// Add the scrape class parameters which aren't defined by the probe.
+	for _, k := range sortutil.SortedKeys(scrapeClass.Params) {
+		if slices.ContainsFunc(paramsMapSlice, func(item yaml.MapItem) bool { return item.Key == k }) {
+			continue
+		}
+
+		paramsMapSlice = append(paramsMapSlice, yaml.MapItem{Key: k, Value: scrapeClass.Params[k]})
+	}
+
 	if len(paramsMapSlice) != 0 {
 		cfg = append(cfg, yaml.MapItem{Key: "params", Value: paramsMapSlice})
 	}
 
 	cpf := cg.prom.GetCommonPrometheusFields()
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, m.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, m.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, m.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, m.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, m.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(m.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(m.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, m.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, m.Spec.NativeHistogramConfig)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(m.Spec.NativeHistogramConfig, scrapeClass))
 	cfg = cg.addScrapeProtocols(cfg, m.Spec.ScrapeProtocols)
 	cfg = cg.addFallbackScrapeProtocol(cfg, mergeFallbackScrapeProtocolWithScrapeClass(m.Spec.FallbackScrapeProtocol, scrapeClass))
 
@@ -1697,7 +1866,7 @@ func (cg *ConfigGenerator) generateProbeConfig(
 
 	s := store.ForNamespace(m.Namespace)
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, m.Spec.ProberSpec.ProxyConfig)
+	cfg = cg.addProxyConfigtoYaml(cfg, s, mergeProxyConfigWithScrapeClass(m.Spec.ProberSpec.ProxyConfig, scrapeClass))
 
 	cfg = cg.addHTTPConfigToYAML(cfg, s, &m.Spec.HTTPConfig, scrapeClass)
 
@@ -1841,9 +2010,115 @@ func (cg *ConfigGenerator) generateProbeConfig(
 
 		// Add configured relabelings.
 		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, m.Spec.Targets.Ingress.RelabelConfigs))...)
+
+	case m.Spec.Targets.Service != nil:
+		// Generate kubernetes_sd_config section for the service resources.
+		// Filter targets by services selected by the monitor.
+		relabelings = append(relabelings, generateLabelSelectorRelabelings(m.Spec.Targets.Service.Selector, kubernetesSDRoleService)...)
+
+		if m.Spec.Targets.Service.Port != "" {
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "keep"},
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_service_port_name"}},
+				{Key: "regex", Value: m.Spec.Targets.Service.Port},
+			})
+		}
+
+		cfg = append(cfg, cg.generateK8SSDConfig(m.Spec.Targets.Service.NamespaceSelector, m.Namespace, apiserverConfig, s, kubernetesSDRoleService, nil))
+
+		// Relabelings for service SD. The address of the service targets is
+		// <service>.<namespace>.svc:<port>.
+		relabelings = append(relabelings, []yaml.MapSlice{
+			{
+				{Key: "source_labels", Value: []string{"__address__"}},
+				{Key: "separator", Value: ";"},
+				{Key: "regex", Value: "(.+)"},
+				{Key: "target_label", Value: "__param_target"},
+				{Key: "replacement", Value: fmt.Sprintf("%s://${1}%s", cmp.Or(m.Spec.Targets.Service.Scheme, "http"), cmp.Or(m.Spec.Targets.Service.Path, "/"))},
+				{Key: "action", Value: "replace"},
+			},
+			{
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_namespace"}},
+				{Key: "target_label", Value: "namespace"},
+			},
+			{
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_service_name"}},
+				{Key: "target_label", Value: "service"},
+			},
+		}...)
+
+		// Relabelings for prober.
+		relabelings = append(relabelings, []yaml.MapSlice{
+			{
+				{Key: "source_labels", Value: []string{"__address__"}},
+				{Key: "separator", Value: ";"},
+				{Key: "regex", Value: "(.*)"},
+				{Key: "target_label", Value: "__tmp_service_address"},
+				{Key: "replacement", Value: "$1"},
+				{Key: "action", Value: "replace"},
+			},
+			{
+				{Key: "source_labels", Value: []string{"__param_target"}},
+				{Key: "target_label", Value: "instance"},
+			},
+			{
+				{Key: "target_label", Value: "__address__"},
+				{Key: "replacement", Value: m.Spec.ProberSpec.URL},
+			},
+		}...)
+
+		// Add scrape class relabelings if there is any.
+		relabelings = append(relabelings, generateRelabelConfig(scrapeClass.Relabelings)...)
+
+		// Add configured relabelings.
+		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, m.Spec.Targets.Service.RelabelConfigs))...)
+
+	case m.Spec.Targets.HTTPRoute != nil:
+		// Prometheus has no service discovery for the HTTPRoute resources,
+		// the URLs are resolved by the operator and generated as static
+		// configs.
+		var staticConfigs []yaml.MapSlice
+		for _, route := range cg.probeHTTPRoutes[fmt.Sprintf("%s/%s", m.Namespace, m.Name)] {
+			staticConfigs = append(staticConfigs, yaml.MapSlice{
+				{Key: "targets", Value: route.URLs},
+				{Key: "labels", Value: map[string]string{
+					"namespace": route.Namespace,
+					"httproute": route.Name,
+				}},
+			})
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "static_configs",
+			Value: staticConfigs,
+		})
+
+		// Relabelings for prober.
+		relabelings = append(relabelings, []yaml.MapSlice{
+			{
+				{Key: "source_labels", Value: []string{"__address__"}},
+				{Key: "target_label", Value: "__param_target"},
+			},
+			{
+				{Key: "source_labels", Value: []string{"__param_target"}},
+				{Key: "target_label", Value: "instance"},
+			},
+			{
+				{Key: "target_label", Value: "__address__"},
+				{Key: "replacement", Value: m.Spec.ProberSpec.URL},
+			},
+		}...)
+
+		// Add scrape class relabelings if there is any.
+		relabelings = append(relabelings, generateRelabelConfig(scrapeClass.Relabelings)...)
+
+		// Add configured relabelings.
+		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, m.Spec.Targets.HTTPRoute.RelabelConfigs))...)
 	}
 
-	relabelings = cg.appendShardingRelabelingForProbes(relabelings, shards)
+	if !cg.IsResourceShardingActive() {
+		relabelings = cg.appendShardingRelabelingForProbes(relabelings, shards)
+	}
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
 	if m.Spec.BearerTokenSecret != nil { //nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
@@ -1887,8 +2162,8 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 			Value: fmt.Sprintf("serviceMonitor/%s/%s/%d", m.Namespace, m.Name, i),
 		},
 	}
-	cfg = cg.AddHonorLabels(cfg, ep.HonorLabels)
-	cfg = cg.AddHonorTimestamps(cfg, ep.HonorTimestamps)
+	cfg = cg.AddHonorLabels(cfg, mergeHonorLabelsWithScrapeClass(ep.HonorLabels, scrapeClass))
+	cfg = cg.AddHonorTimestamps(cfg, mergeHonorTimestampsWithScrapeClass(ep.HonorTimestamps, scrapeClass))
 	cfg = cg.AddTrackTimestampsStaleness(cfg, ep.TrackTimestampsStaleness)
 
 	attachMetaConfig := mergeAttachMetadataWithScrapeClass(m.Spec.AttachMetadata, scrapeClass, "2.37.0")
@@ -1914,20 +2189,20 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 		cg.withK8SRoleSelectorConfig(m.Spec.Selector, m.Spec.SelectorMechanism, roleSelectors)),
 	)
 
-	if ep.Interval != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: ep.Interval})
+	if interval := mergeScrapeIntervalWithScrapeClass(ep.Interval, scrapeClass); interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: interval})
 	}
-	if ep.ScrapeTimeout != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: ep.ScrapeTimeout})
+	if scrapeTimeout := mergeScrapeTimeoutWithScrapeClass(ep.ScrapeTimeout, scrapeClass); scrapeTimeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: scrapeTimeout})
 	}
 	if ep.Path != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: ep.Path})
 	}
-	if ep.Params != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "params", Value: ep.Params})
+	if params := mergeParamsWithScrapeClass(ep.Params, scrapeClass); params != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "params", Value: params})
 	}
-	if ep.Scheme != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: ep.Scheme.String()})
+	if scheme := mergeSchemeWithScrapeClass(ep.Scheme, scrapeClass); scheme != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: scheme.String()})
 	}
 	if ep.FollowRedirects != nil {
 		cfg = cg.WithMinimumVersion("2.26.0").AppendMapItem(cfg, "follow_redirects", *ep.FollowRedirects)
@@ -1936,7 +2211,7 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 		cfg = cg.WithMinimumVersion("2.35.0").AppendMapItem(cfg, "enable_http2", *ep.EnableHTTP2)
 	}
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, ep.ProxyConfig)
+	cfg = cg.addProxyConfigtoYaml(cfg, s, mergeProxyConfigWithScrapeClass(ep.ProxyConfig, scrapeClass))
 
 	cfg = cg.addOAuth2ToYaml(cfg, s, ep.OAuth2)
 
@@ -2136,16 +2411,18 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 	labeler := namespacelabeler.New(cpf.EnforcedNamespaceLabel, cpf.ExcludedFromEnforcement, false)
 	relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, ep.RelabelConfigs))...)
 
-	relabelings = cg.appendShardingRelabelingWithAddress(relabelings, shards)
+	if !cg.IsResourceShardingActive() {
+		relabelings = cg.appendShardingRelabelingWithAddress(relabelings, shards)
+	}
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, m.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, m.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, m.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, m.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, m.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(m.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(m.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, m.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, m.Spec.NativeHistogramConfig)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(m.Spec.NativeHistogramConfig, scrapeClass))
 	cfg = cg.addScrapeProtocols(cfg, m.Spec.ScrapeProtocols)
 	cfg = cg.addFallbackScrapeProtocol(cfg, mergeFallbackScrapeProtocolWithScrapeClass(m.Spec.FallbackScrapeProtocol, scrapeClass))
 
@@ -2164,6 +2441,59 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 	return cfg
 }
 
+// generateLabelSelectorRelabelings returns the relabeling rules keeping the
+// targets whose Kubernetes object (identified by the service discovery role)
+// matches the label selector.
+func generateLabelSelectorRelabelings(selector metav1.LabelSelector, role string) []yaml.MapSlice {
+	var (
+		relabelings   []yaml.MapSlice
+		labelPrefix   = fmt.Sprintf("__meta_kubernetes_%s_label_", role)
+		presentPrefix = fmt.Sprintf("__meta_kubernetes_%s_labelpresent_", role)
+	)
+
+	// Exact label matches.
+	for _, k := range sortutil.SortedKeys(selector.MatchLabels) {
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "action", Value: "keep"},
+			{Key: "source_labels", Value: []string{labelPrefix + sanitizeLabelName(k), presentPrefix + sanitizeLabelName(k)}},
+			{Key: "regex", Value: fmt.Sprintf("(%s);true", selector.MatchLabels[k])},
+		})
+	}
+
+	// Set based label matching. We have to map the valid relations
+	// `In`, `NotIn`, `Exists`, and `DoesNotExist`, into relabeling rules.
+	for _, exp := range selector.MatchExpressions {
+		switch exp.Operator {
+		case metav1.LabelSelectorOpIn:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "keep"},
+				{Key: "source_labels", Value: []string{labelPrefix + sanitizeLabelName(exp.Key), presentPrefix + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: fmt.Sprintf("(%s);true", strings.Join(exp.Values, "|"))},
+			})
+		case metav1.LabelSelectorOpNotIn:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "drop"},
+				{Key: "source_labels", Value: []string{labelPrefix + sanitizeLabelName(exp.Key), presentPrefix + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: fmt.Sprintf("(%s);true", strings.Join(exp.Values, "|"))},
+			})
+		case metav1.LabelSelectorOpExists:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "keep"},
+				{Key: "source_labels", Value: []string{presentPrefix + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: "true"},
+			})
+		case metav1.LabelSelectorOpDoesNotExist:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "drop"},
+				{Key: "source_labels", Value: []string{presentPrefix + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: "true"},
+			})
+		}
+	}
+
+	return relabelings
+}
+
 func generateRunningFilter() yaml.MapSlice {
 	return yaml.MapSlice{
 		{Key: "action", Value: "drop"},
@@ -2336,10 +2666,11 @@ func generateRelabelConfig(rc []monitoringv1.RelabelConfig) []yaml.MapSlice {
 	return cfg
 }
 
-// GetNamespacesFromNamespaceSelector gets a list of namespaces to select based on
+// getNamespacesFromNamespaceSelector gets a list of namespaces to select based on
 // the given namespace selector, the given default namespace, and whether to ignore namespace selectors.
-func (cg *ConfigGenerator) getNamespacesFromNamespaceSelector(nsel monitoringv1.NamespaceSelector, namespace string) []string {
-	if cg.prom.GetCommonPrometheusFields().IgnoreNamespaceSelectors {
+// An empty list means all namespaces.
+func getNamespacesFromNamespaceSelector(p monitoringv1.PrometheusInterface, nsel monitoringv1.NamespaceSelector, namespace string) []string {
+	if p.GetCommonPrometheusFields().IgnoreNamespaceSelectors {
 		return []string{namespace}
 	} else if nsel.Any {
 		return []string{}
@@ -2390,7 +2721,7 @@ func (cg *ConfigGenerator) generateK8SSDConfig(
 		},
 	}
 
-	namespaces := cg.getNamespacesFromNamespaceSelector(namespaceSelector, namespace)
+	namespaces := getNamespacesFromNamespaceSelector(cg.prom, namespaceSelector, namespace)
 	if len(namespaces) != 0 {
 		k8sSDConfig = append(k8sSDConfig, yaml.MapItem{
 			Key: "namespaces",
@@ -2493,12 +2824,21 @@ func (cg *ConfigGenerator) generateRoleSelectorConfig(k8sSDConfig yaml.MapSlice,
 }
 
 func (cg *ConfigGenerator) generateAlertmanagerConfig(alerting *monitoringv1.AlertingSpec, apiserverConfig *monitoringv1.APIServerConfig, store assets.StoreGetter) []yaml.MapSlice {
-	if alerting == nil || len(alerting.Alertmanagers) == 0 {
+	if alerting == nil {
+		return nil
+	}
+
+	ams := alerting.Alertmanagers
+	for _, ep := range cg.alertmanagerEndpoints {
+		ams = append(ams, alertmanagerEndpointsFromResource(ep, alerting.AlertmanagerTLSConfig))
+	}
+
+	if len(ams) == 0 {
 		return nil
 	}
 
-	alertmanagerConfigs := make([]yaml.MapSlice, 0, len(alerting.Alertmanagers))
-	for i, am := range alerting.Alertmanagers {
+	alertmanagerConfigs := make([]yaml.MapSlice, 0, len(ams))
+	for i, am := range ams {
 		cfg := yaml.MapSlice{}
 		if am.Scheme != nil {
 			cfg = cg.AppendMapItem(cfg, "scheme", am.Scheme.String())
@@ -2590,6 +2930,34 @@ func (cg *ConfigGenerator) generateAlertmanagerConfig(alerting *monitoringv1.Ale
 	return alertmanagerConfigs
 }
 
+// alertmanagerEndpointsFromResource returns the endpoints configuration of
+// the given Alertmanager resource.
+func alertmanagerEndpointsFromResource(ep operator.AlertmanagerEndpoint, tlsConfig *monitoringv1.SafeTLSConfig) monitoringv1.AlertmanagerEndpoints {
+	am := monitoringv1.AlertmanagerEndpoints{
+		Namespace:  ptr.To(ep.Namespace),
+		Name:       ep.ServiceName,
+		Port:       intstr.FromString(ep.PortName),
+		Scheme:     ptr.To(ep.Scheme),
+		PathPrefix: ptr.To(ep.PathPrefix),
+		APIVersion: ptr.To(ep.APIVersion),
+		// The governing service can be shared by several Alertmanager
+		// resources.
+		RelabelConfigs: []monitoringv1.RelabelConfig{
+			{
+				Action:       "keep",
+				SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_label_alertmanager"},
+				Regex:        regexp.QuoteMeta(ep.Name),
+			},
+		},
+	}
+
+	if ep.Scheme == monitoringv1.SchemeHTTPS && tlsConfig != nil {
+		am.TLSConfig = &monitoringv1.TLSConfig{SafeTLSConfig: *tlsConfig}
+	}
+
+	return am
+}
+
 func (cg *ConfigGenerator) generateAdditionalScrapeConfigs(
 	additionalScrapeConfigs []byte,
 	shards int32,
@@ -3127,17 +3495,27 @@ func (cg *ConfigGenerator) appendServiceMonitorConfigs(
 	serviceMonitors map[string]*monitoringv1.ServiceMonitor,
 	apiserverConfig *monitoringv1.APIServerConfig,
 	store *assets.StoreBuilder,
-	shards int32) []yaml.MapSlice {
+	shards int32,
+	jobs *scrapeJobSession) []yaml.MapSlice {
 
 	for _, identifier := range sortutil.SortedKeys(serviceMonitors) {
-		for i, ep := range serviceMonitors[identifier].Spec.Endpoints {
+		sm := serviceMonitors[identifier]
+		for i, ep := range sm.Spec.Endpoints {
 			slices = append(slices,
-				cg.WithKeyVals("service_monitor", identifier).generateServiceMonitorConfig(
-					serviceMonitors[identifier],
-					ep, i,
-					apiserverConfig,
+				jobs.mustJob(
+					fmt.Sprintf("serviceMonitor/%s/%s/%d", sm.Namespace, sm.Name, i),
+					sm.ObjectMeta,
+					cg.getScrapeClassOrDefault(sm.Spec.ScrapeClassName),
 					store,
-					shards,
+					func(store *assets.StoreBuilder) yaml.MapSlice {
+						return cg.WithKeyVals("service_monitor", identifier).generateServiceMonitorConfig(
+							sm,
+							ep, i,
+							apiserverConfig,
+							store,
+							shards,
+						)
+					},
 				),
 			)
 		}
@@ -3150,16 +3528,26 @@ func (cg *ConfigGenerator) appendPodMonitorConfigs(
 	podMonitors map[string]*monitoringv1.PodMonitor,
 	apiserverConfig *monitoringv1.APIServerConfig,
 	store *assets.StoreBuilder,
-	shards int32) []yaml.MapSlice {
+	shards int32,
+	jobs *scrapeJobSession) []yaml.MapSlice {
 
 	for _, identifier := range sortutil.SortedKeys(podMonitors) {
-		for i, ep := range podMonitors[identifier].Spec.PodMetricsEndpoints {
+		pm := podMonitors[identifier]
+		for i, ep := range pm.Spec.PodMetricsEndpoints {
 			slices = append(slices,
-				cg.WithKeyVals("pod_monitor", identifier).generatePodMonitorConfig(
-					podMonitors[identifier], ep, i,
-					apiserverConfig,
+				jobs.mustJob(
+					fmt.Sprintf("podMonitor/%s/%s/%d", pm.Namespace, pm.Name, i),
+					pm.ObjectMeta,
+					cg.getScrapeClassOrDefault(pm.Spec.ScrapeClassName),
 					store,
-					shards,
+					func(store *assets.StoreBuilder) yaml.MapSlice {
+						return cg.WithKeyVals("pod_monitor", identifier).generatePodMonitorConfig(
+							pm, ep, i,
+							apiserverConfig,
+							store,
+							shards,
+						)
+					},
 				),
 			)
 		}
@@ -3173,15 +3561,32 @@ func (cg *ConfigGenerator) appendProbeConfigs(
 	probes map[string]*monitoringv1.Probe,
 	apiserverConfig *monitoringv1.APIServerConfig,
 	store *assets.StoreBuilder,
-	shards int32) []yaml.MapSlice {
+	shards int32,
+	jobs *scrapeJobSession) []yaml.MapSlice {
 
 	for _, identifier := range sortutil.SortedKeys(probes) {
+		probe := probes[identifier]
 		slices = append(slices,
-			cg.WithKeyVals("probe", identifier).generateProbeConfig(
-				probes[identifier],
-				apiserverConfig,
+			jobs.mustJob(
+				fmt.Sprintf("probe/%s/%s", probe.Namespace, probe.Name),
+				probe.ObjectMeta,
+				// The HTTPRoute targets are resolved outside of the Probe.
+				struct {
+					ScrapeClass monitoringv1.ScrapeClass
+					HTTPRoutes  []HTTPRouteTarget
+				}{
+					ScrapeClass: cg.getScrapeClassOrDefault(probe.Spec.ScrapeClassName),
+					HTTPRoutes:  cg.probeHTTPRoutes[fmt.Sprintf("%s/%s", probe.Namespace, probe.Name)],
+				},
 				store,
-				shards,
+				func(store *assets.StoreBuilder) yaml.MapSlice {
+					return cg.WithKeyVals("probe", identifier).generateProbeConfig(
+						probe,
+						apiserverConfig,
+						store,
+						shards,
+					)
+				},
 			),
 		)
 	}
@@ -3231,7 +3636,8 @@ func (cg *ConfigGenerator) GenerateAgentConfiguration(
 		shards          = shardsNumber(cg.prom)
 	)
 
-	scrapeConfigs = cg.appendPodMonitorConfigs(scrapeConfigs, pMons, apiserverConfig, store, shards)
+	jobs := cg.newScrapeJobSession()
+	scrapeConfigs = cg.appendPodMonitorConfigs(scrapeConfigs, pMons, apiserverConfig, store, shards, jobs)
 	scrapeConfigs, err := cg.appendAdditionalScrapeConfigs(scrapeConfigs, additionalScrapeConfigs, shards)
 	if err != nil {
 		return nil, fmt.Errorf("generate additional scrape configs: %w", err)
@@ -3239,13 +3645,14 @@ func (cg *ConfigGenerator) GenerateAgentConfiguration(
 
 	// Currently, DaemonSet mode doesn't support these.
 	if !cg.daemonSet {
-		scrapeConfigs = cg.appendServiceMonitorConfigs(scrapeConfigs, sMons, apiserverConfig, store, shards)
-		scrapeConfigs = cg.appendProbeConfigs(scrapeConfigs, probes, apiserverConfig, store, shards)
-		scrapeConfigs, err = cg.appendScrapeConfigs(scrapeConfigs, sCons, store, shards)
+		scrapeConfigs = cg.appendServiceMonitorConfigs(scrapeConfigs, sMons, apiserverConfig, store, shards, jobs)
+		scrapeConfigs = cg.appendProbeConfigs(scrapeConfigs, probes, apiserverConfig, store, shards, jobs)
+		scrapeConfigs, err = cg.appendScrapeConfigs(scrapeConfigs, sCons, store, shards, jobs)
 		if err != nil {
 			return nil, fmt.Errorf("generate scrape configs: %w", err)
 		}
 	}
+	jobs.commit(cg)
 
 	cfg = append(cfg, yaml.MapItem{
 		Key:   "scrape_configs",
@@ -3289,12 +3696,20 @@ func (cg *ConfigGenerator) appendScrapeConfigs(
 	slices []yaml.MapSlice,
 	scrapeConfigs map[string]*monitoringv1alpha1.ScrapeConfig,
 	store *assets.StoreBuilder,
-	shards int32) ([]yaml.MapSlice, error) {
+	shards int32,
+	jobs *scrapeJobSession) ([]yaml.MapSlice, error) {
 
 	for _, identifier := range sortutil.SortedKeys(scrapeConfigs) {
-		cfgGenerator := cg.WithKeyVals("scrapeconfig", identifier)
-		scrapeConfig, err := cfgGenerator.generateScrapeConfig(scrapeConfigs[identifier], store.ForNamespace(scrapeConfigs[identifier].GetNamespace()), shards)
-
+		sc := scrapeConfigs[identifier]
+		scrapeConfig, err := jobs.job(
+			fmt.Sprintf("scrapeConfig/%s/%s", sc.Namespace, sc.Name),
+			sc.ObjectMeta,
+			cg.getScrapeClassOrDefault(sc.Spec.ScrapeClassName),
+			store,
+			func(store *assets.StoreBuilder) (yaml.MapSlice, error) {
+				return cg.WithKeyVals("scrapeconfig", identifier).generateScrapeConfig(sc, store.ForNamespace(sc.GetNamespace()), shards)
+			},
+		)
 		if err != nil {
 			return slices, err
 		}
@@ -3335,24 +3750,29 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 		})
 	}
 
-	if sc.Spec.HonorTimestamps != nil {
-		cfg = cg.AddHonorTimestamps(cfg, sc.Spec.HonorTimestamps)
+	if honorTimestamps := mergeHonorTimestampsWithScrapeClass(sc.Spec.HonorTimestamps, scrapeClass); honorTimestamps != nil {
+		cfg = cg.AddHonorTimestamps(cfg, honorTimestamps)
 	}
 
 	if sc.Spec.TrackTimestampsStaleness != nil {
 		cfg = cg.AddTrackTimestampsStaleness(cfg, sc.Spec.TrackTimestampsStaleness)
 	}
 
-	if sc.Spec.HonorLabels != nil {
-		cfg = cg.AddHonorLabels(cfg, *sc.Spec.HonorLabels)
+	honorLabels := sc.Spec.HonorLabels
+	if honorLabels == nil {
+		honorLabels = scrapeClass.HonorLabels
+	}
+
+	if honorLabels != nil {
+		cfg = cg.AddHonorLabels(cfg, *honorLabels)
 	}
 
 	if sc.Spec.MetricsPath != nil {
 		cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: *sc.Spec.MetricsPath})
 	}
 
-	if len(sc.Spec.Params) > 0 {
-		cfg = append(cfg, yaml.MapItem{Key: "params", Value: stringMapToMapSlice(sc.Spec.Params)})
+	if params := mergeParamsWithScrapeClass(sc.Spec.Params, scrapeClass); len(params) > 0 {
+		cfg = append(cfg, yaml.MapItem{Key: "params", Value: stringMapToMapSlice(params)})
 	}
 
 	if sc.Spec.EnableCompression != nil {
@@ -3363,22 +3783,22 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 		cfg = cg.WithMinimumVersion("2.35.0").AppendMapItem(cfg, "enable_http2", *sc.Spec.EnableHTTP2)
 	}
 
-	if sc.Spec.ScrapeInterval != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: *sc.Spec.ScrapeInterval})
+	if scrapeInterval := mergeScrapeIntervalWithScrapeClass(ptr.Deref(sc.Spec.ScrapeInterval, ""), scrapeClass); scrapeInterval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: scrapeInterval})
 	}
 
-	if sc.Spec.ScrapeTimeout != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: *sc.Spec.ScrapeTimeout})
+	if scrapeTimeout := mergeScrapeTimeoutWithScrapeClass(ptr.Deref(sc.Spec.ScrapeTimeout, ""), scrapeClass); scrapeTimeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: scrapeTimeout})
 	}
 
 	cfg = cg.addScrapeProtocols(cfg, sc.Spec.ScrapeProtocols)
 	cfg = cg.addFallbackScrapeProtocol(cfg, mergeFallbackScrapeProtocolWithScrapeClass(sc.Spec.FallbackScrapeProtocol, scrapeClass))
 
-	if sc.Spec.Scheme != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: sc.Spec.Scheme.String()})
+	if scheme := mergeSchemeWithScrapeClass(sc.Spec.Scheme, scrapeClass); scheme != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: scheme.String()})
 	}
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, sc.Spec.ProxyConfig)
+	cfg = cg.addProxyConfigtoYaml(cfg, s, mergeProxyConfigWithScrapeClass(sc.Spec.ProxyConfig, scrapeClass))
 
 	cfg = cg.addBasicAuthToYaml(cfg, s, sc.Spec.BasicAuth)
 
@@ -3388,13 +3808,13 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 
 	cfg = cg.addTLStoYaml(cfg, s, mergeSafeTLSConfigWithScrapeClass(sc.Spec.TLSConfig, scrapeClass))
 
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, sc.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, sc.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, sc.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, sc.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, sc.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(sc.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(sc.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(sc.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(sc.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(sc.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, sc.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, sc.Spec.NativeHistogramConfig)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(sc.Spec.NativeHistogramConfig, scrapeClass))
 
 	if bodySizeLimit := getLowerByteSize(sc.Spec.BodySizeLimit, &cpf); !isByteSizeEmpty(bodySizeLimit) {
 		cfg = cg.WithMinimumVersion("2.28.0").AppendMapItem(cfg, "body_size_limit", bodySizeLimit)
@@ -3422,7 +3842,7 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 	}
 
 	// FileSDConfig
-	if len(sc.Spec.FileSDConfigs) > 0 {
+	if len(sc.Spec.FileSDConfigs) > 0 || len(sc.Spec.StaticConfigsFrom) > 0 {
 		configs := make([][]yaml.MapItem, len(sc.Spec.FileSDConfigs))
 		for i, config := range sc.Spec.FileSDConfigs {
 			configs[i] = []yaml.MapItem{
@@ -3439,6 +3859,23 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 				})
 			}
 		}
+
+		// The ConfigMaps and Secrets of the static configs are mounted into
+		// the pods and discovered by a file service discovery.
+		if len(sc.Spec.StaticConfigsFrom) > 0 {
+			files := make([]string, len(sc.Spec.StaticConfigsFrom))
+			for i, sel := range sc.Spec.StaticConfigsFrom {
+				files[i] = staticConfigsFromFile(sel)
+			}
+
+			configs = append(configs, []yaml.MapItem{
+				{
+					Key:   "files",
+					Value: files,
+				},
+			})
+		}
+
 		cfg = append(cfg, yaml.MapItem{
 			Key:   "file_sd_configs",
 			Value: configs,
@@ -4917,19 +5354,342 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 		})
 	}
 
-	if len(sc.Spec.RelabelConfigs) > 0 {
-		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(sc.TypeMeta, sc.ObjectMeta, sc.Spec.RelabelConfigs))...)
-	}
+	// VultrSDConfig
+	if len(sc.Spec.VultrSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.VultrSDConfigs))
+		for i, config := range sc.Spec.VultrSDConfigs {
+			configs[i] = cg.addSafeAuthorizationToYaml(configs[i], s, config.Authorization)
+			configs[i] = cg.addOAuth2ToYaml(configs[i], s, config.OAuth2)
+			configs[i] = cg.addProxyConfigtoYaml(configs[i], s, config.ProxyConfig)
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
 
-	if shards != 1 {
-		relabelings = cg.appendShardingRelabelingWithAddressIfMissing(relabelings, shards)
-	}
+			if config.Port != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "port",
+					Value: config.Port,
+				})
+			}
 
-	// No need to check for the length because relabelings should always have
-	// at least one item.
-	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
 
-	metricRelabelings := []monitoringv1.RelabelConfig{}
+			if config.FollowRedirects != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "follow_redirects",
+					Value: config.FollowRedirects,
+				})
+			}
+
+			if config.EnableHTTP2 != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "enable_http2",
+					Value: config.EnableHTTP2,
+				})
+			}
+		}
+
+		cfg = cg.WithMinimumVersion("2.36.0").AppendMapItem(cfg, "vultr_sd_configs", configs)
+	}
+
+	// STACKITSDConfig
+	if len(sc.Spec.STACKITSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.STACKITSDConfigs))
+		for i, config := range sc.Spec.STACKITSDConfigs {
+			configs[i] = cg.addSafeAuthorizationToYaml(configs[i], s, config.Authorization)
+			configs[i] = cg.addProxyConfigtoYaml(configs[i], s, config.ProxyConfig)
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
+
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "project",
+				Value: config.Project,
+			})
+
+			if config.Region != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "region",
+					Value: config.Region,
+				})
+			}
+
+			if config.Endpoint != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "endpoint",
+					Value: config.Endpoint,
+				})
+			}
+
+			if config.ServiceAccountKey != nil {
+				value, _ := s.GetSecretKey(*config.ServiceAccountKey)
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "service_account_key",
+					Value: string(value),
+				})
+			}
+
+			if config.PrivateKey != nil {
+				value, _ := s.GetSecretKey(*config.PrivateKey)
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "private_key",
+					Value: string(value),
+				})
+			}
+
+			if config.Port != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "port",
+					Value: config.Port,
+				})
+			}
+
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
+
+			if config.FollowRedirects != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "follow_redirects",
+					Value: config.FollowRedirects,
+				})
+			}
+
+			if config.EnableHTTP2 != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "enable_http2",
+					Value: config.EnableHTTP2,
+				})
+			}
+		}
+
+		cfg = cg.WithMinimumVersion("3.5.0").AppendMapItem(cfg, "stackit_sd_configs", configs)
+	}
+
+	// MarathonSDConfig
+	if len(sc.Spec.MarathonSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.MarathonSDConfigs))
+		for i, config := range sc.Spec.MarathonSDConfigs {
+			configs[i] = []yaml.MapItem{
+				{
+					Key:   "servers",
+					Value: config.Servers,
+				},
+			}
+
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
+
+			if config.AuthToken != nil {
+				value, _ := s.GetSecretKey(*config.AuthToken)
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "auth_token",
+					Value: string(value),
+				})
+			}
+
+			configs[i] = cg.addBasicAuthToYaml(configs[i], s, config.BasicAuth)
+			configs[i] = cg.addSafeAuthorizationToYaml(configs[i], s, config.Authorization)
+			configs[i] = cg.addProxyConfigtoYaml(configs[i], s, config.ProxyConfig)
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
+
+			if config.FollowRedirects != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "follow_redirects",
+					Value: config.FollowRedirects,
+				})
+			}
+
+			if config.EnableHTTP2 != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "enable_http2",
+					Value: config.EnableHTTP2,
+				})
+			}
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "marathon_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// TritonSDConfig
+	if len(sc.Spec.TritonSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.TritonSDConfigs))
+		for i, config := range sc.Spec.TritonSDConfigs {
+			configs[i] = []yaml.MapItem{
+				{
+					Key:   "account",
+					Value: config.Account,
+				},
+			}
+
+			if config.Role != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "role",
+					Value: strings.ToLower(string(*config.Role)),
+				})
+			}
+
+			configs[i] = append(configs[i],
+				yaml.MapItem{
+					Key:   "dns_suffix",
+					Value: config.DNSSuffix,
+				},
+				yaml.MapItem{
+					Key:   "endpoint",
+					Value: config.Endpoint,
+				},
+			)
+
+			if len(config.Groups) > 0 {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "groups",
+					Value: config.Groups,
+				})
+			}
+
+			if config.Port != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "port",
+					Value: config.Port,
+				})
+			}
+
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
+
+			if config.Version != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "version",
+					Value: config.Version,
+				})
+			}
+
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "triton_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// UyuniSDConfig
+	if len(sc.Spec.UyuniSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.UyuniSDConfigs))
+		for i, config := range sc.Spec.UyuniSDConfigs {
+			username, _ := s.GetSecretKey(config.Username)
+			password, _ := s.GetSecretKey(config.Password)
+			configs[i] = []yaml.MapItem{
+				{
+					Key:   "server",
+					Value: config.Server,
+				},
+				{
+					Key:   "username",
+					Value: string(username),
+				},
+				{
+					Key:   "password",
+					Value: string(password),
+				},
+			}
+
+			if config.Entitlement != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "entitlement",
+					Value: config.Entitlement,
+				})
+			}
+
+			if config.Separator != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "separator",
+					Value: config.Separator,
+				})
+			}
+
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
+
+			configs[i] = cg.addProxyConfigtoYaml(configs[i], s, config.ProxyConfig)
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
+
+			if config.FollowRedirects != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "follow_redirects",
+					Value: config.FollowRedirects,
+				})
+			}
+
+			if config.EnableHTTP2 != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "enable_http2",
+					Value: config.EnableHTTP2,
+				})
+			}
+		}
+
+		cfg = cg.WithMinimumVersion("2.31.0").AppendMapItem(cfg, "uyuni_sd_configs", configs)
+	}
+
+	// ServersetSDConfig
+	if len(sc.Spec.ServersetSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.ServersetSDConfigs))
+		for i, config := range sc.Spec.ServersetSDConfigs {
+			configs[i] = zookeeperSDConfigToYaml(config.Servers, config.Paths, config.Timeout)
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "serverset_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// NerveSDConfig
+	if len(sc.Spec.NerveSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.NerveSDConfigs))
+		for i, config := range sc.Spec.NerveSDConfigs {
+			configs[i] = zookeeperSDConfigToYaml(config.Servers, config.Paths, config.Timeout)
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "nerve_sd_configs",
+			Value: configs,
+		})
+	}
+
+	if len(sc.Spec.RelabelConfigs) > 0 {
+		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(sc.TypeMeta, sc.ObjectMeta, sc.Spec.RelabelConfigs))...)
+	}
+
+	if shards != 1 && !cg.IsResourceShardingActive() {
+		relabelings = cg.appendShardingRelabelingWithAddressIfMissing(relabelings, shards)
+	}
+
+	// No need to check for the length because relabelings should always have
+	// at least one item.
+	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
+
+	metricRelabelings := []monitoringv1.RelabelConfig{}
 	metricRelabelings = append(metricRelabelings, scrapeClass.MetricRelabelings...)
 	metricRelabelings = append(metricRelabelings, labeler.GetRelabelingConfigs(sc.TypeMeta, sc.ObjectMeta, sc.Spec.MetricRelabelConfigs)...)
 
@@ -4943,6 +5703,30 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 	return cfg, nil
 }
 
+// zookeeperSDConfigToYaml returns the configuration of the service discovery
+// mechanisms backed by ZooKeeper (Serverset and Nerve).
+func zookeeperSDConfigToYaml(servers, paths []string, timeout *monitoringv1.Duration) yaml.MapSlice {
+	cfg := yaml.MapSlice{
+		{
+			Key:   "servers",
+			Value: servers,
+		},
+		{
+			Key:   "paths",
+			Value: paths,
+		},
+	}
+
+	if timeout != nil {
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "timeout",
+			Value: timeout,
+		})
+	}
+
+	return cfg
+}
+
 func (cg *ConfigGenerator) appendOTLPConfig(cfg yaml.MapSlice) (yaml.MapSlice, error) {
 	otlpConfig := cg.prom.GetCommonPrometheusFields().OTLP
 	nameValidationScheme := cg.prom.GetCommonPrometheusFields().NameValidationScheme
@@ -5290,6 +6074,60 @@ func (cg *ConfigGenerator) isTopologyShardingActive() bool {
 		len(ss.Topology.Values) > 0
 }
 
+// IsResourceShardingActive returns true when the resource sharding feature
+// gate is enabled and the Prometheus resource is configured with
+// mode=Namespace or mode=Monitor.
+func (cg *ConfigGenerator) IsResourceShardingActive() bool {
+	if !cg.prometheusResourceSharding {
+		return false
+	}
+
+	return IsResourceShardingMode(cg.prom.GetCommonPrometheusFields().ShardingStrategy)
+}
+
+// IsResourceShardingMode returns true when the sharding strategy assigns
+// monitoring resources to shards (mode=Namespace or mode=Monitor).
+func IsResourceShardingMode(ss *monitoringv1.ShardingStrategy) bool {
+	if ss == nil || ss.Mode == nil {
+		return false
+	}
+
+	switch *ss.Mode {
+	case monitoringv1.NamespaceShardingStrategyMode, monitoringv1.MonitorShardingStrategyMode:
+		return true
+	default:
+		return false
+	}
+}
+
+// ShardForResource returns the index of the shard to which the monitoring
+// resource is assigned when resource sharding is active.
+func (cg *ConfigGenerator) ShardForResource(o metav1.Object) int32 {
+	key := o.GetNamespace()
+	if *cg.prom.GetCommonPrometheusFields().ShardingStrategy.Mode == monitoringv1.MonitorShardingStrategyMode {
+		key = fmt.Sprintf("%s/%s", o.GetNamespace(), o.GetName())
+	}
+
+	return int32(xxhash.Sum64String(key) % uint64(shardsNumber(cg.prom)))
+}
+
+// resourcesForShard returns the resources assigned to the generator's shard
+// (shard 0 if not set).
+func resourcesForShard[T metav1.Object](cg *ConfigGenerator, resources map[string]T) map[string]T {
+	shard := ptr.Deref(cg.shard, 0)
+
+	res := make(map[string]T, len(resources))
+	for k, o := range resources {
+		if cg.ShardForResource(o) != shard {
+			continue
+		}
+
+		res[k] = o
+	}
+
+	return res
+}
+
 // shardsPerZone returns max(1, floor(totalShards / numZones)).
 // Only call when isTopologyShardingActive() is true.
 func (cg *ConfigGenerator) shardsPerZone(totalShards int32) int32 {
@@ -967,6 +967,136 @@ func TestProbeIngressSDConfigGenerationWithLabelEnforce(t *testing.T) {
 	golden.Assert(t, string(cfg), "ProbeIngressSDConfigGenerationWithLabelEnforce.golden")
 }
 
+func TestProbeServiceSDConfigGeneration(t *testing.T) {
+	p := defaultPrometheus()
+
+	cg := mustNewConfigGenerator(t, p)
+	cfg, err := cg.GenerateServerConfiguration(
+		p,
+		nil,
+		nil,
+		map[string]*monitoringv1.Probe{
+			"probe1": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testprobe1",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ProbeSpec{
+					ProberSpec: monitoringv1.ProberSpec{
+						Scheme: ptr.To(monitoringv1.SchemeHTTP),
+						URL:    "blackbox.exporter.io",
+						Path:   "/probe",
+					},
+					Module: "http_2xx",
+					Targets: monitoringv1.ProbeTargets{
+						Service: &monitoringv1.ProbeTargetService{
+							Selector: metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"prometheus.io/probe": "true",
+								},
+								MatchExpressions: []metav1.LabelSelectorRequirement{
+									{
+										Key:      "tier",
+										Operator: metav1.LabelSelectorOpIn,
+										Values:   []string{"frontend", "backend"},
+									},
+								},
+							},
+							NamespaceSelector: monitoringv1.NamespaceSelector{
+								MatchNames: []string{"default", "test"},
+							},
+							Port:   "http",
+							Scheme: "https",
+							Path:   "/healthz",
+							RelabelConfigs: []monitoringv1.RelabelConfig{
+								{
+									TargetLabel: "foo",
+									Replacement: ptr.To("bar"),
+									Action:      "replace",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		nil,
+		&assets.StoreBuilder{},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	golden.Assert(t, string(cfg), "ProbeServiceSDConfigGeneration.golden")
+}
+
+func TestProbeHTTPRouteConfigGeneration(t *testing.T) {
+	p := defaultPrometheus()
+
+	cg := mustNewConfigGenerator(
+		t,
+		p,
+		WithProbeHTTPRoutes(map[string][]HTTPRouteTarget{
+			"default/testprobe1": {
+				{
+					Namespace: "default",
+					Name:      "route1",
+					URLs:      []string{"https://example.com/", "https://example.com/api"},
+				},
+				{
+					Namespace: "test",
+					Name:      "route2",
+					URLs:      []string{"https://foo.example.com/"},
+				},
+			},
+		}),
+	)
+	cfg, err := cg.GenerateServerConfiguration(
+		p,
+		nil,
+		nil,
+		map[string]*monitoringv1.Probe{
+			"probe1": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testprobe1",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ProbeSpec{
+					ProberSpec: monitoringv1.ProberSpec{
+						Scheme: ptr.To(monitoringv1.SchemeHTTP),
+						URL:    "blackbox.exporter.io",
+						Path:   "/probe",
+					},
+					Module: "http_2xx",
+					Targets: monitoringv1.ProbeTargets{
+						HTTPRoute: &monitoringv1.ProbeTargetHTTPRoute{
+							Selector: metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"prometheus.io/probe": "true",
+								},
+							},
+							NamespaceSelector: monitoringv1.NamespaceSelector{
+								Any: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		nil,
+		&assets.StoreBuilder{},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	golden.Assert(t, string(cfg), "ProbeHTTPRouteConfigGeneration.golden")
+}
+
 func TestProbeWithHttp2Disabled(t *testing.T) {
 	p := defaultPrometheus()
 	p.Spec.EnforcedNamespaceLabel = "namespace"
@@ -6570,6 +6700,35 @@ func TestScrapeConfigSpecConfig(t *testing.T) {
 			},
 			golden: "ScrapeConfigSpecConfig_FileSD.golden",
 		},
+		{
+			name: "static_configs_from",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				FileSDConfigs: []monitoringv1alpha1.FileSDConfig{
+					{
+						Files: []monitoringv1alpha1.SDFile{"/tmp/myfile.json"},
+					},
+				},
+				StaticConfigsFrom: []monitoringv1.SecretOrConfigMap{
+					{
+						ConfigMap: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "inventory",
+							},
+							Key: "targets.json",
+						},
+					},
+					{
+						Secret: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "inventory",
+							},
+							Key: "targets.yaml",
+						},
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_StaticConfigsFrom.golden",
+		},
 		{
 			name: "http_sd_config",
 			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
@@ -13601,6 +13760,171 @@ func TestScrapeClassFallbackScrapeProtocol(t *testing.T) {
 	}
 }
 
+func TestScrapeClassScrapeSettings(t *testing.T) {
+	scrapeClass := monitoringv1.ScrapeClass{
+		Name:                  "default",
+		Default:               ptr.To(true),
+		ScrapeInterval:        ptr.To(monitoringv1.Duration("1m")),
+		ScrapeTimeout:         ptr.To(monitoringv1.Duration("20s")),
+		HonorLabels:           ptr.To(true),
+		HonorTimestamps:       ptr.To(false),
+		Params:                map[string][]string{"module": {"class"}, "format": {"prometheus"}},
+		Scheme:                ptr.To(monitoringv1.SchemeHTTPS),
+		SampleLimit:           ptr.To(uint64(10000)),
+		TargetLimit:           ptr.To(uint64(100)),
+		LabelLimit:            ptr.To(uint64(50)),
+		LabelNameLengthLimit:  ptr.To(uint64(64)),
+		LabelValueLengthLimit: ptr.To(uint64(256)),
+		NativeHistogramConfig: monitoringv1.NativeHistogramConfig{
+			NativeHistogramBucketLimit: ptr.To(uint64(20)),
+		},
+		ProxyConfig: monitoringv1.ProxyConfig{
+			ProxyURL: ptr.To("http://proxy.example.com:3128"),
+			NoProxy:  ptr.To("10.0.0.0/8"),
+		},
+	}
+
+	serviceMonitorWithOverrides := defaultServiceMonitor()
+	serviceMonitorWithOverrides.Spec.SampleLimit = ptr.To(uint64(500))
+	serviceMonitorWithOverrides.Spec.Endpoints[0].Interval = ""
+	serviceMonitorWithOverrides.Spec.Endpoints[0].ScrapeTimeout = "10s"
+	serviceMonitorWithOverrides.Spec.Endpoints[0].Params = map[string][]string{"module": {"monitor"}}
+	serviceMonitorWithOverrides.Spec.Endpoints[0].Scheme = ptr.To(monitoringv1.SchemeHTTP)
+	serviceMonitorWithOverrides.Spec.Endpoints[0].ProxyURL = ptr.To("http://other-proxy.example.com:3128")
+
+	scrapeConfigWithOverrides := defaultScrapeConfig()
+	scrapeConfigWithOverrides.Spec.HonorLabels = ptr.To(false)
+	scrapeConfigWithOverrides.Spec.LabelLimit = ptr.To(uint64(10))
+	scrapeConfigWithOverrides.Spec.NativeHistogramConfig = monitoringv1.NativeHistogramConfig{
+		NativeHistogramBucketLimit: ptr.To(uint64(5)),
+		ScrapeClassicHistograms:    ptr.To(true),
+	}
+
+	for _, tc := range []struct {
+		name            string
+		serviceMonitors map[string]*monitoringv1.ServiceMonitor
+		podMonitors     map[string]*monitoringv1.PodMonitor
+		probes          map[string]*monitoringv1.Probe
+		scrapeConfigs   map[string]*monitoringv1alpha1.ScrapeConfig
+		goldenFile      string
+	}{
+		{
+			name:            "ServiceMonitor",
+			serviceMonitors: map[string]*monitoringv1.ServiceMonitor{"monitor": defaultServiceMonitor()},
+			goldenFile:      "serviceMonitorObjectWithScrapeClassScrapeSettings.golden",
+		},
+		{
+			name:            "ServiceMonitor with overrides",
+			serviceMonitors: map[string]*monitoringv1.ServiceMonitor{"monitor": serviceMonitorWithOverrides},
+			goldenFile:      "serviceMonitorObjectWithScrapeClassScrapeSettingsOverrides.golden",
+		},
+		{
+			name:        "PodMonitor",
+			podMonitors: map[string]*monitoringv1.PodMonitor{"monitor": defaultPodMonitor()},
+			goldenFile:  "podMonitorObjectWithScrapeClassScrapeSettings.golden",
+		},
+		{
+			name:       "Probe",
+			probes:     map[string]*monitoringv1.Probe{"monitor": defaultProbe()},
+			goldenFile: "probeObjectWithScrapeClassScrapeSettings.golden",
+		},
+		{
+			name:          "ScrapeConfig",
+			scrapeConfigs: map[string]*monitoringv1alpha1.ScrapeConfig{"monitor": defaultScrapeConfig()},
+			goldenFile:    "scrapeConfigObjectWithScrapeClassScrapeSettings.golden",
+		},
+		{
+			name:          "ScrapeConfig with overrides",
+			scrapeConfigs: map[string]*monitoringv1alpha1.ScrapeConfig{"monitor": scrapeConfigWithOverrides},
+			goldenFile:    "scrapeConfigObjectWithScrapeClassScrapeSettingsOverrides.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{scrapeClass}
+			cg := mustNewConfigGenerator(t, p)
+
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				tc.serviceMonitors,
+				tc.podMonitors,
+				tc.probes,
+				tc.scrapeConfigs,
+				&assets.StoreBuilder{},
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.goldenFile)
+		})
+	}
+}
+
+func TestNewConfigGeneratorWithInvalidScrapeClass(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		scrapeClass monitoringv1.ScrapeClass
+	}{
+		{
+			name: "scrape timeout greater than scrape interval",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name:           "invalid",
+				ScrapeInterval: ptr.To(monitoringv1.Duration("10s")),
+				ScrapeTimeout:  ptr.To(monitoringv1.Duration("20s")),
+			},
+		},
+		{
+			name: "scrape timeout greater than global scrape interval",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name:          "invalid",
+				ScrapeTimeout: ptr.To(monitoringv1.Duration("1h")),
+			},
+		},
+		{
+			name: "proxy connect header",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name: "invalid",
+				ProxyConfig: monitoringv1.ProxyConfig{
+					ProxyURL: ptr.To("http://proxy.example.com"),
+					ProxyConnectHeader: map[string][]corev1.SecretKeySelector{
+						"header": {{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Key: "key"}},
+					},
+				},
+			},
+		},
+		{
+			name: "proxy from environment and proxy URL",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name: "invalid",
+				ProxyConfig: monitoringv1.ProxyConfig{
+					ProxyURL:             ptr.To("http://proxy.example.com"),
+					ProxyFromEnvironment: ptr.To(true),
+				},
+			},
+		},
+		{
+			name: "invalid namespace selector",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name: "invalid",
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Invalid"}},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{tc.scrapeClass}
+
+			_, err := NewConfigGenerator(nil, p)
+			require.Error(t, err)
+		})
+	}
+}
+
 func TestGenerateAlertmanagerConfig(t *testing.T) {
 	for _, tc := range []struct {
 		alerting *monitoringv1.AlertingSpec
@@ -14611,28 +14935,206 @@ func TestShardingRelabelConfigsWithRetention(t *testing.T) {
 	}
 }
 
-func TestTopologyZoneForShard(t *testing.T) {
-	topologyMode := monitoringv1.TopologyShardingStrategyMode
-	addressMode := monitoringv1.AddressShardingStrategyMode
+func TestResourceSharding(t *testing.T) {
+	serviceMonitors := func() map[string]*monitoringv1.ServiceMonitor {
+		res := map[string]*monitoringv1.ServiceMonitor{}
+		for _, ns := range []string{"ns-a", "ns-b", "ns-c"} {
+			for _, name := range []string{"sm-1", "sm-2"} {
+				res[ns+"/"+name] = &monitoringv1.ServiceMonitor{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: ns,
+					},
+					Spec: monitoringv1.ServiceMonitorSpec{
+						Selector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"foo": "bar"},
+						},
+						Endpoints: []monitoringv1.Endpoint{
+							{Port: "web"},
+						},
+					},
+				}
+			}
+		}
+
+		return res
+	}
 
 	for _, tc := range []struct {
-		name                       string
-		shardingStrategy           *monitoringv1.ShardingStrategy
-		prometheusTopologySharding bool
-		shardIndex                 int32
-		expectedZone               string
+		name    string
+		mode    monitoringv1.ShardingStrategyMode
+		enabled bool
+
+		expectedActive bool
 	}{
 		{
-			name:                       "prometheusTopologySharding=false returns empty",
-			prometheusTopologySharding: false,
-			expectedZone:               "",
+			name:    "namespace mode",
+			mode:    monitoringv1.NamespaceShardingStrategyMode,
+			enabled: true,
+
+			expectedActive: true,
 		},
 		{
-			name: "address mode returns empty",
-			shardingStrategy: &monitoringv1.ShardingStrategy{
-				Mode: ptr.To(addressMode),
-			},
-			prometheusTopologySharding: true,
+			name:    "monitor mode",
+			mode:    monitoringv1.MonitorShardingStrategyMode,
+			enabled: true,
+
+			expectedActive: true,
+		},
+		{
+			name: "namespace mode without feature gate",
+			mode: monitoringv1.NamespaceShardingStrategyMode,
+		},
+		{
+			name:    "address mode",
+			mode:    monitoringv1.AddressShardingStrategyMode,
+			enabled: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.Shards = ptr.To(int32(3))
+			p.Spec.ShardingStrategy = &monitoringv1.ShardingStrategy{Mode: ptr.To(tc.mode)}
+
+			opts := []ConfigGeneratorOption{}
+			if tc.enabled {
+				opts = append(opts, WithPrometheusResourceSharding())
+			}
+
+			cg := mustNewConfigGenerator(t, p, opts...)
+			require.Equal(t, tc.expectedActive, cg.IsResourceShardingActive())
+
+			if !tc.expectedActive {
+				return
+			}
+
+			// Every resource is assigned to exactly one shard.
+			jobs := map[string]int32{}
+			for shard := range int32(3) {
+				cfg, err := cg.ForShard(shard).GenerateServerConfiguration(
+					p,
+					serviceMonitors(),
+					nil,
+					nil,
+					nil,
+					&assets.StoreBuilder{},
+					nil,
+					nil,
+					nil,
+					nil,
+				)
+				require.NoError(t, err)
+				require.NotContains(t, string(cfg), "hashmod")
+
+				var c struct {
+					ScrapeConfigs []struct {
+						JobName string `yaml:"job_name"`
+					} `yaml:"scrape_configs"`
+				}
+				require.NoError(t, yaml.Unmarshal(cfg, &c))
+
+				for _, sc := range c.ScrapeConfigs {
+					_, found := jobs[sc.JobName]
+					require.False(t, found, "job %q assigned to several shards", sc.JobName)
+					jobs[sc.JobName] = shard
+				}
+			}
+			require.Len(t, jobs, 6)
+
+			// All the resources from the same namespace are on the same shard.
+			if tc.mode == monitoringv1.NamespaceShardingStrategyMode {
+				for _, ns := range []string{"ns-a", "ns-b", "ns-c"} {
+					require.Equal(t, jobs["serviceMonitor/"+ns+"/sm-1/0"], jobs["serviceMonitor/"+ns+"/sm-2/0"])
+				}
+			}
+
+			// Retained shards have no resources.
+			cfg, err := cg.ForShard(3).GenerateServerConfiguration(
+				p,
+				serviceMonitors(),
+				nil,
+				nil,
+				nil,
+				&assets.StoreBuilder{},
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			require.NotContains(t, string(cfg), "serviceMonitor/")
+		})
+	}
+}
+
+func TestResourceShardingGolden(t *testing.T) {
+	p := defaultPrometheus()
+	p.Spec.Shards = ptr.To(int32(2))
+	p.Spec.ShardingStrategy = &monitoringv1.ShardingStrategy{
+		Mode: ptr.To(monitoringv1.NamespaceShardingStrategyMode),
+	}
+
+	cg := mustNewConfigGenerator(t, p, WithPrometheusResourceSharding())
+	smons := map[string]*monitoringv1.ServiceMonitor{}
+	for _, ns := range []string{"default", "team-a"} {
+		smons[ns+"/test"] = &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: ns,
+			},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"foo": "bar"},
+				},
+				Endpoints: []monitoringv1.Endpoint{
+					{Port: "web", Interval: "30s"},
+				},
+			},
+		}
+	}
+
+	for shard := range int32(2) {
+		t.Run(fmt.Sprintf("shard-%d", shard), func(t *testing.T) {
+			cfg, err := cg.ForShard(shard).GenerateServerConfiguration(
+				p,
+				smons,
+				nil,
+				nil,
+				nil,
+				&assets.StoreBuilder{},
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), fmt.Sprintf("ResourceSharding_Namespace_shard_%d.golden", shard))
+		})
+	}
+}
+
+func TestTopologyZoneForShard(t *testing.T) {
+	topologyMode := monitoringv1.TopologyShardingStrategyMode
+	addressMode := monitoringv1.AddressShardingStrategyMode
+
+	for _, tc := range []struct {
+		name                       string
+		shardingStrategy           *monitoringv1.ShardingStrategy
+		prometheusTopologySharding bool
+		shardIndex                 int32
+		expectedZone               string
+	}{
+		{
+			name:                       "prometheusTopologySharding=false returns empty",
+			prometheusTopologySharding: false,
+			expectedZone:               "",
+		},
+		{
+			name: "address mode returns empty",
+			shardingStrategy: &monitoringv1.ShardingStrategy{
+				Mode: ptr.To(addressMode),
+			},
+			prometheusTopologySharding: true,
 			shardIndex:                 0,
 			expectedZone:               "",
 		},
@@ -14780,3 +15282,914 @@ func TestInzoneShardForShard(t *testing.T) {
 		})
 	}
 }
+
+func TestAlertmanagerResources(t *testing.T) {
+	tlsConfig := &monitoringv1.SafeTLSConfig{
+		CA: monitoringv1.SecretOrConfigMap{
+			Secret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: "tls",
+				},
+				Key: "ca",
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		alertmanagers []*monitoringv1.Alertmanager
+		alerting      *monitoringv1.AlertingSpec
+		golden        string
+	}{
+		{
+			name: "default",
+			alertmanagers: []*monitoringv1.Alertmanager{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+				},
+			},
+			alerting: &monitoringv1.AlertingSpec{
+				AlertmanagerSelector: &metav1.LabelSelector{},
+			},
+			golden: "AlertmanagerResources_default.golden",
+		},
+		{
+			name: "https and custom service",
+			alertmanagers: []*monitoringv1.Alertmanager{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "monitoring"},
+					Spec: monitoringv1.AlertmanagerSpec{
+						ServiceName: ptr.To("alertmanager-main"),
+						PortName:    "http-web",
+						RoutePrefix: "/alertmanager",
+						Web: &monitoringv1.AlertmanagerWebSpec{
+							WebConfigFileFields: monitoringv1.WebConfigFileFields{
+								TLSConfig: &monitoringv1.WebTLSConfig{},
+							},
+						},
+					},
+				},
+			},
+			alerting: &monitoringv1.AlertingSpec{
+				AlertmanagerRefs: []monitoringv1.AlertmanagerReference{
+					{Namespace: ptr.To("monitoring"), Name: "main"},
+				},
+				AlertmanagerTLSConfig: tlsConfig,
+			},
+			golden: "AlertmanagerResources_https.golden",
+		},
+		{
+			name: "with alertmanager endpoints",
+			alertmanagers: []*monitoringv1.Alertmanager{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+				},
+			},
+			alerting: &monitoringv1.AlertingSpec{
+				Alertmanagers: []monitoringv1.AlertmanagerEndpoints{
+					{
+						Name:      "alertmanager-other",
+						Namespace: ptr.To("other"),
+						Port:      intstr.FromString("web"),
+					},
+				},
+				AlertmanagerRefs: []monitoringv1.AlertmanagerReference{
+					{Name: "main"},
+				},
+				// The TLS configuration is ignored for HTTP endpoints.
+				AlertmanagerTLSConfig: tlsConfig,
+			},
+			golden: "AlertmanagerResources_with_endpoints.golden",
+		},
+		{
+			name: "no alertmanager selected",
+			alerting: &monitoringv1.AlertingSpec{
+				AlertmanagerSelector: &metav1.LabelSelector{},
+			},
+			golden: "AlertmanagerResources_none.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.Alerting = tc.alerting
+
+			var eps []operator.AlertmanagerEndpoint
+			for _, am := range tc.alertmanagers {
+				eps = append(eps, operator.NewAlertmanagerEndpoint(am))
+			}
+
+			cg := mustNewConfigGenerator(t, p, WithAlertmanagerEndpoints(eps))
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				nil,
+				&assets.StoreBuilder{},
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeConfigSpecConfigWithVultrSD(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		version string
+		scSpec  monitoringv1alpha1.ScrapeConfigSpec
+		golden  string
+	}{
+		{
+			name: "vultr_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				VultrSDConfigs: []monitoringv1alpha1.VultrSDConfig{
+					{
+						Authorization: &monitoringv1.SafeAuthorization{
+							Credentials: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "credential",
+							},
+						},
+						ProxyConfig: monitoringv1.ProxyConfig{
+							ProxyURL:             ptr.To("http://no-proxy.com"),
+							NoProxy:              ptr.To("0.0.0.0"),
+							ProxyFromEnvironment: ptr.To(false),
+						},
+						FollowRedirects: ptr.To(true),
+						EnableHTTP2:     ptr.To(true),
+						Port:            ptr.To(int32(9100)),
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("30s")),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_VultrSD.golden",
+		},
+		{
+			name: "vultr_sd_config_with_oauth2",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				VultrSDConfigs: []monitoringv1alpha1.VultrSDConfig{
+					{
+						OAuth2: &monitoringv1.OAuth2{
+							ClientID: monitoringv1.SecretOrConfigMap{
+								ConfigMap: &corev1.ConfigMapKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "oauth2",
+									},
+									Key: "client_id",
+								},
+							},
+							ClientSecret: corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "oauth2",
+								},
+								Key: "client_secret",
+							},
+							TokenURL: "http://test.url",
+						},
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_VultrSD_withOAuth2.golden",
+		},
+		{
+			name:    "vultr_sd_config_unsupported_version",
+			version: "v2.35.0",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				VultrSDConfigs: []monitoringv1alpha1.VultrSDConfig{
+					{
+						Port: ptr.To(int32(9100)),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_VultrSD_UnsupportedVersion.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "secret",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"credential": []byte("vultr-api-key"),
+					},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string]string{
+						"client_id": "client-id",
+					},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"client_secret": []byte("client-secret"),
+					},
+				},
+			)
+
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			if tc.version != "" {
+				p.Spec.CommonPrometheusFields.Version = tc.version
+			}
+
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeConfigSpecConfigWithSTACKITSD(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		version string
+		scSpec  monitoringv1alpha1.ScrapeConfigSpec
+		golden  string
+	}{
+		{
+			name: "stackit_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				STACKITSDConfigs: []monitoringv1alpha1.STACKITSDConfig{
+					{
+						Project:  "00000000-0000-0000-0000-000000000001",
+						Region:   ptr.To("eu01"),
+						Endpoint: ptr.To(monitoringv1alpha1.URL("https://iaas.api.eu01.stackit.cloud")),
+						ServiceAccountKey: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "stackit",
+							},
+							Key: "service-account-key",
+						},
+						PrivateKey: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "stackit",
+							},
+							Key: "private-key",
+						},
+						ProxyConfig: monitoringv1.ProxyConfig{
+							ProxyURL: ptr.To("http://no-proxy.com"),
+						},
+						FollowRedirects: ptr.To(true),
+						EnableHTTP2:     ptr.To(true),
+						Port:            ptr.To(int32(9100)),
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("30s")),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_STACKITSD.golden",
+		},
+		{
+			name: "stackit_sd_config_with_authorization",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				STACKITSDConfigs: []monitoringv1alpha1.STACKITSDConfig{
+					{
+						Project: "00000000-0000-0000-0000-000000000001",
+						Authorization: &monitoringv1.SafeAuthorization{
+							Credentials: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "stackit",
+								},
+								Key: "token",
+							},
+						},
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_STACKITSD_withAuthorization.golden",
+		},
+		{
+			name:    "stackit_sd_config_unsupported_version",
+			version: "v3.4.0",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				STACKITSDConfigs: []monitoringv1alpha1.STACKITSDConfig{
+					{
+						Project: "00000000-0000-0000-0000-000000000001",
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_STACKITSD_UnsupportedVersion.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "stackit",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"service-account-key": []byte(`{"id": "key-id"}`),
+						"private-key":         []byte("private-key"),
+						"token":               []byte("token"),
+					},
+				},
+			)
+
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			if tc.version != "" {
+				p.Spec.CommonPrometheusFields.Version = tc.version
+			}
+
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeConfigSpecConfigWithMarathonSD(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		version string
+		scSpec  monitoringv1alpha1.ScrapeConfigSpec
+		golden  string
+	}{
+		{
+			name: "marathon_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				MarathonSDConfigs: []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []monitoringv1alpha1.URL{
+							"https://marathon-1.example.com:8080",
+							"https://marathon-2.example.com:8080",
+						},
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("30s")),
+						AuthToken: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "marathon",
+							},
+							Key: "token",
+						},
+						ProxyConfig: monitoringv1.ProxyConfig{
+							ProxyURL: ptr.To("http://no-proxy.com"),
+						},
+						TLSConfig: &monitoringv1.SafeTLSConfig{
+							CA: monitoringv1.SecretOrConfigMap{
+								Secret: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "tls",
+									},
+									Key: "ca",
+								},
+							},
+						},
+						FollowRedirects: ptr.To(true),
+						EnableHTTP2:     ptr.To(true),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_MarathonSD.golden",
+		},
+		{
+			name: "marathon_sd_config_with_basic_auth",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				MarathonSDConfigs: []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []monitoringv1alpha1.URL{
+							"https://marathon.example.com:8080",
+						},
+						BasicAuth: &monitoringv1.BasicAuth{
+							Username: corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "marathon",
+								},
+								Key: "username",
+							},
+							Password: corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "marathon",
+								},
+								Key: "password",
+							},
+						},
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_MarathonSD_withBasicAuth.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "marathon",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"token":    []byte("token"),
+						"username": []byte("user"),
+						"password": []byte("pass"),
+					},
+				},
+			)
+
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			if tc.version != "" {
+				p.Spec.CommonPrometheusFields.Version = tc.version
+			}
+
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeConfigSpecConfigWithTritonSD(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		version string
+		scSpec  monitoringv1alpha1.ScrapeConfigSpec
+		golden  string
+	}{
+		{
+			name: "triton_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				TritonSDConfigs: []monitoringv1alpha1.TritonSDConfig{
+					{
+						Account:         "testAccount",
+						Role:            ptr.To(monitoringv1alpha1.TritonRoleContainer),
+						DNSSuffix:       "triton.example.com",
+						Endpoint:        "cmon.triton.example.com",
+						Groups:          []string{"group1", "group2"},
+						Port:            ptr.To(int32(9163)),
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("60s")),
+						Version:         ptr.To(int32(1)),
+						TLSConfig: &monitoringv1.SafeTLSConfig{
+							CA: monitoringv1.SecretOrConfigMap{
+								Secret: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "tls",
+									},
+									Key: "ca",
+								},
+							},
+							Cert: monitoringv1.SecretOrConfigMap{
+								Secret: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "tls",
+									},
+									Key: "cert",
+								},
+							},
+							KeySecret: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "tls",
+								},
+								Key: "private-key",
+							},
+						},
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_TritonSD.golden",
+		},
+		{
+			name: "triton_sd_config_with_cn_role",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				TritonSDConfigs: []monitoringv1alpha1.TritonSDConfig{
+					{
+						Account:   "testAccount",
+						Role:      ptr.To(monitoringv1alpha1.TritonRoleCN),
+						DNSSuffix: "triton.example.com",
+						Endpoint:  "cmon.triton.example.com",
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_TritonSD_withCNRole.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			if tc.version != "" {
+				p.Spec.CommonPrometheusFields.Version = tc.version
+			}
+
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				assets.NewTestStoreBuilder(),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeConfigSpecConfigWithUyuniSD(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		version string
+		scSpec  monitoringv1alpha1.ScrapeConfigSpec
+		golden  string
+	}{
+		{
+			name: "uyuni_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				UyuniSDConfigs: []monitoringv1alpha1.UyuniSDConfig{
+					{
+						Server: "https://uyuni.example.com",
+						Username: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "uyuni",
+							},
+							Key: "username",
+						},
+						Password: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "uyuni",
+							},
+							Key: "password",
+						},
+						Entitlement:     ptr.To("monitoring_entitled"),
+						Separator:       ptr.To(","),
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("1m")),
+						ProxyConfig: monitoringv1.ProxyConfig{
+							ProxyURL: ptr.To("http://no-proxy.com"),
+						},
+						TLSConfig: &monitoringv1.SafeTLSConfig{
+							InsecureSkipVerify: ptr.To(true),
+						},
+						FollowRedirects: ptr.To(true),
+						EnableHTTP2:     ptr.To(true),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_UyuniSD.golden",
+		},
+		{
+			name:    "uyuni_sd_config_unsupported_version",
+			version: "v2.30.0",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				UyuniSDConfigs: []monitoringv1alpha1.UyuniSDConfig{
+					{
+						Server: "https://uyuni.example.com",
+						Username: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "uyuni",
+							},
+							Key: "username",
+						},
+						Password: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "uyuni",
+							},
+							Key: "password",
+						},
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_UyuniSD_UnsupportedVersion.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "uyuni",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+						"password": []byte("pass"),
+					},
+				},
+			)
+
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			if tc.version != "" {
+				p.Spec.CommonPrometheusFields.Version = tc.version
+			}
+
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeConfigSpecConfigWithZooKeeperSD(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		scSpec monitoringv1alpha1.ScrapeConfigSpec
+		golden string
+	}{
+		{
+			name: "serverset_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				ServersetSDConfigs: []monitoringv1alpha1.ServersetSDConfig{
+					{
+						Servers: []string{"zk-1.example.com:2181", "zk-2.example.com:2181"},
+						Paths:   []string{"/aurora/jobs/prod"},
+						Timeout: (*monitoringv1.Duration)(ptr.To("15s")),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_ServersetSD.golden",
+		},
+		{
+			name: "nerve_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				NerveSDConfigs: []monitoringv1alpha1.NerveSDConfig{
+					{
+						Servers: []string{"zk.example.com:2181"},
+						Paths:   []string{"/nerve/services/api", "/nerve/services/web"},
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_NerveSD.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				assets.NewTestStoreBuilder(),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestFederationSources(t *testing.T) {
+	zoneA := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{Name: "zone-a", Namespace: "zone-a"},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				Shards:   ptr.To(int32(2)),
+				Replicas: ptr.To(int32(2)),
+			},
+		},
+	}
+	zoneB := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{Name: "zone-b", Namespace: "default"},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				RoutePrefix: "/prometheus",
+				Web: &monitoringv1.PrometheusWebSpec{
+					WebConfigFileFields: monitoringv1.WebConfigFileFields{
+						TLSConfig: &monitoringv1.WebTLSConfig{},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		sources    []monitoringv1.FederationSource
+		prometheus []*monitoringv1.Prometheus
+		shards     *int32
+		golden     string
+	}{
+		{
+			name: "sharded source",
+			sources: []monitoringv1.FederationSource{
+				{
+					Namespace:      ptr.To("zone-a"),
+					Name:           "zone-a",
+					Match:          []string{`{__name__=~"job:.*"}`},
+					ScrapeInterval: ptr.To(monitoringv1.Duration("1m")),
+					ScrapeTimeout:  ptr.To(monitoringv1.Duration("50s")),
+				},
+			},
+			prometheus: []*monitoringv1.Prometheus{zoneA},
+			golden:     "FederationSources_sharded.golden",
+		},
+		{
+			name: "source with web TLS",
+			sources: []monitoringv1.FederationSource{
+				{
+					Name:  "zone-b",
+					Match: []string{`{__name__=~"job:.*"}`, `up`},
+					TLSConfig: &monitoringv1.SafeTLSConfig{
+						CA: monitoringv1.SecretOrConfigMap{
+							Secret: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "tls",
+								},
+								Key: "ca",
+							},
+						},
+						ServerName: ptr.To("prometheus.example.com"),
+					},
+				},
+			},
+			prometheus: []*monitoringv1.Prometheus{zoneB},
+			golden:     "FederationSources_https.golden",
+		},
+		{
+			name: "missing source",
+			sources: []monitoringv1.FederationSource{
+				{
+					Name:  "zone-c",
+					Match: []string{`{__name__=~"job:.*"}`},
+				},
+				{
+					Namespace: ptr.To("zone-a"),
+					Name:      "zone-a",
+					Match:     []string{`{__name__=~"job:.*"}`},
+				},
+			},
+			prometheus: []*monitoringv1.Prometheus{zoneA},
+			golden:     "FederationSources_missing.golden",
+		},
+		{
+			name: "invalid source",
+			sources: []monitoringv1.FederationSource{
+				{
+					Namespace: ptr.To("zone-a"),
+					Name:      "zone-a",
+					Match:     []string{`sum(up)`},
+				},
+				{
+					Namespace: ptr.To("zone-a"),
+					Name:      "zone-a",
+					Match:     []string{`{__name__=~"job:.*"}`},
+				},
+			},
+			prometheus: []*monitoringv1.Prometheus{zoneA},
+			golden:     "FederationSources_invalid.golden",
+		},
+		{
+			name: "sharded federating Prometheus",
+			sources: []monitoringv1.FederationSource{
+				{
+					Name:  "zone-b",
+					Match: []string{`{__name__=~"job:.*"}`},
+				},
+			},
+			prometheus: []*monitoringv1.Prometheus{zoneB},
+			shards:     ptr.To(int32(2)),
+			golden:     "FederationSources_sharding.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.FederationSources = tc.sources
+			p.Spec.Shards = tc.shards
+
+			eps := map[string]FederationEndpoint{}
+			for _, prom := range tc.prometheus {
+				eps[prom.Namespace+"/"+prom.Name] = NewFederationEndpoint(prom, "prometheus-operated")
+			}
+
+			cg := mustNewConfigGenerator(t, p, WithFederationEndpoints(eps))
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				nil,
+				assets.NewTestStoreBuilder(),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
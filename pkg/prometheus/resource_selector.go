@@ -22,10 +22,13 @@ import (
 	"net"
 	"net/url"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/blang/semver/v4"
+	"github.com/prometheus/prometheus/discovery/targetgroup"
+	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -64,6 +67,20 @@ type ResourceSelector struct {
 	accessor           *operator.Accessor
 
 	eventRecorder *operator.EventRecorder
+
+	httpRouteSupported bool
+}
+
+// ResourceSelectorOption configures the ResourceSelector.
+type ResourceSelectorOption func(*ResourceSelector)
+
+// WithHTTPRouteSupport tells that the Kubernetes API supports the Gateway API
+// HTTPRoute resource. Otherwise the Probe objects selecting HTTPRoute objects
+// are rejected.
+func WithHTTPRouteSupport() ResourceSelectorOption {
+	return func(rs *ResourceSelector) {
+		rs.httpRouteSupported = true
+	}
 }
 
 type ListAllByNamespaceFn func(namespace string, selector labels.Selector, appendFn cache.AppendFunc) error
@@ -75,6 +92,7 @@ func NewResourceSelector(
 	namespaceInformers cache.SharedIndexInformer,
 	metrics *operator.Metrics,
 	eventRecorder *operator.EventRecorder,
+	opts ...ResourceSelectorOption,
 ) (*ResourceSelector, error) {
 	promVersion := operator.StringValOrDefault(p.GetCommonPrometheusFields().Version, operator.DefaultPrometheusVersion)
 	version, err := semver.ParseTolerant(promVersion)
@@ -82,7 +100,7 @@ func NewResourceSelector(
 		return nil, fmt.Errorf("failed to parse Prometheus version: %w", err)
 	}
 
-	return &ResourceSelector{
+	rs := &ResourceSelector{
 		l:                  l,
 		p:                  p,
 		version:            version,
@@ -91,7 +109,13 @@ func NewResourceSelector(
 		metrics:            metrics,
 		eventRecorder:      eventRecorder,
 		accessor:           operator.NewAccessor(l),
-	}, nil
+	}
+
+	for _, opt := range opts {
+		opt(rs)
+	}
+
+	return rs, nil
 }
 
 func selectObjects[T operator.ConfigurationResource](
@@ -118,7 +142,12 @@ func selectObjects[T operator.ConfigurationResource](
 		return nil, err
 	}
 	for _, ns := range namespaces {
-		err := listFn(ns, labelSelector, func(o any) {
+		scrapeClassName, err := rs.scrapeClassForNamespace(ns)
+		if err != nil {
+			return nil, err
+		}
+
+		err = listFn(ns, labelSelector, func(o any) {
 			k, ok := rs.accessor.MetaNamespaceKey(o)
 			if !ok {
 				return
@@ -130,6 +159,7 @@ func selectObjects[T operator.ConfigurationResource](
 				logger.Error("failed to set type information", "namespace", ns, "err", err)
 				return
 			}
+			setScrapeClassName(obj, scrapeClassName)
 			objects[k] = obj
 		})
 		if err != nil {
@@ -237,7 +267,7 @@ func (rs *ResourceSelector) checkServiceMonitor(ctx context.Context, sm *monitor
 			return fmt.Errorf("%w: authorization: %w", epErr, err)
 		}
 
-		if err := validateScrapeIntervalAndTimeout(rs.p, endpoint.Interval, endpoint.ScrapeTimeout); err != nil {
+		if err := validateScrapeIntervalAndTimeout(rs.p, sm.Spec.ScrapeClassName, endpoint.Interval, endpoint.ScrapeTimeout); err != nil {
 			return fmt.Errorf("%w: %w", epErr, err)
 		}
 
@@ -287,7 +317,14 @@ func testForArbitraryFSAccess(e monitoringv1.Endpoint) error {
 	return nil
 }
 
-func validateScrapeIntervalAndTimeout(p monitoringv1.PrometheusInterface, scrapeInterval, scrapeTimeout monitoringv1.Duration) error {
+// validateScrapeIntervalAndTimeout verifies that the scrape timeout isn't
+// greater than the scrape interval once merged with the values of the scrape
+// class.
+func validateScrapeIntervalAndTimeout(p monitoringv1.PrometheusInterface, scrapeClassName *string, scrapeInterval, scrapeTimeout monitoringv1.Duration) error {
+	scrapeClass := scrapeClassOrDefault(p, scrapeClassName)
+	scrapeInterval = mergeScrapeIntervalWithScrapeClass(scrapeInterval, scrapeClass)
+	scrapeTimeout = mergeScrapeTimeoutWithScrapeClass(scrapeTimeout, scrapeClass)
+
 	if scrapeTimeout == "" {
 		return nil
 	}
@@ -297,6 +334,83 @@ func validateScrapeIntervalAndTimeout(p monitoringv1.PrometheusInterface, scrape
 	return CompareScrapeTimeoutToScrapeInterval(scrapeTimeout, scrapeInterval)
 }
 
+// scrapeClassForNamespace returns the name of the first scrape class whose
+// namespace selector matches the given namespace. It returns an empty string
+// if no scrape class matches.
+func (rs *ResourceSelector) scrapeClassForNamespace(ns string) (string, error) {
+	for _, sc := range rs.p.GetCommonPrometheusFields().ScrapeClasses {
+		if sc.NamespaceSelector == nil || rs.namespaceInformers == nil {
+			continue
+		}
+
+		o, found, err := rs.namespaceInformers.GetStore().GetByKey(ns)
+		if err != nil {
+			return "", fmt.Errorf("failed to get namespace %s: %w", ns, err)
+		}
+
+		if !found {
+			return "", nil
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(sc.NamespaceSelector)
+		if err != nil {
+			return "", fmt.Errorf("scrapeClass %q: invalid namespace selector: %w", sc.Name, err)
+		}
+
+		if selector.Matches(labels.Set(o.(*corev1.Namespace).Labels)) {
+			return sc.Name, nil
+		}
+	}
+
+	return "", nil
+}
+
+// setScrapeClassName assigns the scrape class to the object when it doesn't
+// define an explicit scrape class name.
+func setScrapeClassName(obj runtime.Object, scrapeClassName string) {
+	if scrapeClassName == "" {
+		return
+	}
+
+	var name **string
+	switch o := obj.(type) {
+	case *monitoringv1.ServiceMonitor:
+		name = &o.Spec.ScrapeClassName
+	case *monitoringv1.PodMonitor:
+		name = &o.Spec.ScrapeClassName
+	case *monitoringv1.Probe:
+		name = &o.Spec.ScrapeClassName
+	case *monitoringv1alpha1.ScrapeConfig:
+		name = &o.Spec.ScrapeClassName
+	default:
+		return
+	}
+
+	if ptr.Deref(*name, "") == "" {
+		*name = ptr.To(scrapeClassName)
+	}
+}
+
+// scrapeClassOrDefault returns the scrape class with the given name or the
+// default scrape class (like ConfigGenerator.getScrapeClassOrDefault()).
+func scrapeClassOrDefault(p monitoringv1.PrometheusInterface, name *string) monitoringv1.ScrapeClass {
+	var (
+		defaultScrapeClass monitoringv1.ScrapeClass
+		scrapeClassName    = ptr.Deref(name, "")
+	)
+	for _, c := range p.GetCommonPrometheusFields().ScrapeClasses {
+		if scrapeClassName != "" && c.Name == scrapeClassName {
+			return c
+		}
+
+		if ptr.Deref(c.Default, false) {
+			defaultScrapeClass = c
+		}
+	}
+
+	return defaultScrapeClass
+}
+
 func validateScrapeClass(p monitoringv1.PrometheusInterface, sc *string) error {
 	if ptr.Deref(sc, "") == "" {
 		return nil
@@ -349,7 +463,7 @@ func (rs *ResourceSelector) checkPodMonitor(ctx context.Context, pm *monitoringv
 
 	for i, endpoint := range pm.Spec.PodMetricsEndpoints {
 		epErr := fmt.Errorf("endpoint[%d]", i)
-		if err := validateScrapeIntervalAndTimeout(rs.p, endpoint.Interval, endpoint.ScrapeTimeout); err != nil {
+		if err := validateScrapeIntervalAndTimeout(rs.p, pm.Spec.ScrapeClassName, endpoint.Interval, endpoint.ScrapeTimeout); err != nil {
 			return fmt.Errorf("%w: %w", epErr, err)
 		}
 
@@ -439,6 +553,10 @@ func (rs *ResourceSelector) checkProbe(ctx context.Context, probe *monitoringv1.
 		return err
 	}
 
+	if selectsHTTPRoutes(probe) && !rs.httpRouteSupported {
+		return fmt.Errorf("targets.httpRoute: the %s resource isn't available in the Kubernetes API", HTTPRouteGroupVersionResource.GroupResource())
+	}
+
 	if probe.Spec.BearerTokenSecret != nil { //nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
 		if _, err := rs.store.GetSecretKey(ctx, probe.GetNamespace(), *probe.Spec.BearerTokenSecret); err != nil { //nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
 			return fmt.Errorf("bearerTokenSecret: %w", err)
@@ -461,7 +579,7 @@ func (rs *ResourceSelector) checkProbe(ctx context.Context, probe *monitoringv1.
 		return fmt.Errorf("oauth2: %w", err)
 	}
 
-	if err := validateScrapeIntervalAndTimeout(rs.p, probe.Spec.Interval, probe.Spec.ScrapeTimeout); err != nil {
+	if err := validateScrapeIntervalAndTimeout(rs.p, probe.Spec.ScrapeClassName, probe.Spec.Interval, probe.Spec.ScrapeTimeout); err != nil {
 		return err
 	}
 
@@ -481,6 +599,18 @@ func (rs *ResourceSelector) checkProbe(ctx context.Context, probe *monitoringv1.
 		}
 	}
 
+	if probe.Spec.Targets.Service != nil {
+		if err := rs.ValidateRelabelConfigs(probe.Spec.Targets.Service.RelabelConfigs); err != nil {
+			return fmt.Errorf("targets.service.relabelConfigs: %w", err)
+		}
+	}
+
+	if probe.Spec.Targets.HTTPRoute != nil {
+		if err := rs.ValidateRelabelConfigs(probe.Spec.Targets.HTTPRoute.RelabelConfigs); err != nil {
+			return fmt.Errorf("targets.httpRoute.relabelConfigs: %w", err)
+		}
+	}
+
 	if err := addProxyConfigToStore(ctx, probe.Spec.ProberSpec.ProxyConfig, rs.store, probe.GetNamespace()); err != nil {
 		return fmt.Errorf("proxy configuration: %w", err)
 	}
@@ -533,6 +663,33 @@ func validateServer(server string) error {
 	return nil
 }
 
+// validateZooKeeperSDConfig checks that the servers are in the `host:port`
+// format and that the paths are absolute ZooKeeper paths.
+func validateZooKeeperSDConfig(servers, paths []string) error {
+	for _, server := range servers {
+		host, port, err := net.SplitHostPort(server)
+		if err != nil {
+			return fmt.Errorf("invalid server %q: %w", server, err)
+		}
+
+		if host == "" {
+			return fmt.Errorf("invalid server %q: empty host", server)
+		}
+
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return fmt.Errorf("invalid server %q: invalid port", server)
+		}
+	}
+
+	for _, path := range paths {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("invalid path %q: must start with a slash", path)
+		}
+	}
+
+	return nil
+}
+
 // SelectScrapeConfigs returns the ScrapeConfigs which match the selectors in the
 // Prometheus CR and filters them returning all the configuration.
 func (rs *ResourceSelector) SelectScrapeConfigs(ctx context.Context, listFn ListAllByNamespaceFn) (operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig], error) {
@@ -585,7 +742,7 @@ func (rs *ResourceSelector) checkScrapeConfig(ctx context.Context, sc *monitorin
 		scrapeTimeout = *sc.Spec.ScrapeTimeout
 	}
 
-	if err := validateScrapeIntervalAndTimeout(rs.p, scrapeInterval, scrapeTimeout); err != nil {
+	if err := validateScrapeIntervalAndTimeout(rs.p, sc.Spec.ScrapeClassName, scrapeInterval, scrapeTimeout); err != nil {
 		return err
 	}
 
@@ -603,6 +760,10 @@ func (rs *ResourceSelector) checkScrapeConfig(ctx context.Context, sc *monitorin
 		return fmt.Errorf("staticConfigs: %w", err)
 	}
 
+	if err := rs.validateStaticConfigsFrom(ctx, sc); err != nil {
+		return fmt.Errorf("staticConfigsFrom: %w", err)
+	}
+
 	if err := rs.validateHTTPSDConfigs(ctx, sc); err != nil {
 		return fmt.Errorf("httpSDConfigs: %w", err)
 	}
@@ -683,6 +844,38 @@ func (rs *ResourceSelector) checkScrapeConfig(ctx context.Context, sc *monitorin
 		return fmt.Errorf("IonosSDConfigs: %w", err)
 	}
 
+	if err := rs.validateVultrSDConfigs(ctx, sc); err != nil {
+		return fmt.Errorf("vultrSDConfigs: %w", err)
+	}
+
+	if err := rs.validateSTACKITSDConfigs(ctx, sc); err != nil {
+		return fmt.Errorf("stackitSDConfigs: %w", err)
+	}
+
+	if err := rs.validateMarathonSDConfigs(ctx, sc); err != nil {
+		return fmt.Errorf("marathonSDConfigs: %w", err)
+	}
+
+	if err := rs.validateTritonSDConfigs(ctx, sc); err != nil {
+		return fmt.Errorf("tritonSDConfigs: %w", err)
+	}
+
+	if err := rs.validateUyuniSDConfigs(ctx, sc); err != nil {
+		return fmt.Errorf("uyuniSDConfigs: %w", err)
+	}
+
+	for i, config := range sc.Spec.ServersetSDConfigs {
+		if err := validateZooKeeperSDConfig(config.Servers, config.Paths); err != nil {
+			return fmt.Errorf("serversetSDConfigs[%d]: %w", i, err)
+		}
+	}
+
+	for i, config := range sc.Spec.NerveSDConfigs {
+		if err := validateZooKeeperSDConfig(config.Servers, config.Paths); err != nil {
+			return fmt.Errorf("nerveSDConfigs[%d]: %w", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -1311,6 +1504,166 @@ func (rs *ResourceSelector) validateScalewaySDConfigs(ctx context.Context, sc *m
 	return nil
 }
 
+func (rs *ResourceSelector) validateVultrSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	for i, config := range sc.Spec.VultrSDConfigs {
+		if config.Authorization != nil && config.OAuth2 != nil {
+			return fmt.Errorf("[%d]: authorization and oauth2 cannot be set at the same time", i)
+		}
+
+		if err := rs.store.AddSafeAuthorizationCredentials(ctx, sc.GetNamespace(), config.Authorization); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddOAuth2(ctx, sc.GetNamespace(), config.OAuth2); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := addProxyConfigToStore(ctx, config.ProxyConfig, rs.store, sc.GetNamespace()); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (rs *ResourceSelector) validateSTACKITSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	for i, config := range sc.Spec.STACKITSDConfigs {
+		if config.Authorization != nil && config.ServiceAccountKey != nil {
+			return fmt.Errorf("[%d]: authorization and serviceAccountKey cannot be set at the same time", i)
+		}
+
+		if config.PrivateKey != nil && config.ServiceAccountKey == nil {
+			return fmt.Errorf("[%d]: privateKey requires serviceAccountKey to be set", i)
+		}
+
+		if config.Endpoint != nil {
+			if err := validateServer(string(*config.Endpoint)); err != nil {
+				return fmt.Errorf("[%d]: endpoint: %w", i, err)
+			}
+		}
+
+		if config.ServiceAccountKey != nil {
+			if _, err := rs.store.GetSecretKey(ctx, sc.GetNamespace(), *config.ServiceAccountKey); err != nil {
+				return fmt.Errorf("[%d]: serviceAccountKey: %w", i, err)
+			}
+		}
+
+		if config.PrivateKey != nil {
+			if _, err := rs.store.GetSecretKey(ctx, sc.GetNamespace(), *config.PrivateKey); err != nil {
+				return fmt.Errorf("[%d]: privateKey: %w", i, err)
+			}
+		}
+
+		if err := rs.store.AddSafeAuthorizationCredentials(ctx, sc.GetNamespace(), config.Authorization); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := addProxyConfigToStore(ctx, config.ProxyConfig, rs.store, sc.GetNamespace()); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (rs *ResourceSelector) validateMarathonSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	for i, config := range sc.Spec.MarathonSDConfigs {
+		var authCount int
+		for _, set := range []bool{config.AuthToken != nil, config.BasicAuth != nil, config.Authorization != nil} {
+			if set {
+				authCount++
+			}
+		}
+		if authCount > 1 {
+			return fmt.Errorf("[%d]: at most one of authToken, basicAuth and authorization must be configured", i)
+		}
+
+		for j, server := range config.Servers {
+			if err := validateServer(string(server)); err != nil {
+				return fmt.Errorf("[%d]: servers[%d]: %w", i, j, err)
+			}
+		}
+
+		if config.AuthToken != nil {
+			if _, err := rs.store.GetSecretKey(ctx, sc.GetNamespace(), *config.AuthToken); err != nil {
+				return fmt.Errorf("[%d]: authToken: %w", i, err)
+			}
+		}
+
+		if err := rs.store.AddBasicAuth(ctx, sc.GetNamespace(), config.BasicAuth); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeAuthorizationCredentials(ctx, sc.GetNamespace(), config.Authorization); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := addProxyConfigToStore(ctx, config.ProxyConfig, rs.store, sc.GetNamespace()); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (rs *ResourceSelector) validateTritonSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	for i, config := range sc.Spec.TritonSDConfigs {
+		if ptr.Deref(config.Role, "") == monitoringv1alpha1.TritonRoleCN {
+			if rs.version.LT(semver.MustParse("2.19.0")) {
+				return fmt.Errorf("[%d]: the CN role is only supported for Prometheus version >= 2.19.0", i)
+			}
+
+			if len(config.Groups) > 0 {
+				return fmt.Errorf("[%d]: groups are only supported with the Container role", i)
+			}
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (rs *ResourceSelector) validateUyuniSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	for i, config := range sc.Spec.UyuniSDConfigs {
+		if err := validateServer(string(config.Server)); err != nil {
+			return fmt.Errorf("[%d]: server: %w", i, err)
+		}
+
+		if _, err := rs.store.GetSecretKey(ctx, sc.GetNamespace(), config.Username); err != nil {
+			return fmt.Errorf("[%d]: username: %w", i, err)
+		}
+
+		if _, err := rs.store.GetSecretKey(ctx, sc.GetNamespace(), config.Password); err != nil {
+			return fmt.Errorf("[%d]: password: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := addProxyConfigToStore(ctx, config.ProxyConfig, rs.store, sc.GetNamespace()); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
 func (rs *ResourceSelector) validateStaticConfig(sc *monitoringv1alpha1.ScrapeConfig) error {
 	for i, config := range sc.Spec.StaticConfigs {
 		for labelName := range config.Labels {
@@ -1323,6 +1676,63 @@ func (rs *ResourceSelector) validateStaticConfig(sc *monitoringv1alpha1.ScrapeCo
 	return nil
 }
 
+// validateStaticConfigsFrom verifies that the referenced keys contain valid
+// targets in the file service discovery format.
+func (rs *ResourceSelector) validateStaticConfigsFrom(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	if len(sc.Spec.StaticConfigsFrom) == 0 {
+		return nil
+	}
+
+	// The referenced objects are mounted into the Prometheus pods.
+	if sc.GetNamespace() != rs.p.GetObjectMeta().GetNamespace() {
+		return fmt.Errorf("the ScrapeConfig resource must be in the same namespace as the Prometheus resource (%q)", rs.p.GetObjectMeta().GetNamespace())
+	}
+
+	for i, sel := range sc.Spec.StaticConfigsFrom {
+		if err := sel.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		switch {
+		case sel.Secret != nil:
+			if sel.Secret.Key == "" {
+				return fmt.Errorf("[%d]: secret key must not be empty", i)
+			}
+		case sel.ConfigMap != nil:
+			if sel.ConfigMap.Key == "" {
+				return fmt.Errorf("[%d]: configMap key must not be empty", i)
+			}
+		default:
+			return fmt.Errorf("[%d]: either secret or configMap must be defined", i)
+		}
+
+		content, err := rs.store.GetKey(ctx, sc.GetNamespace(), sel)
+		if err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		// YAML is a superset of JSON so the same decoder works for both formats.
+		var groups []*targetgroup.Group
+		if err := yaml.UnmarshalStrict([]byte(content), &groups); err != nil {
+			return fmt.Errorf("[%d]: invalid targets in %s: %w", i, sel.String(), err)
+		}
+
+		for _, g := range groups {
+			if g == nil {
+				return fmt.Errorf("[%d]: invalid targets in %s: nil target group", i, sel.String())
+			}
+
+			for labelName := range g.Labels {
+				if !isValidLabelName(string(labelName), rs.version) {
+					return fmt.Errorf("[%d]: invalid label %q in %s", i, labelName, sel.String())
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func (rs *ResourceSelector) validateIonosSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
 	if rs.version.LT(semver.MustParse("2.36.0")) {
 		return fmt.Errorf("IONOS SD configuration is only supported for Prometheus version >= 2.36.0")
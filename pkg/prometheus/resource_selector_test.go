@@ -574,10 +574,84 @@ func TestValidateScrapeIntervalAndTimeout(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			scenario: "scrape timeout specified at service monitor spec but invalid compared to the scrape class interval",
+			prometheus: monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						ScrapeInterval: "60s",
+						ScrapeClasses: []monitoringv1.ScrapeClass{
+							{
+								Name:           "fast",
+								ScrapeInterval: ptr.To(monitoringv1.Duration("10s")),
+							},
+						},
+					},
+				},
+			},
+			smSpec: monitoringv1.ServiceMonitorSpec{
+				ScrapeClassName: ptr.To("fast"),
+				Endpoints: []monitoringv1.Endpoint{
+					{
+						ScrapeTimeout: "20s",
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			scenario: "scrape interval specified at service monitor spec but invalid compared to the default scrape class timeout",
+			prometheus: monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						ScrapeClasses: []monitoringv1.ScrapeClass{
+							{
+								Name:          "default",
+								Default:       ptr.To(true),
+								ScrapeTimeout: ptr.To(monitoringv1.Duration("20s")),
+							},
+						},
+					},
+				},
+			},
+			smSpec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{
+					{
+						Interval: "10s",
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			scenario: "scrape interval and timeout specified at service monitor spec override the scrape class",
+			prometheus: monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						ScrapeClasses: []monitoringv1.ScrapeClass{
+							{
+								Name:           "default",
+								Default:        ptr.To(true),
+								ScrapeInterval: ptr.To(monitoringv1.Duration("10s")),
+								ScrapeTimeout:  ptr.To(monitoringv1.Duration("5s")),
+							},
+						},
+					},
+				},
+			},
+			smSpec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{
+					{
+						Interval:      "60s",
+						ScrapeTimeout: "30s",
+					},
+				},
+			},
+		},
 	} {
 		t.Run(tc.scenario, func(t *testing.T) {
 			for _, endpoint := range tc.smSpec.Endpoints {
-				err := validateScrapeIntervalAndTimeout(&tc.prometheus, endpoint.Interval, endpoint.ScrapeTimeout)
+				err := validateScrapeIntervalAndTimeout(&tc.prometheus, tc.smSpec.ScrapeClassName, endpoint.Interval, endpoint.ScrapeTimeout)
 				t.Logf("err %v", err)
 				if tc.expectedErr {
 					require.Error(t, err)
@@ -1579,6 +1653,7 @@ func TestSelectScrapeConfigs(t *testing.T) {
 		valid       bool
 		promVersion string
 		scrapeClass *string
+		namespace   string
 	}{
 		{
 			scenario: "valid relabeling config",
@@ -1865,6 +1940,492 @@ func TestSelectScrapeConfigs(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			scenario: "staticConfigsFrom with valid YAML targets",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.StaticConfigsFrom = []monitoringv1.SecretOrConfigMap{
+					{
+						Secret: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "targets",
+						},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "staticConfigsFrom with valid JSON targets",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.StaticConfigsFrom = []monitoringv1.SecretOrConfigMap{
+					{
+						ConfigMap: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "configmap",
+							},
+							Key: "targets",
+						},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "staticConfigsFrom with invalid targets",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.StaticConfigsFrom = []monitoringv1.SecretOrConfigMap{
+					{
+						ConfigMap: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "configmap",
+							},
+							Key: "invalid_targets",
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario:    "staticConfigsFrom with invalid label name",
+			promVersion: "2.55.0",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.StaticConfigsFrom = []monitoringv1.SecretOrConfigMap{
+					{
+						ConfigMap: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "configmap",
+							},
+							Key: "invalid_label",
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "staticConfigsFrom with missing key",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.StaticConfigsFrom = []monitoringv1.SecretOrConfigMap{
+					{
+						ConfigMap: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "configmap",
+							},
+							Key: "missing",
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "staticConfigsFrom without reference",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.StaticConfigsFrom = []monitoringv1.SecretOrConfigMap{{}}
+			},
+			valid: false,
+		},
+		{
+			scenario: "staticConfigsFrom with empty key",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.StaticConfigsFrom = []monitoringv1.SecretOrConfigMap{
+					{
+						ConfigMap: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "configmap",
+							},
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario:  "staticConfigsFrom in another namespace",
+			namespace: "other",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.StaticConfigsFrom = []monitoringv1.SecretOrConfigMap{
+					{
+						ConfigMap: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "configmap",
+							},
+							Key: "targets",
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Vultr SD config with valid authorization",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.VultrSDConfigs = []monitoringv1alpha1.VultrSDConfig{
+					{
+						Authorization: &monitoringv1.SafeAuthorization{
+							Credentials: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key1",
+							},
+						},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "Vultr SD config with missing authorization secret",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.VultrSDConfigs = []monitoringv1alpha1.VultrSDConfig{
+					{
+						Authorization: &monitoringv1.SafeAuthorization{
+							Credentials: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "missing",
+							},
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "STACKIT SD config with valid service account key",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.STACKITSDConfigs = []monitoringv1alpha1.STACKITSDConfig{
+					{
+						Project: "project",
+						ServiceAccountKey: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+						PrivateKey: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key2",
+						},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "STACKIT SD config with missing service account key",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.STACKITSDConfigs = []monitoringv1alpha1.STACKITSDConfig{
+					{
+						Project: "project",
+						ServiceAccountKey: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "missing",
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "STACKIT SD config with private key and without service account key",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.STACKITSDConfigs = []monitoringv1alpha1.STACKITSDConfig{
+					{
+						Project: "project",
+						PrivateKey: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key2",
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "STACKIT SD config with invalid endpoint",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.STACKITSDConfigs = []monitoringv1alpha1.STACKITSDConfig{
+					{
+						Project:  "project",
+						Endpoint: ptr.To(monitoringv1alpha1.URL("iaas.api.eu01.stackit.cloud")),
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Marathon SD config with valid auth token",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []monitoringv1alpha1.URL{"https://marathon.example.com:8080"},
+						AuthToken: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "Marathon SD config with missing auth token",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []monitoringv1alpha1.URL{"https://marathon.example.com:8080"},
+						AuthToken: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "missing",
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Marathon SD config with auth token and basic auth",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []monitoringv1alpha1.URL{"https://marathon.example.com:8080"},
+						AuthToken: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+						BasicAuth: &monitoringv1.BasicAuth{
+							Username: corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key1",
+							},
+							Password: corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key2",
+							},
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Marathon SD config with invalid server",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []monitoringv1alpha1.URL{"marathon.example.com"},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Triton SD config with valid TLS config",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.TritonSDConfigs = []monitoringv1alpha1.TritonSDConfig{
+					{
+						Account:   "account",
+						DNSSuffix: "triton.example.com",
+						Endpoint:  "cmon.triton.example.com",
+						TLSConfig: &monitoringv1.SafeTLSConfig{
+							CA: monitoringv1.SecretOrConfigMap{
+								Secret: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "ca",
+								},
+							},
+							Cert: monitoringv1.SecretOrConfigMap{
+								Secret: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "cert",
+								},
+							},
+							KeySecret: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key",
+							},
+						},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "Triton SD config with CN role and groups",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.TritonSDConfigs = []monitoringv1alpha1.TritonSDConfig{
+					{
+						Account:   "account",
+						Role:      ptr.To(monitoringv1alpha1.TritonRoleCN),
+						DNSSuffix: "triton.example.com",
+						Endpoint:  "cmon.triton.example.com",
+						Groups:    []string{"group1"},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Triton SD config with CN role and unsupported version",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.TritonSDConfigs = []monitoringv1alpha1.TritonSDConfig{
+					{
+						Account:   "account",
+						Role:      ptr.To(monitoringv1alpha1.TritonRoleCN),
+						DNSSuffix: "triton.example.com",
+						Endpoint:  "cmon.triton.example.com",
+					},
+				}
+			},
+			promVersion: "2.18.0",
+			valid:       false,
+		},
+		{
+			scenario: "Uyuni SD config with valid credentials",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.UyuniSDConfigs = []monitoringv1alpha1.UyuniSDConfig{
+					{
+						Server: "https://uyuni.example.com",
+						Username: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+						Password: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key2",
+						},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "Uyuni SD config with missing password",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.UyuniSDConfigs = []monitoringv1alpha1.UyuniSDConfig{
+					{
+						Server: "https://uyuni.example.com",
+						Username: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+						Password: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "missing",
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Serverset SD config with valid servers",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.ServersetSDConfigs = []monitoringv1alpha1.ServersetSDConfig{
+					{
+						Servers: []string{"zk.example.com:2181", "[::1]:2181"},
+						Paths:   []string{"/aurora/jobs"},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "Serverset SD config with server without port",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.ServersetSDConfigs = []monitoringv1alpha1.ServersetSDConfig{
+					{
+						Servers: []string{"zk.example.com"},
+						Paths:   []string{"/aurora/jobs"},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Serverset SD config with invalid port",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.ServersetSDConfigs = []monitoringv1alpha1.ServersetSDConfig{
+					{
+						Servers: []string{"zk.example.com:99999"},
+						Paths:   []string{"/aurora/jobs"},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Nerve SD config with valid servers",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.NerveSDConfigs = []monitoringv1alpha1.NerveSDConfig{
+					{
+						Servers: []string{"zk.example.com:2181"},
+						Paths:   []string{"/nerve/services"},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "Nerve SD config with server without host",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.NerveSDConfigs = []monitoringv1alpha1.NerveSDConfig{
+					{
+						Servers: []string{":2181"},
+						Paths:   []string{"/nerve/services"},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Nerve SD config with relative path",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.NerveSDConfigs = []monitoringv1alpha1.NerveSDConfig{
+					{
+						Servers: []string{"zk.example.com:2181"},
+						Paths:   []string{"nerve/services"},
+					},
+				}
+			},
+			valid: false,
+		},
 		{
 			scenario: "HTTP SD config with valid proxy settings",
 			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
@@ -4605,6 +5166,7 @@ func TestSelectScrapeConfigs(t *testing.T) {
 						"invalid_ca": []byte("garbage"),
 						"cert":       cert,
 						"key":        key,
+						"targets":    []byte("- targets: ['host1:9100', 'host2:9100']\n  labels:\n    env: prod\n"),
 					},
 				},
 				&corev1.ConfigMap{
@@ -4613,7 +5175,19 @@ func TestSelectScrapeConfigs(t *testing.T) {
 						Namespace: "test",
 					},
 					Data: map[string]string{
-						"key1": "val1",
+						"key1":            "val1",
+						"targets":         `[{"targets": ["host1:9100"], "labels": {"env": "prod"}}]`,
+						"invalid_targets": `{"targets": ["host1:9100"]}`,
+						"invalid_label":   `[{"targets": ["host1:9100"], "labels": {"0env": "prod"}}]`,
+					},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "configmap",
+						Namespace: "other",
+					},
+					Data: map[string]string{
+						"targets": `[{"targets": ["host1:9100"]}]`,
 					},
 				},
 			)
@@ -4651,6 +5225,10 @@ func TestSelectScrapeConfigs(t *testing.T) {
 				},
 			}
 
+			if tc.namespace != "" {
+				sc.Namespace = tc.namespace
+			}
+
 			if tc.scrapeClass != nil {
 				sc.Spec.ScrapeClassName = tc.scrapeClass
 			}
@@ -4818,3 +5396,103 @@ func TestSelectPodMonitorsWithInvalidAuthentication(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectServiceMonitorsWithNamespaceScrapeClass(t *testing.T) {
+	for _, tc := range []struct {
+		scenario        string
+		nsLabels        map[string]string
+		scrapeClassName *string
+		expected        *string
+	}{
+		{
+			scenario: "namespace matching the first scrape class",
+			nsLabels: map[string]string{"team": "a"},
+			expected: ptr.To("team-a"),
+		},
+		{
+			scenario: "namespace matching both scrape classes",
+			nsLabels: map[string]string{"team": "a", "env": "prod"},
+			expected: ptr.To("team-a"),
+		},
+		{
+			scenario: "namespace matching the second scrape class",
+			nsLabels: map[string]string{"env": "prod"},
+			expected: ptr.To("prod"),
+		},
+		{
+			scenario: "namespace not matching",
+			nsLabels: map[string]string{"team": "b"},
+		},
+		{
+			scenario:        "explicit scrape class",
+			nsLabels:        map[string]string{"team": "a"},
+			scrapeClassName: ptr.To("prod"),
+			expected:        ptr.To("prod"),
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						ScrapeClasses: []monitoringv1.ScrapeClass{
+							{
+								Name:              "team-a",
+								NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+							},
+							{
+								Name:              "prod",
+								NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+							},
+						},
+					},
+				},
+			}
+
+			nsInf := cache.NewSharedIndexInformer(&cache.ListWatch{}, &corev1.Namespace{}, 0, cache.Indexers{})
+			require.NoError(t, nsInf.GetStore().Add(&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "test",
+					Labels: tc.nsLabels,
+				},
+			}))
+
+			cs := fake.NewClientset()
+			rs, err := NewResourceSelector(
+				newLogger(),
+				p,
+				assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1()),
+				nsInf,
+				operator.NewMetrics(prometheus.NewPedanticRegistry()),
+				operator.NewFakeRecorder(1, p),
+			)
+			require.NoError(t, err)
+
+			sm := &monitoringv1.ServiceMonitor{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.ServiceMonitorSpec{
+					ScrapeClassName: tc.scrapeClassName,
+				},
+			}
+
+			sms, err := rs.SelectServiceMonitors(context.Background(), func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+				appendFn(sm)
+				return nil
+			})
+			require.NoError(t, err)
+
+			valid := sms.ValidResources()
+			require.Len(t, valid, 1)
+			require.Equal(t, tc.expected, valid["test/test"].Spec.ScrapeClassName)
+
+			// The original object must not be modified.
+			require.Equal(t, tc.scrapeClassName, sm.Spec.ScrapeClassName)
+		})
+	}
+}
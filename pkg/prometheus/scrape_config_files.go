@@ -0,0 +1,345 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8s"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	// ScrapeConfigFilesDir is the directory where the scrape configuration
+	// files are mounted in the config-reloader container.
+	ScrapeConfigFilesDir = "/etc/prometheus/scrape_config_files"
+	// ScrapeConfigFilesOutDir is the directory where the config-reloader
+	// writes the scrape configuration files loaded by Prometheus.
+	ScrapeConfigFilesOutDir = ConfOutDir + "/scrape_config_files"
+	// ScrapeConfigFilesVolumeName is the name of the volume containing the
+	// scrape configuration files.
+	ScrapeConfigFilesVolumeName = "scrape-config-files"
+
+	// scrapeConfigFileSize is the maximum size of an uncompressed scrape
+	// configuration file. Once compressed, the file is guaranteed to fit
+	// into a single Secret.
+	scrapeConfigFileSize = operator.MaxSecretDataSizeBytes / 2
+
+	// ConfigSizeWarningThreshold is the size of the generated configuration
+	// above which the operator reports that it's close to the maximum size of
+	// a Secret.
+	ConfigSizeWarningThreshold = operator.MaxSecretDataSizeBytes * 8 / 10
+)
+
+// ScrapeConfigFilesSecretName returns the name prefix of the Secrets holding
+// the scrape configuration files of the given shard.
+func ScrapeConfigFilesSecretName(p monitoringv1.PrometheusInterface, shard int32) string {
+	return fmt.Sprintf("%s-scrape-config-files", ConfigSecretNameForShard(p, shard))
+}
+
+// NewScrapeConfigFilesSecret returns the template of the Secrets holding the
+// scrape configuration files of the given shard.
+func NewScrapeConfigFilesSecret(p monitoringv1.PrometheusInterface, config Config, shard int32) *corev1.Secret {
+	s := &corev1.Secret{
+		Data: map[string][]byte{},
+	}
+
+	operator.UpdateObject(
+		s,
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithManagingOwner(p),
+		operator.WithName(ScrapeConfigFilesSecretName(p, shard)),
+		operator.WithNamespace(p.GetObjectMeta().GetNamespace()),
+	)
+
+	return s
+}
+
+// ScrapeConfigFilesVolume returns the volume holding the scrape
+// configuration files stored in the given Secrets, the volume mount of the
+// config-reloader and the config-reloader option which decompresses the
+// files into ScrapeConfigFilesOutDir.
+func ScrapeConfigFilesVolume(secrets *operator.ShardedSecret) (corev1.Volume, corev1.VolumeMount, operator.ReloaderOption) {
+	return secrets.Volume(ScrapeConfigFilesVolumeName),
+		corev1.VolumeMount{
+			Name:      ScrapeConfigFilesVolumeName,
+			MountPath: ScrapeConfigFilesDir,
+		},
+		operator.ConfigDirectory(ScrapeConfigFilesDir, ScrapeConfigFilesOutDir)
+}
+
+// WorkloadState describes how a StatefulSet or DaemonSet loading the
+// configuration uses the scrape configuration files.
+type WorkloadState struct {
+	// MountsScrapeConfigFiles is true when the pod template mounts the
+	// scrape configuration files.
+	MountsScrapeConfigFiles bool
+	// RolledOut is true when all the pods run the current pod template.
+	RolledOut bool
+}
+
+// StatefulSetState returns the state of the given StatefulSet.
+func StatefulSetState(sset *appsv1.StatefulSet) WorkloadState {
+	return WorkloadState{
+		MountsScrapeConfigFiles: mountsScrapeConfigFiles(sset.Spec.Template.Spec),
+		RolledOut: sset.Status.ObservedGeneration >= sset.Generation &&
+			sset.Status.UpdatedReplicas == sset.Status.Replicas &&
+			sset.Status.CurrentRevision == sset.Status.UpdateRevision,
+	}
+}
+
+// DaemonSetState returns the state of the given DaemonSet.
+func DaemonSetState(dset *appsv1.DaemonSet) WorkloadState {
+	return WorkloadState{
+		MountsScrapeConfigFiles: mountsScrapeConfigFiles(dset.Spec.Template.Spec),
+		RolledOut: dset.Status.ObservedGeneration >= dset.Generation &&
+			dset.Status.UpdatedNumberScheduled == dset.Status.DesiredNumberScheduled,
+	}
+}
+
+func mountsScrapeConfigFiles(spec corev1.PodSpec) bool {
+	for _, v := range spec.Volumes {
+		if v.Name == ScrapeConfigFilesVolumeName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ScrapeConfigFilesSecretsExist returns whether the informers know about
+// the Secrets of the scrape configuration files of the given shard.
+func ScrapeConfigFilesSecretsExist(secrInfs *informers.ForResource, p monitoringv1.PrometheusInterface, shard int32) (bool, error) {
+	_, err := secrInfs.Get(fmt.Sprintf("%s/%s-0", p.GetObjectMeta().GetNamespace(), ScrapeConfigFilesSecretName(p, shard)))
+	if err == nil {
+		return true, nil
+	}
+
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+// ReconcileConfigurationSecrets stores the generated configuration of the
+// given shard into Secrets.
+//
+// When the compressed configuration doesn't fit into a single Secret, the
+// scrape configurations are moved to files stored in separate Secrets (see
+// SplitScrapeConfigs). The configuration Secret switches to the files only
+// once all the pods mount them: until then, it keeps the previous
+// configuration. Conversely, the Secrets of the files are deleted only once
+// no pod mounts them anymore.
+//
+// workloads describes the existing StatefulSets or DaemonSets which load the
+// configuration and filesExist tells whether the Secrets of the files
+// exist. It returns the Secrets of the files which the pods need to mount
+// (nil if the configuration fits into a single Secret).
+func ReconcileConfigurationSecrets(
+	ctx context.Context,
+	logger *slog.Logger,
+	kclient kubernetes.Interface,
+	rt *operator.ReconciliationTracker,
+	p monitoringv1.PrometheusInterface,
+	config Config,
+	cg *ConfigGenerator,
+	shard int32,
+	conf []byte,
+	workloads []WorkloadState,
+	filesExist bool,
+) (*operator.ShardedSecret, error) {
+	s, err := MakeConfigurationSecretForShard(p, config, shard, conf)
+	if err != nil {
+		return nil, fmt.Errorf("creating compressed secret failed: %w", err)
+	}
+
+	// When the compressed configuration is still too large, the scrape
+	// configurations are moved to files stored in separate Secrets.
+	var files map[string][]byte
+	if size := dataSize(s.Data); size > operator.MaxSecretDataSizeBytes {
+		if !cg.WithMinimumVersion("2.43.0").IsCompatible() {
+			return nil, fmt.Errorf("the generated configuration uses %d bytes (the maximum size of a Secret is %d bytes) and splitting it requires Prometheus >= 2.43.0", size, operator.MaxSecretDataSizeBytes)
+		}
+
+		conf, files, err = SplitScrapeConfigs(conf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to split the configuration: %w", err)
+		}
+
+		s, err = MakeConfigurationSecretForShard(p, config, shard, conf)
+		if err != nil {
+			return nil, fmt.Errorf("creating compressed secret failed: %w", err)
+		}
+	}
+
+	var (
+		key                 = operator.KeyForObject(p.GetObjectMeta())
+		template            = NewScrapeConfigFilesSecret(p, config, shard)
+		scrapeConfigSecrets *operator.ShardedSecret
+		numSecrets          = 1
+	)
+	switch {
+	case len(files) > 0:
+		// The Secrets need to exist before the pods mount them.
+		scrapeConfigSecrets, err = operator.ReconcileShardedSecret(ctx, files, kclient, template)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile the scrape configuration secrets: %w", err)
+		}
+		numSecrets += scrapeConfigSecrets.Len()
+
+	case filesExist && allWorkloads(workloads, func(ws WorkloadState) bool { return !ws.MountsScrapeConfigFiles && ws.RolledOut }):
+		if err := operator.DeleteShardedSecret(ctx, kclient, template); err != nil {
+			return nil, fmt.Errorf("failed to delete the scrape configuration secrets: %w", err)
+		}
+	}
+
+	if size := dataSize(s.Data) + dataSize(files); size >= ConfigSizeWarningThreshold {
+		rt.AddReasonAndMessage(
+			key,
+			operator.ConfigurationSizeNearLimitReason,
+			fmt.Sprintf("the generated configuration of shard %d uses %d bytes (the maximum size of a Secret is %d bytes) and is stored in %d Secret(s)", shard, size, operator.MaxSecretDataSizeBytes, numSecrets),
+		)
+	}
+
+	if len(files) > 0 && !allWorkloads(workloads, func(ws WorkloadState) bool { return ws.MountsScrapeConfigFiles && ws.RolledOut }) {
+		logger.Info("postponing the configuration update until all the pods mount the scrape configuration files", "secret", s.Name)
+		rt.AddReasonAndMessage(
+			key,
+			operator.ConfigurationSizeNearLimitReason,
+			fmt.Sprintf("the configuration of shard %d will be updated once all the pods mount the scrape configuration files", shard),
+		)
+
+		return scrapeConfigSecrets, nil
+	}
+
+	logger.Debug("updating Prometheus configuration secret", "secret", s.Name)
+	if err := k8s.CreateOrUpdateSecret(ctx, kclient.CoreV1().Secrets(p.GetObjectMeta().GetNamespace()), s); err != nil {
+		return nil, err
+	}
+
+	return scrapeConfigSecrets, nil
+}
+
+func allWorkloads(workloads []WorkloadState, fn func(WorkloadState) bool) bool {
+	for _, ws := range workloads {
+		if !fn(ws) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func dataSize(data map[string][]byte) int {
+	var size int
+	for k, v := range data {
+		size += len(k) + len(v)
+	}
+
+	return size
+}
+
+// SplitScrapeConfigs moves the scrape configurations out of the generated
+// Prometheus configuration into files which are loaded by Prometheus via
+// `scrape_config_files`.
+//
+// It returns the updated configuration and the compressed content of the
+// files. The files are expected to be decompressed into
+// ScrapeConfigFilesOutDir by the config-reloader.
+func SplitScrapeConfigs(conf []byte) ([]byte, map[string][]byte, error) {
+	var cfg yaml.MapSlice
+	if err := yaml.Unmarshal(conf, &cfg); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal the configuration: %w", err)
+	}
+
+	i := 0
+	for ; i < len(cfg); i++ {
+		if cfg[i].Key == "scrape_configs" {
+			break
+		}
+	}
+
+	if i == len(cfg) {
+		return conf, nil, nil
+	}
+
+	jobs, ok := cfg[i].Value.([]any)
+	if !ok || len(jobs) == 0 {
+		return conf, nil, nil
+	}
+
+	var (
+		files = map[string][]byte{}
+		buf   bytes.Buffer
+	)
+	flush := func() error {
+		b, err := compress(append([]byte("scrape_configs:\n"), buf.Bytes()...))
+		if err != nil {
+			return err
+		}
+
+		files[fmt.Sprintf("scrape-configs-%04d.yaml", len(files))] = b
+		buf.Reset()
+
+		return nil
+	}
+
+	for _, job := range jobs {
+		// The YAML sequence of the scrape configs isn't indented which means
+		// that the jobs can be marshalled one by one.
+		b, err := yaml.Marshal([]any{job})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal scrape config: %w", err)
+		}
+
+		if buf.Len() > 0 && buf.Len()+len(b) > scrapeConfigFileSize {
+			if err := flush(); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		buf.Write(b)
+	}
+
+	if err := flush(); err != nil {
+		return nil, nil, err
+	}
+
+	cfg[i] = yaml.MapItem{
+		Key:   "scrape_config_files",
+		Value: []string{path.Join(ScrapeConfigFilesOutDir, "*.yaml")},
+	}
+
+	conf, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal the configuration: %w", err)
+	}
+
+	return conf, files, nil
+}
@@ -0,0 +1,392 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestSplitScrapeConfigs(t *testing.T) {
+	makeConfig := func(jobs int, size int) []byte {
+		scrapeConfigs := make([]yaml.MapSlice, 0, jobs)
+		for i := range jobs {
+			scrapeConfigs = append(scrapeConfigs, yaml.MapSlice{
+				{Key: "job_name", Value: fmt.Sprintf("job-%d", i)},
+				{Key: "honor_labels", Value: true},
+				{Key: "static_configs", Value: []yaml.MapSlice{{
+					{Key: "targets", Value: []string{strings.Repeat("x", size)}},
+				}}},
+			})
+		}
+
+		b, err := yaml.Marshal(yaml.MapSlice{
+			{Key: "global", Value: yaml.MapSlice{{Key: "scrape_interval", Value: "30s"}}},
+			{Key: "scrape_configs", Value: scrapeConfigs},
+			{Key: "rule_files", Value: []string{"/etc/prometheus/rules/*.yaml"}},
+		})
+		require.NoError(t, err)
+
+		return b
+	}
+
+	for _, tc := range []struct {
+		name          string
+		conf          []byte
+		expectedFiles int
+	}{
+		{
+			name: "no scrape configs",
+			conf: []byte("global:\n  scrape_interval: 30s\n"),
+		},
+		{
+			name:          "single file",
+			conf:          makeConfig(10, 10),
+			expectedFiles: 1,
+		},
+		{
+			name:          "multiple files",
+			conf:          makeConfig(10, scrapeConfigFileSize/4),
+			expectedFiles: 4,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			conf, files, err := SplitScrapeConfigs(tc.conf)
+			require.NoError(t, err)
+			require.Len(t, files, tc.expectedFiles)
+
+			if tc.expectedFiles == 0 {
+				require.Equal(t, tc.conf, conf)
+				return
+			}
+
+			var (
+				cfg, expected yaml.MapSlice
+				scrapeConfigs []any
+			)
+			require.NoError(t, yaml.Unmarshal(conf, &cfg))
+			require.NoError(t, yaml.Unmarshal(tc.conf, &expected))
+
+			for _, k := range sortutil.SortedKeys(files) {
+				r, err := gzip.NewReader(bytes.NewReader(files[k]))
+				require.NoError(t, err)
+				b, err := io.ReadAll(r)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(b), scrapeConfigFileSize+len("scrape_configs:\n"))
+
+				var f yaml.MapSlice
+				require.NoError(t, yaml.Unmarshal(b, &f))
+				require.Len(t, f, 1)
+				require.Equal(t, "scrape_configs", f[0].Key)
+				scrapeConfigs = append(scrapeConfigs, f[0].Value.([]any)...)
+			}
+
+			// The scrape configs are replaced by the scrape config files and
+			// the other sections are left untouched.
+			require.Equal(t, yaml.MapSlice{
+				expected[0],
+				{Key: "scrape_config_files", Value: []any{"/etc/prometheus/config_out/scrape_config_files/*.yaml"}},
+				expected[2],
+			}, cfg)
+			require.Equal(t, expected[1].Value, scrapeConfigs)
+		})
+	}
+}
+
+func TestReconcileConfigurationSecrets(t *testing.T) {
+	// Random data doesn't compress well which makes it possible to exceed
+	// the maximum size of a Secret.
+	makeConfig := func(jobs int, size int) []byte {
+		var b strings.Builder
+		b.WriteString("scrape_configs:\n")
+		for i := range jobs {
+			target := make([]byte, size/2)
+			_, err := rand.Read(target)
+			require.NoError(t, err)
+			fmt.Fprintf(&b, "- job_name: job-%d\n  static_configs:\n  - targets:\n    - %s\n", i, hex.EncodeToString(target))
+		}
+
+		return []byte(b.String())
+	}
+
+	var (
+		smallConfig = makeConfig(1, 100)
+		largeConfig = makeConfig(3, operator.MaxSecretDataSizeBytes)
+		mounted     = WorkloadState{MountsScrapeConfigFiles: true, RolledOut: true}
+		unmounted   = WorkloadState{RolledOut: true}
+	)
+
+	for _, tc := range []struct {
+		name       string
+		version    string
+		conf       []byte
+		workloads  []WorkloadState
+		filesExist bool
+
+		expectedErr            bool
+		expectedSecrets        int
+		expectedReason         string
+		expectedMessage        string
+		expectedDelete         bool
+		expectScrapeConfigFile bool
+		expectPreviousConfig   bool
+	}{
+		{
+			name:      "small configuration",
+			conf:      smallConfig,
+			workloads: []WorkloadState{unmounted},
+		},
+		{
+			name:           "configuration near the limit",
+			conf:           makeConfig(1, ConfigSizeWarningThreshold*2),
+			expectedReason: operator.ConfigurationSizeNearLimitReason,
+		},
+		{
+			name:                   "configuration above the limit without workload",
+			conf:                   largeConfig,
+			expectedSecrets:        3,
+			expectedReason:         operator.ConfigurationSizeNearLimitReason,
+			expectScrapeConfigFile: true,
+		},
+		{
+			name:                   "configuration above the limit with mounted files",
+			conf:                   largeConfig,
+			workloads:              []WorkloadState{mounted, mounted},
+			expectedSecrets:        3,
+			expectedReason:         operator.ConfigurationSizeNearLimitReason,
+			expectScrapeConfigFile: true,
+		},
+		{
+			name:                 "configuration above the limit with unmounted files",
+			conf:                 largeConfig,
+			workloads:            []WorkloadState{mounted, unmounted},
+			expectedSecrets:      3,
+			expectedReason:       operator.ConfigurationSizeNearLimitReason,
+			expectedMessage:      "will be updated once all the pods mount the scrape configuration files",
+			expectPreviousConfig: true,
+		},
+		{
+			name:                 "configuration above the limit during the rollout",
+			conf:                 largeConfig,
+			workloads:            []WorkloadState{{MountsScrapeConfigFiles: true}},
+			expectedSecrets:      3,
+			expectedReason:       operator.ConfigurationSizeNearLimitReason,
+			expectedMessage:      "will be updated once all the pods mount the scrape configuration files",
+			expectPreviousConfig: true,
+		},
+		{
+			name:        "configuration above the limit with unsupported version",
+			version:     "v2.42.0",
+			conf:        largeConfig,
+			expectedErr: true,
+		},
+		{
+			name:            "small configuration with mounted files",
+			conf:            smallConfig,
+			workloads:       []WorkloadState{mounted},
+			filesExist:      true,
+			expectedSecrets: 1,
+		},
+		{
+			name:            "small configuration while unmounting the files",
+			conf:            smallConfig,
+			workloads:       []WorkloadState{{}},
+			filesExist:      true,
+			expectedSecrets: 1,
+		},
+		{
+			name:           "small configuration with unmounted files",
+			conf:           smallConfig,
+			workloads:      []WorkloadState{unmounted},
+			filesExist:     true,
+			expectedDelete: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "ns",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Version: tc.version,
+					},
+				},
+			}
+
+			previous, err := MakeConfigurationSecret(p, Config{}, []byte("previous"))
+			require.NoError(t, err)
+			previous.Namespace = "ns"
+			objects := []runtime.Object{previous}
+			if tc.filesExist {
+				objects = append(objects, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "prometheus-test-scrape-config-files-0",
+						Namespace: "ns",
+					},
+				})
+			}
+			kclient := fake.NewClientset(objects...)
+
+			rt := &operator.ReconciliationTracker{}
+			rt.ResetStatus("ns/test")
+
+			cg := mustNewConfigGenerator(t, p)
+			scrapeConfigSecrets, err := ReconcileConfigurationSecrets(context.Background(), newLogger(), kclient, rt, p, Config{}, cg, 0, tc.conf, tc.workloads, tc.filesExist)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var deleted bool
+			for _, action := range kclient.Actions() {
+				if a, ok := action.(k8stesting.DeleteAction); ok && a.GetName() == "prometheus-test-scrape-config-files-0" {
+					deleted = true
+				}
+			}
+			require.Equal(t, tc.expectedDelete, deleted)
+
+			secrets, err := kclient.CoreV1().Secrets("ns").List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+			var n int
+			for _, s := range secrets.Items {
+				if strings.HasPrefix(s.Name, "prometheus-test-scrape-config-files-") {
+					n++
+				}
+			}
+			require.Equal(t, tc.expectedSecrets, n)
+
+			cond := rt.GetCondition("ns/test", 0)
+			require.Equal(t, tc.expectedReason, cond.Reason)
+			require.Contains(t, cond.Message, tc.expectedMessage)
+
+			s, err := kclient.CoreV1().Secrets("ns").Get(context.Background(), "prometheus-test", metav1.GetOptions{})
+			require.NoError(t, err)
+			r, err := gzip.NewReader(bytes.NewReader(s.Data[ConfigFilename]))
+			require.NoError(t, err)
+			conf, err := io.ReadAll(r)
+			require.NoError(t, err)
+
+			if tc.expectScrapeConfigFile || tc.expectPreviousConfig {
+				require.NotNil(t, scrapeConfigSecrets)
+				require.Equal(t, tc.expectedSecrets, scrapeConfigSecrets.Len())
+			} else {
+				require.Nil(t, scrapeConfigSecrets)
+			}
+
+			switch {
+			case tc.expectPreviousConfig:
+				require.Equal(t, "previous", string(conf))
+			case tc.expectScrapeConfigFile:
+				require.NotContains(t, string(conf), "scrape_configs")
+				require.Contains(t, string(conf), "scrape_config_files:\n- /etc/prometheus/config_out/scrape_config_files/*.yaml\n")
+			default:
+				require.Equal(t, string(tc.conf), string(conf))
+			}
+		})
+	}
+}
+
+func TestWorkloadState(t *testing.T) {
+	podSpec := corev1.PodSpec{
+		Volumes: []corev1.Volume{{Name: ScrapeConfigFilesVolumeName}},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		sset     appsv1.StatefulSetStatus
+		expected WorkloadState
+	}{
+		{
+			name: "rolled out",
+			sset: appsv1.StatefulSetStatus{
+				ObservedGeneration: 2,
+				Replicas:           2,
+				UpdatedReplicas:    2,
+				CurrentRevision:    "b",
+				UpdateRevision:     "b",
+			},
+			expected: WorkloadState{MountsScrapeConfigFiles: true, RolledOut: true},
+		},
+		{
+			name: "generation not observed",
+			sset: appsv1.StatefulSetStatus{
+				ObservedGeneration: 1,
+				Replicas:           2,
+				UpdatedReplicas:    2,
+				CurrentRevision:    "b",
+				UpdateRevision:     "b",
+			},
+			expected: WorkloadState{MountsScrapeConfigFiles: true},
+		},
+		{
+			name: "rollout in progress",
+			sset: appsv1.StatefulSetStatus{
+				ObservedGeneration: 2,
+				Replicas:           2,
+				UpdatedReplicas:    1,
+				CurrentRevision:    "a",
+				UpdateRevision:     "b",
+			},
+			expected: WorkloadState{MountsScrapeConfigFiles: true},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec: appsv1.StatefulSetSpec{
+					Template: corev1.PodTemplateSpec{Spec: podSpec},
+				},
+				Status: tc.sset,
+			}
+			require.Equal(t, tc.expected, StatefulSetState(sset))
+		})
+	}
+
+	dset := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Generation: 1},
+		Status: appsv1.DaemonSetStatus{
+			ObservedGeneration:     1,
+			DesiredNumberScheduled: 3,
+			UpdatedNumberScheduled: 2,
+		},
+	}
+	require.Equal(t, WorkloadState{}, DaemonSetState(dset))
+
+	dset.Status.UpdatedNumberScheduled = 3
+	require.Equal(t, WorkloadState{RolledOut: true}, DaemonSetState(dset))
+}
@@ -0,0 +1,282 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/hashstructure"
+	"gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+// ScrapeJobCache caches the scrape jobs generated for the ServiceMonitor,
+// PodMonitor, Probe and ScrapeConfig resources and for the federation sources
+// across reconciliations so that only the jobs which changed need to be
+// generated again.
+//
+// A cached job is reused when the resource (UID and resource version), the
+// resolved scrape class, the resource versions of the secrets and configmaps
+// read during the generation, the generator's settings (Prometheus version,
+// scrape-related fields of the Prometheus resource, generator options) and
+// the other inputs of the job (e.g. the HTTPRoute targets of a Probe) are
+// unchanged.
+//
+// ScrapeJobCache is safe for concurrent use.
+type ScrapeJobCache struct {
+	mtx sync.Mutex
+	// The jobs are grouped by Prometheus resource and shard.
+	scopes map[string]map[string]scrapeJobCacheEntry
+}
+
+type scrapeJobCacheEntry struct {
+	key    string
+	assets assets.AssetVersions
+	job    yaml.MapSlice
+}
+
+// NewScrapeJobCache returns an empty cache.
+func NewScrapeJobCache() *ScrapeJobCache {
+	return &ScrapeJobCache{
+		scopes: map[string]map[string]scrapeJobCacheEntry{},
+	}
+}
+
+// Forget removes the cached jobs of the Prometheus resource identified by
+// its namespace/name key.
+func (c *ScrapeJobCache) Forget(key string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for scope := range c.scopes {
+		if strings.HasPrefix(scope, key+"/") {
+			delete(c.scopes, scope)
+		}
+	}
+}
+
+// scrapeJobSession tracks the cached jobs used during the generation of a
+// configuration. The jobs which haven't been used are evicted from the cache
+// when the session is committed.
+//
+// A nil session is valid and always generates the jobs.
+type scrapeJobSession struct {
+	cache       *ScrapeJobCache
+	scope       string
+	fingerprint string
+
+	prev map[string]scrapeJobCacheEntry
+	next map[string]scrapeJobCacheEntry
+
+	hits, misses int
+}
+
+// newScrapeJobSession returns a new session for the configuration being
+// generated. It returns nil if the generator has no cache or if the
+// fingerprint of the generator can't be computed.
+func (cg *ConfigGenerator) newScrapeJobSession() *scrapeJobSession {
+	if cg.scrapeJobCache == nil {
+		return nil
+	}
+
+	fingerprint, err := cg.fingerprint()
+	if err != nil {
+		cg.logger.Warn("failed to compute the configuration fingerprint, disabling the scrape job cache", "err", err)
+		return nil
+	}
+
+	objMeta := cg.prom.GetObjectMeta()
+	scope := fmt.Sprintf("%s/%s/%d", objMeta.GetNamespace(), objMeta.GetName(), ptr.Deref(cg.shard, 0))
+
+	cg.scrapeJobCache.mtx.Lock()
+	prev := cg.scrapeJobCache.scopes[scope]
+	cg.scrapeJobCache.mtx.Unlock()
+
+	return &scrapeJobSession{
+		cache:       cg.scrapeJobCache,
+		scope:       scope,
+		fingerprint: fingerprint,
+		prev:        prev,
+		next:        make(map[string]scrapeJobCacheEntry, len(prev)),
+	}
+}
+
+// fingerprint returns a hash of the generator's settings which influence
+// the generation of the scrape jobs.
+func (cg *ConfigGenerator) fingerprint() (string, error) {
+	hash, err := hashstructure.Hash(struct {
+		Version                    string
+		CommonPrometheusFields     monitoringv1.CommonPrometheusFields
+		EndpointSliceSupported     bool
+		DaemonSet                  bool
+		PrometheusTopologySharding bool
+		PrometheusResourceSharding bool
+		InlineTLSConfig            bool
+		BypassVersionCheck         bool
+	}{
+		Version:                    cg.version.String(),
+		CommonPrometheusFields:     cg.prom.GetCommonPrometheusFields(),
+		EndpointSliceSupported:     cg.endpointSliceSupported,
+		DaemonSet:                  cg.daemonSet,
+		PrometheusTopologySharding: cg.prometheusTopologySharding,
+		PrometheusResourceSharding: cg.prometheusResourceSharding,
+		InlineTLSConfig:            cg.inlineTLSConfig,
+		BypassVersionCheck:         cg.bypassVersionCheck,
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d", hash), nil
+}
+
+// job returns the scrape job identified by name from the cache if it is
+// still valid, otherwise it calls generate and caches the result.
+//
+// deps holds the values which influence the generation of the job besides
+// the object (e.g. the resolved scrape class). The returned job is a copy of
+// the cached job which the caller is free to modify.
+func (s *scrapeJobSession) job(
+	name string,
+	objMeta metav1.ObjectMeta,
+	deps any,
+	store *assets.StoreBuilder,
+	generate func(*assets.StoreBuilder) (yaml.MapSlice, error),
+) (yaml.MapSlice, error) {
+	if s == nil {
+		return generate(store)
+	}
+
+	depsHash, err := hashstructure.Hash(deps, nil)
+	if err != nil {
+		return generate(store)
+	}
+
+	key := fmt.Sprintf("%s/%s/%s/%d", objMeta.UID, objMeta.ResourceVersion, s.fingerprint, depsHash)
+	if e, found := s.prev[name]; found && e.key == key && store.MatchAssetVersions(e.assets) {
+		s.hits++
+		s.next[name] = e
+		return copyMapSlice(e.job), nil
+	}
+
+	s.misses++
+	av := assets.AssetVersions{}
+	job, err := generate(store.WithAssetVersions(av))
+	if err != nil {
+		return nil, err
+	}
+
+	s.next[name] = scrapeJobCacheEntry{
+		key:    key,
+		assets: av,
+		job:    job,
+	}
+
+	return copyMapSlice(job), nil
+}
+
+// mustJob is like job for generators which can't fail.
+func (s *scrapeJobSession) mustJob(
+	name string,
+	objMeta metav1.ObjectMeta,
+	deps any,
+	store *assets.StoreBuilder,
+	generate func(*assets.StoreBuilder) yaml.MapSlice,
+) yaml.MapSlice {
+	job, _ := s.job(name, objMeta, deps, store, func(store *assets.StoreBuilder) (yaml.MapSlice, error) {
+		return generate(store), nil
+	})
+
+	return job
+}
+
+// copyMapSlice returns a deep copy of the YAML values built by the
+// configuration generator.
+func copyMapSlice(in yaml.MapSlice) yaml.MapSlice {
+	if in == nil {
+		return nil
+	}
+
+	out := make(yaml.MapSlice, len(in))
+	for i, item := range in {
+		out[i] = yaml.MapItem{Key: item.Key, Value: copyYAMLValue(item.Value)}
+	}
+
+	return out
+}
+
+func copyYAMLValue(v any) any {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		return copyMapSlice(v)
+	case yaml.MapItem:
+		return yaml.MapItem{Key: v.Key, Value: copyYAMLValue(v.Value)}
+	case []yaml.MapSlice:
+		if v == nil {
+			return v
+		}
+		out := make([]yaml.MapSlice, len(v))
+		for i := range v {
+			out[i] = copyMapSlice(v[i])
+		}
+		return out
+	case []any:
+		if v == nil {
+			return v
+		}
+		out := make([]any, len(v))
+		for i := range v {
+			out[i] = copyYAMLValue(v[i])
+		}
+		return out
+	case []string:
+		return slices.Clone(v)
+	case map[string]string:
+		return maps.Clone(v)
+	case map[string][]string:
+		if v == nil {
+			return v
+		}
+		out := make(map[string][]string, len(v))
+		for k, vv := range v {
+			out[k] = slices.Clone(vv)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// commit replaces the cached jobs of the session's scope by the jobs used
+// during the session.
+func (s *scrapeJobSession) commit(cg *ConfigGenerator) {
+	if s == nil {
+		return
+	}
+
+	s.cache.mtx.Lock()
+	s.cache.scopes[s.scope] = s.next
+	s.cache.mtx.Unlock()
+
+	cg.logger.Debug("scrape job cache", "hits", s.hits, "misses", s.misses)
+}
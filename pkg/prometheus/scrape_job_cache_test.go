@@ -0,0 +1,361 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+func makeCachedServiceMonitor(name, resourceVersion string) *monitoringv1.ServiceMonitor {
+	return &monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			UID:             types.UID("uid-" + name),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": name,
+				},
+			},
+			Endpoints: []monitoringv1.Endpoint{
+				{
+					Port:     "web",
+					Interval: "30s",
+					HTTPConfigWithProxyAndTLSFiles: monitoringv1.HTTPConfigWithProxyAndTLSFiles{
+						HTTPConfigWithTLSFiles: monitoringv1.HTTPConfigWithTLSFiles{
+							HTTPConfigWithoutTLS: monitoringv1.HTTPConfigWithoutTLS{
+								BasicAuth: &monitoringv1.BasicAuth{
+									Username: corev1.SecretKeySelector{
+										LocalObjectReference: corev1.LocalObjectReference{Name: "auth-" + name},
+										Key:                  "username",
+									},
+									Password: corev1.SecretKeySelector{
+										LocalObjectReference: corev1.LocalObjectReference{Name: "auth-" + name},
+										Key:                  "password",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func makeCachedSecret(name, resourceVersion, password string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "auth-" + name,
+			Namespace:       "default",
+			ResourceVersion: resourceVersion,
+		},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte(password),
+		},
+	}
+}
+
+func TestScrapeJobCache(t *testing.T) {
+	p := defaultPrometheus()
+	p.Spec.ServiceMonitorSelector = &metav1.LabelSelector{}
+
+	generate := func(t *testing.T, p *monitoringv1.Prometheus, sMons map[string]*monitoringv1.ServiceMonitor, store *assets.StoreBuilder, opts ...ConfigGeneratorOption) string {
+		t.Helper()
+
+		cg := mustNewConfigGenerator(t, p, opts...)
+		cfg, err := cg.GenerateServerConfiguration(p, sMons, nil, nil, nil, store, nil, nil, nil, nil)
+		require.NoError(t, err)
+
+		return string(cfg)
+	}
+
+	setup := func() (map[string]*monitoringv1.ServiceMonitor, *assets.StoreBuilder) {
+		return map[string]*monitoringv1.ServiceMonitor{
+			"default/sm1": makeCachedServiceMonitor("sm1", "1"),
+			"default/sm2": makeCachedServiceMonitor("sm2", "1"),
+		}, assets.NewTestStoreBuilder(
+			makeCachedSecret("sm1", "1", "pass1"),
+			makeCachedSecret("sm2", "1", "pass2"),
+		)
+	}
+
+	t.Run("identical output", func(t *testing.T) {
+		sMons, store := setup()
+		cache := NewScrapeJobCache()
+
+		expected := generate(t, p, sMons, store)
+		for range 3 {
+			require.Equal(t, expected, generate(t, p, sMons, store, WithScrapeJobCache(cache)))
+		}
+	})
+
+	t.Run("monitor updated", func(t *testing.T) {
+		sMons, store := setup()
+		cache := NewScrapeJobCache()
+		generate(t, p, sMons, store, WithScrapeJobCache(cache))
+
+		// The cached job is reused as long as the resource version doesn't
+		// change.
+		sMons["default/sm1"].Spec.Endpoints[0].Interval = "10s"
+		require.NotContains(t, generate(t, p, sMons, store, WithScrapeJobCache(cache)), "scrape_interval: 10s")
+
+		sMons["default/sm1"].ResourceVersion = "2"
+		got := generate(t, p, sMons, store, WithScrapeJobCache(cache))
+		require.Contains(t, got, "scrape_interval: 10s")
+		require.Equal(t, generate(t, p, sMons, store), got)
+	})
+
+	t.Run("secret updated", func(t *testing.T) {
+		sMons, store := setup()
+		cache := NewScrapeJobCache()
+		generate(t, p, sMons, store, WithScrapeJobCache(cache))
+
+		require.NoError(t, store.UpdateObject(makeCachedSecret("sm2", "2", "updated")))
+		got := generate(t, p, sMons, store, WithScrapeJobCache(cache))
+		require.Contains(t, got, "password: updated")
+		require.Contains(t, got, "password: pass1")
+		require.Equal(t, generate(t, p, sMons, store), got)
+	})
+
+	t.Run("secret created", func(t *testing.T) {
+		sMons, _ := setup()
+		store := assets.NewTestStoreBuilder(makeCachedSecret("sm1", "1", "pass1"))
+		cache := NewScrapeJobCache()
+		generate(t, p, sMons, store, WithScrapeJobCache(cache))
+
+		require.NoError(t, store.UpdateObject(makeCachedSecret("sm2", "1", "pass2")))
+		got := generate(t, p, sMons, store, WithScrapeJobCache(cache))
+		require.Contains(t, got, "password: pass2")
+	})
+
+	t.Run("prometheus updated", func(t *testing.T) {
+		sMons, store := setup()
+		cache := NewScrapeJobCache()
+		generate(t, p, sMons, store, WithScrapeJobCache(cache))
+
+		p := p.DeepCopy()
+		p.Spec.EnforcedSampleLimit = ptr.To(uint64(1000))
+		got := generate(t, p, sMons, store, WithScrapeJobCache(cache))
+		require.Contains(t, got, "sample_limit: 1000")
+		require.Equal(t, generate(t, p, sMons, store), got)
+	})
+
+	t.Run("scrape class assigned", func(t *testing.T) {
+		sMons, store := setup()
+		cache := NewScrapeJobCache()
+
+		p := p.DeepCopy()
+		p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{
+			{
+				Name: "team-a",
+				Relabelings: []monitoringv1.RelabelConfig{
+					{
+						TargetLabel: "team",
+						Replacement: ptr.To("a"),
+					},
+				},
+			},
+		}
+		generate(t, p, sMons, store, WithScrapeJobCache(cache))
+
+		// The scrape class selected by the namespace's labels is set
+		// without changing the resource version.
+		sMons["default/sm1"].Spec.ScrapeClassName = ptr.To("team-a")
+		got := generate(t, p, sMons, store, WithScrapeJobCache(cache))
+		require.Contains(t, got, "replacement: a")
+		require.Equal(t, generate(t, p, sMons, store), got)
+	})
+
+	t.Run("probe routes updated", func(t *testing.T) {
+		cache := NewScrapeJobCache()
+		store := assets.NewTestStoreBuilder()
+		probes := map[string]*monitoringv1.Probe{
+			"probe": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "probe1",
+					Namespace:       "default",
+					UID:             types.UID("uid-probe1"),
+					ResourceVersion: "1",
+				},
+				Spec: monitoringv1.ProbeSpec{
+					ProberSpec: monitoringv1.ProberSpec{
+						URL: "blackbox-exporter:9115",
+					},
+					Targets: monitoringv1.ProbeTargets{
+						HTTPRoute: &monitoringv1.ProbeTargetHTTPRoute{},
+					},
+				},
+			},
+		}
+
+		generate := func(url string, opts ...ConfigGeneratorOption) string {
+			cg := mustNewConfigGenerator(t, p, append(opts, WithProbeHTTPRoutes(map[string][]HTTPRouteTarget{
+				"default/probe1": {{Namespace: "default", Name: "route", URLs: []string{url}}},
+			}))...)
+			cfg, err := cg.GenerateServerConfiguration(p, nil, nil, probes, nil, store, nil, nil, nil, nil)
+			require.NoError(t, err)
+
+			return string(cfg)
+		}
+
+		generate("https://a.example.com/", WithScrapeJobCache(cache))
+		got := generate("https://b.example.com/", WithScrapeJobCache(cache))
+		require.Contains(t, got, "https://b.example.com/")
+		require.Equal(t, generate("https://b.example.com/"), got)
+	})
+
+	t.Run("scrape config reused", func(t *testing.T) {
+		cache := NewScrapeJobCache()
+		store := assets.NewTestStoreBuilder()
+		sCons := map[string]*monitoringv1alpha1.ScrapeConfig{
+			"default/sc1": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "sc1",
+					Namespace:       "default",
+					UID:             types.UID("uid-sc1"),
+					ResourceVersion: "1",
+				},
+				Spec: monitoringv1alpha1.ScrapeConfigSpec{
+					StaticConfigs: []monitoringv1alpha1.StaticConfig{
+						{
+							Targets: []monitoringv1alpha1.Target{"localhost:9100"},
+						},
+					},
+				},
+			},
+		}
+
+		generate := func() string {
+			cg := mustNewConfigGenerator(t, p, WithScrapeJobCache(cache))
+			cfg, err := cg.GenerateServerConfiguration(p, nil, nil, nil, sCons, store, nil, nil, nil, nil)
+			require.NoError(t, err)
+
+			return string(cfg)
+		}
+
+		generate()
+		sCons["default/sc1"].Spec.StaticConfigs[0].Targets = []monitoringv1alpha1.Target{"localhost:9200"}
+		require.Contains(t, generate(), "localhost:9100")
+
+		sCons["default/sc1"].ResourceVersion = "2"
+		require.Contains(t, generate(), "localhost:9200")
+	})
+
+	t.Run("returned job is a copy", func(t *testing.T) {
+		cache := NewScrapeJobCache()
+		cg := mustNewConfigGenerator(t, p, WithScrapeJobCache(cache))
+		objMeta := metav1.ObjectMeta{UID: types.UID("uid"), ResourceVersion: "1"}
+		store := assets.NewTestStoreBuilder()
+
+		expected := yaml.MapSlice{
+			{Key: "relabel_configs", Value: []yaml.MapSlice{{{Key: "action", Value: "keep"}}}},
+		}
+
+		s := cg.newScrapeJobSession()
+		job := s.mustJob("job", objMeta, nil, store, func(*assets.StoreBuilder) yaml.MapSlice {
+			return copyMapSlice(expected)
+		})
+		job[0].Value.([]yaml.MapSlice)[0][0].Value = "drop"
+		s.commit(cg)
+
+		s = cg.newScrapeJobSession()
+		job = s.mustJob("job", objMeta, nil, store, func(*assets.StoreBuilder) yaml.MapSlice {
+			require.FailNow(t, "unexpected generation")
+			return nil
+		})
+		require.Equal(t, expected, job)
+
+		job[0].Value = nil
+		require.Equal(t, expected, cache.scopes["default/test/0"]["job"].job)
+	})
+
+	t.Run("monitor deleted", func(t *testing.T) {
+		sMons, store := setup()
+		cache := NewScrapeJobCache()
+		generate(t, p, sMons, store, WithScrapeJobCache(cache))
+		require.Len(t, cache.scopes["default/test/0"], 2)
+
+		delete(sMons, "default/sm2")
+		generate(t, p, sMons, store, WithScrapeJobCache(cache))
+		require.Len(t, cache.scopes["default/test/0"], 1)
+	})
+
+	t.Run("forget", func(t *testing.T) {
+		sMons, store := setup()
+		cache := NewScrapeJobCache()
+		generate(t, p, sMons, store, WithScrapeJobCache(cache))
+
+		cache.Forget("default/other")
+		require.Len(t, cache.scopes, 1)
+
+		cache.Forget("default/test")
+		require.Empty(t, cache.scopes)
+	})
+}
+
+func BenchmarkGenerateServerConfiguration(b *testing.B) {
+	p := defaultPrometheus()
+	p.Spec.ServiceMonitorSelector = &metav1.LabelSelector{}
+
+	sMons := make(map[string]*monitoringv1.ServiceMonitor, 500)
+	objects := make([]any, 0, 500)
+	for i := range 500 {
+		name := fmt.Sprintf("sm%d", i)
+		sMons["default/"+name] = makeCachedServiceMonitor(name, "1")
+		objects = append(objects, makeCachedSecret(name, "1", "pass"))
+	}
+	store := assets.NewTestStoreBuilder(objects...)
+
+	for _, tc := range []struct {
+		name string
+		opts []ConfigGeneratorOption
+	}{
+		{
+			name: "without cache",
+		},
+		{
+			name: "with cache",
+			opts: []ConfigGeneratorOption{WithScrapeJobCache(NewScrapeJobCache())},
+		},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			cg, err := NewConfigGenerator(slog.New(slog.DiscardHandler), p, tc.opts...)
+			require.NoError(b, err)
+
+			for b.Loop() {
+				_, err := cg.GenerateServerConfiguration(p, sMons, nil, nil, nil, store, nil, nil, nil, nil)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
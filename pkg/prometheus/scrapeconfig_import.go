@@ -0,0 +1,743 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	k8syaml "sigs.k8s.io/yaml"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+var (
+	invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+	invalidSecretKeyChars    = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+
+	secretKeySelectorType = reflect.TypeFor[corev1.SecretKeySelector]()
+	secretOrConfigMapType = reflect.TypeFor[monitoringv1.SecretOrConfigMap]()
+)
+
+// renamedScrapeConfigFields maps the Prometheus fields to the ScrapeConfig
+// fields when their names differ.
+var renamedScrapeConfigFields = map[string]string{
+	"relabel_configs":        "relabelings",
+	"metric_relabel_configs": "metricRelabelings",
+}
+
+var kubernetesRoles = []string{
+	string(monitoringv1alpha1.KubernetesRolePod),
+	string(monitoringv1alpha1.KubernetesRoleEndpoint),
+	string(monitoringv1alpha1.KubernetesRoleIngress),
+	string(monitoringv1alpha1.KubernetesRoleService),
+	string(monitoringv1alpha1.KubernetesRoleNode),
+	string(monitoringv1alpha1.KubernetesRoleEndpointSlice),
+}
+
+// scrapeConfigEnums lists the values of the ScrapeConfig fields whose case
+// differs from Prometheus, indexed by struct type and JSON field name. The
+// Prometheus values are matched ignoring the case and the underscores.
+var scrapeConfigEnums = map[reflect.Type]map[string][]string{
+	reflect.TypeFor[monitoringv1alpha1.KubernetesSDConfig](): {"role": kubernetesRoles},
+	reflect.TypeFor[monitoringv1alpha1.K8SSelectorConfig]():  {"role": kubernetesRoles},
+	reflect.TypeFor[monitoringv1alpha1.OpenStackSDConfig](): {"role": {
+		string(monitoringv1alpha1.OpenStackRoleInstance),
+		string(monitoringv1alpha1.OpenStackRoleHypervisor),
+		string(monitoringv1alpha1.OpenStackRoleLoadBalancer),
+	}},
+	reflect.TypeFor[monitoringv1alpha1.DockerSwarmSDConfig](): {"role": {"Services", "Tasks", "Nodes"}},
+	reflect.TypeFor[monitoringv1alpha1.ScalewaySDConfig](): {"role": {
+		string(monitoringv1alpha1.ScalewayRoleInstance),
+		string(monitoringv1alpha1.ScalewayRoleBaremetal),
+	}},
+	reflect.TypeFor[monitoringv1alpha1.OVHCloudSDConfig](): {"service": {
+		string(monitoringv1alpha1.OVHServiceVPS),
+		string(monitoringv1alpha1.OVHServiceDedicatedServer),
+	}},
+}
+
+// ScrapeConfigImportOptions defines the parameters of the conversion of
+// Prometheus scrape configurations into ScrapeConfig resources.
+type ScrapeConfigImportOptions struct {
+	// Namespace is the namespace of the generated objects.
+	Namespace string
+	// Labels are added to the generated ScrapeConfig objects (e.g. to match
+	// the scrapeConfigSelector of the Prometheus object).
+	Labels map[string]string
+}
+
+// ScrapeConfigImportResult holds the objects converted from Prometheus scrape
+// configurations.
+type ScrapeConfigImportResult struct {
+	// ScrapeConfigs contains one object per scrape job, in the same order as
+	// the input.
+	ScrapeConfigs []*monitoringv1alpha1.ScrapeConfig
+	// Secrets holds the credentials extracted from the scrape jobs.
+	Secrets []*corev1.Secret
+	// Warnings lists the fields which couldn't be converted.
+	Warnings []string
+
+	namespace string
+	// jobs holds the input scrape configurations, in the same order as
+	// ScrapeConfigs.
+	jobs []map[string]any
+}
+
+// ImportScrapeConfigs converts Prometheus scrape configurations into
+// ScrapeConfig objects. The input is either a list of scrape configurations
+// (e.g. the content of the additionalScrapeConfigs Secret) or a Prometheus
+// configuration file with a `scrape_configs` field.
+//
+// Inline credentials are moved to Secret objects, one per ScrapeConfig object
+// and with the same name.
+func ImportScrapeConfigs(b []byte, opts ScrapeConfigImportOptions) (*ScrapeConfigImportResult, error) {
+	jobs, others, err := decodeScrapeJobs(b)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ScrapeConfigImportResult{namespace: opts.Namespace}
+	im := &scrapeConfigImporter{seen: map[string]struct{}{}}
+
+	for _, k := range others {
+		im.warn("%q: only the scrape configurations are converted", k)
+	}
+
+	names := map[string]struct{}{}
+	for i, job := range jobs {
+		jobName, _ := job["job_name"].(string)
+		if jobName == "" {
+			return nil, fmt.Errorf("scrape_configs[%d]: missing job_name", i)
+		}
+
+		name := uniqueResourceName(scrapeConfigResourceName(jobName, i), names)
+		secret := map[string]string{}
+		im.scope = scrapeConfigImportScope{job: jobName, name: name, secret: secret}
+
+		v := im.convert("", job, reflect.TypeFor[monitoringv1alpha1.ScrapeConfigSpec]())
+
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("job %q: %w", jobName, err)
+		}
+
+		sc := &monitoringv1alpha1.ScrapeConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+				Kind:       monitoringv1alpha1.ScrapeConfigsKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: opts.Namespace,
+				Labels:    opts.Labels,
+			},
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&sc.Spec); err != nil {
+			return nil, fmt.Errorf("job %q: %w", jobName, err)
+		}
+
+		res.ScrapeConfigs = append(res.ScrapeConfigs, sc)
+		res.jobs = append(res.jobs, job)
+
+		if len(secret) == 0 {
+			continue
+		}
+
+		res.Secrets = append(res.Secrets, &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "Secret",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: opts.Namespace,
+			},
+			StringData: secret,
+		})
+	}
+	res.Warnings = im.warnings
+
+	return res, nil
+}
+
+// decodeScrapeJobs returns the scrape configurations and the other top-level
+// fields of the configuration.
+func decodeScrapeJobs(b []byte) ([]map[string]any, []string, error) {
+	v, err := decodeYAML(b)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse the configuration: %w", err)
+	}
+
+	var (
+		list   []any
+		others []string
+	)
+	switch t := v.(type) {
+	case []any:
+		list = t
+	case map[string]any:
+		l, ok := t["scrape_configs"].([]any)
+		if !ok && t["scrape_configs"] != nil {
+			return nil, nil, fmt.Errorf("scrape_configs: expected a list")
+		}
+		list = l
+
+		for _, k := range sortutil.SortedKeys(t) {
+			if k != "scrape_configs" {
+				others = append(others, k)
+			}
+		}
+	case nil:
+	default:
+		return nil, nil, fmt.Errorf("expected a list of scrape configurations or a Prometheus configuration")
+	}
+
+	jobs := make([]map[string]any, 0, len(list))
+	for i, item := range list {
+		job, ok := item.(map[string]any)
+		if !ok {
+			return nil, nil, fmt.Errorf("scrape_configs[%d]: expected a map", i)
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, others, nil
+}
+
+// decodeYAML decodes YAML data into its JSON representation.
+func decodeYAML(b []byte) (any, error) {
+	j, err := k8syaml.YAMLToJSON(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(j))
+	// Keep the numbers as-is to avoid precision loss and exponent notation.
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// scrapeConfigResourceName returns a valid object name for the scrape job.
+func scrapeConfigResourceName(jobName string, i int) string {
+	name := strings.Trim(invalidResourceNameChars.ReplaceAllString(strings.ToLower(jobName), "-"), "-")
+	if name == "" {
+		return fmt.Sprintf("scrape-config-%d", i)
+	}
+
+	if len(name) > validation.DNS1123SubdomainMaxLength-4 {
+		name = strings.TrimRight(name[:validation.DNS1123SubdomainMaxLength-4], "-")
+	}
+
+	return name
+}
+
+func uniqueResourceName(name string, names map[string]struct{}) string {
+	candidate := name
+	for i := 1; ; i++ {
+		if _, found := names[candidate]; !found {
+			names[candidate] = struct{}{}
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s-%d", name, i)
+	}
+}
+
+type scrapeConfigImporter struct {
+	scope scrapeConfigImportScope
+
+	warnings []string
+	seen     map[string]struct{}
+}
+
+// scrapeConfigImportScope holds the state of the scrape job being converted.
+type scrapeConfigImportScope struct {
+	job    string
+	name   string
+	secret map[string]string
+}
+
+func (im *scrapeConfigImporter) warn(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	if _, found := im.seen[msg]; found {
+		return
+	}
+
+	im.seen[msg] = struct{}{}
+	im.warnings = append(im.warnings, msg)
+}
+
+func (im *scrapeConfigImporter) unsupported(path string) {
+	if strings.HasSuffix(path, "_file") {
+		im.warn("job %q: %q references a file which can't be converted, store its content in a Secret and update the ScrapeConfig resource", im.scope.job, path)
+		return
+	}
+
+	im.warn("job %q: %q isn't supported by the ScrapeConfig CRD and has been dropped", im.scope.job, path)
+}
+
+func (im *scrapeConfigImporter) secretRef(path, value string) map[string]any {
+	key := strings.Trim(invalidSecretKeyChars.ReplaceAllString(path, "_"), "_")
+	im.scope.secret[key] = value
+
+	return map[string]any{"name": im.scope.name, "key": key}
+}
+
+// convert transforms the Prometheus representation of a field into the JSON
+// representation of the t type. The Prometheus field names are matched with
+// the JSON names ignoring the case and the underscores, secrets are replaced
+// by references to the Secret object and the fields which don't exist in the
+// t type are reported and dropped.
+func (im *scrapeConfigImporter) convert(path string, in any, t reflect.Type) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case secretKeySelectorType:
+		s, ok := in.(string)
+		if !ok {
+			im.unsupported(path)
+			return nil
+		}
+		return im.secretRef(path, s)
+
+	case secretOrConfigMapType:
+		s, ok := in.(string)
+		if !ok {
+			im.unsupported(path)
+			return nil
+		}
+		return map[string]any{"secret": im.secretRef(path, s)}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := in.(map[string]any)
+		if !ok {
+			return in
+		}
+		out := im.convertStruct(path, m, t)
+		if len(out) == 0 && path != "" {
+			// All the fields have been dropped.
+			return nil
+		}
+		return out
+
+	case reflect.Slice:
+		l, ok := in.([]any)
+		if !ok {
+			return in
+		}
+
+		out := make([]any, 0, len(l))
+		for i, item := range l {
+			out = append(out, im.convert(fmt.Sprintf("%s.%d", path, i), item, t.Elem()))
+		}
+		return out
+
+	case reflect.Map:
+		m, ok := in.(map[string]any)
+		if !ok {
+			return in
+		}
+
+		out := make(map[string]any, len(m))
+		for _, k := range sortutil.SortedKeys(m) {
+			out[k] = im.convert(joinPath(path, k), m[k], t.Elem())
+		}
+		return out
+
+	case reflect.String:
+		// YAML scalars such as `port: 8080` or `replacement: 1` are decoded
+		// as numbers or booleans.
+		switch v := in.(type) {
+		case json.Number:
+			return v.String()
+		case bool:
+			return strconv.FormatBool(v)
+		}
+	}
+
+	return in
+}
+
+func (im *scrapeConfigImporter) convertStruct(path string, in map[string]any, t reflect.Type) map[string]any {
+	fields := jsonFields(t)
+	out := map[string]any{}
+
+	for _, k := range sortutil.SortedKeys(in) {
+		p := joinPath(path, k)
+
+		switch k {
+		case "bearer_token":
+			if _, found := fields["authorization"]; found {
+				s, _ := in[k].(string)
+				out["authorization"] = map[string]any{
+					"type":        "Bearer",
+					"credentials": im.secretRef(p, s),
+				}
+				continue
+			}
+		}
+
+		name := k
+		if renamed, found := renamedScrapeConfigFields[k]; found && path == "" {
+			name = renamed
+		}
+
+		f, found := lookupJSONField(fields, name)
+		if !found {
+			im.unsupported(p)
+			continue
+		}
+
+		value := in[k]
+		if values, found := scrapeConfigEnums[t][f.name]; found {
+			value = enumValue(value, values)
+		}
+
+		if v := im.convert(p, value, f.typ); v != nil {
+			out[f.name] = v
+		}
+	}
+
+	return out
+}
+
+// enumValue returns the value of the enumeration matching v.
+func enumValue(v any, values []string) any {
+	s, ok := v.(string)
+	if !ok {
+		return v
+	}
+
+	for _, value := range values {
+		if normalizeFieldName(s) == normalizeFieldName(value) {
+			return value
+		}
+	}
+
+	return v
+}
+
+func joinPath(path, k string) string {
+	if path == "" {
+		return k
+	}
+
+	return path + "." + k
+}
+
+type jsonField struct {
+	name string
+	typ  reflect.Type
+}
+
+// jsonFields returns the JSON fields of a struct type indexed by their
+// normalized name. The fields of inlined structs are included.
+func jsonFields(t reflect.Type) map[string]jsonField {
+	fields := map[string]jsonField{}
+
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" && sf.Anonymous {
+			for k, v := range jsonFields(sf.Type) {
+				fields[k] = v
+			}
+			continue
+		}
+
+		if name == "" {
+			name = sf.Name
+		}
+
+		fields[normalizeFieldName(name)] = jsonField{name: name, typ: sf.Type}
+	}
+
+	return fields
+}
+
+// lookupJSONField returns the field matching the Prometheus field name. The
+// secret fields may have a suffix in the CRD (e.g. `token` and `tokenRef`).
+func lookupJSONField(fields map[string]jsonField, name string) (jsonField, bool) {
+	n := normalizeFieldName(name)
+	if f, found := fields[n]; found {
+		return f, true
+	}
+
+	for _, suffix := range []string{"ref", "secret"} {
+		if f, found := fields[n+suffix]; found && isSecretType(f.typ) {
+			return f, true
+		}
+	}
+
+	return jsonField{}, false
+}
+
+func isSecretType(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t == secretKeySelectorType
+}
+
+func normalizeFieldName(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+// Verify generates the Prometheus configuration of the ScrapeConfig objects
+// and compares it with the input scrape configurations. It returns the
+// differences which aren't expected from the operator (e.g. the job name and
+// the additional relabeling rules aren't reported).
+func (r *ScrapeConfigImportResult) Verify(logger *slog.Logger) ([]string, error) {
+	ns := r.namespace
+	if ns == "" {
+		ns = metav1.NamespaceDefault
+	}
+
+	cg, err := NewConfigGenerator(logger, &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "verify",
+			Namespace: ns,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	objs := make([]any, 0, len(r.Secrets))
+	for _, s := range r.Secrets {
+		s = s.DeepCopy()
+		s.Namespace = ns
+		// Mimic the API server which converts stringData into data.
+		s.Data = make(map[string][]byte, len(s.StringData))
+		for k, v := range s.StringData {
+			s.Data[k] = []byte(v)
+		}
+		objs = append(objs, s)
+	}
+	store := assets.NewTestStoreBuilder(objs...)
+
+	var diffs []string
+	for i, sc := range r.ScrapeConfigs {
+		sc = sc.DeepCopy()
+		sc.Namespace = ns
+
+		generated, err := cg.generateScrapeConfig(sc, store.ForNamespace(ns), 1)
+		if err != nil {
+			return nil, fmt.Errorf("job %q: failed to generate the configuration: %w", ptr.Deref(sc.Spec.JobName, sc.Name), err)
+		}
+
+		b, err := yaml.Marshal(generated)
+		if err != nil {
+			return nil, err
+		}
+
+		got, err := decodeYAML(b)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range diffScrapeConfig("", r.jobs[i], got) {
+			diffs = append(diffs, fmt.Sprintf("job %q: %s", ptr.Deref(sc.Spec.JobName, sc.Name), d))
+		}
+	}
+
+	return diffs, nil
+}
+
+// diffScrapeConfig returns the semantic differences between the input and
+// the generated scrape configuration.
+func diffScrapeConfig(path string, want, got any) []string {
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected %v, got %v", path, want, got)}
+		}
+
+		if tok, found := w["bearer_token"]; found {
+			// The bearer token is converted to the authorization field.
+			w = maps.Clone(w)
+			delete(w, "bearer_token")
+			w["authorization"] = map[string]any{"credentials": tok}
+		}
+
+		if auth, ok := w["authorization"].(map[string]any); ok && auth["type"] == nil {
+			// The authorization type defaults to Bearer.
+			w = maps.Clone(w)
+			w["authorization"] = maps.Clone(auth)
+			w["authorization"].(map[string]any)["type"] = "Bearer"
+		}
+
+		var diffs []string
+		for _, k := range sortutil.SortedKeys(w) {
+			p := joinPath(path, k)
+
+			switch {
+			case path == "" && k == "job_name":
+				// The operator generates the job name and sets the `job`
+				// label with a relabeling rule.
+				continue
+
+			case path == "" && (k == "relabel_configs" || k == "metric_relabel_configs"):
+				if !isSubsequence(normalizeRelabelConfigs(w[k]), normalizeRelabelConfigs(g[k])) {
+					diffs = append(diffs, fmt.Sprintf("%s: the generated rules don't include the input rules", p))
+				}
+				continue
+			}
+
+			v, found := g[k]
+			if !found {
+				// Inline secrets may be written to files.
+				if _, found := g[k+"_file"]; found {
+					continue
+				}
+				diffs = append(diffs, fmt.Sprintf("%s: missing from the generated configuration", p))
+				continue
+			}
+
+			diffs = append(diffs, diffScrapeConfig(p, w[k], v)...)
+		}
+
+		for _, k := range sortutil.SortedKeys(g) {
+			if _, found := w[k]; found {
+				continue
+			}
+
+			if path == "" && (k == "relabel_configs" || k == "metric_relabel_configs") {
+				continue
+			}
+
+			if isEmptyValue(g[k]) {
+				continue
+			}
+
+			if strings.HasSuffix(k, "_file") {
+				if _, found := w[strings.TrimSuffix(k, "_file")]; found {
+					continue
+				}
+			}
+
+			diffs = append(diffs, fmt.Sprintf("%s: unexpected value %v in the generated configuration", joinPath(path, k), g[k]))
+		}
+
+		return diffs
+
+	case []any:
+		g, ok := got.([]any)
+		if !ok || len(g) != len(w) {
+			return []string{fmt.Sprintf("%s: expected %v, got %v", path, want, got)}
+		}
+
+		var diffs []string
+		for i := range w {
+			diffs = append(diffs, diffScrapeConfig(fmt.Sprintf("%s.%d", path, i), w[i], g[i])...)
+		}
+		return diffs
+	}
+
+	if fmt.Sprint(want) != fmt.Sprint(got) {
+		return []string{fmt.Sprintf("%s: expected %v, got %v", path, want, got)}
+	}
+
+	return nil
+}
+
+func isEmptyValue(v any) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case []any:
+		return len(t) == 0
+	case map[string]any:
+		return len(t) == 0
+	}
+
+	return false
+}
+
+// normalizeRelabelConfigs returns the relabeling rules with the default
+// values removed.
+func normalizeRelabelConfigs(v any) []any {
+	l, _ := v.([]any)
+
+	out := make([]any, 0, len(l))
+	for _, item := range l {
+		m, ok := item.(map[string]any)
+		if !ok {
+			out = append(out, item)
+			continue
+		}
+
+		n := make(map[string]any, len(m))
+		for k, v := range m {
+			if k == "action" {
+				v = strings.ToLower(fmt.Sprint(v))
+			}
+			n[k] = v
+		}
+		out = append(out, n)
+	}
+
+	return out
+}
+
+// isSubsequence returns true if all the items of sub are found in l in the
+// same order.
+func isSubsequence(sub, l []any) bool {
+	i := 0
+	for _, item := range l {
+		if i < len(sub) && reflect.DeepEqual(fmt.Sprint(sub[i]), fmt.Sprint(item)) {
+			i++
+		}
+	}
+
+	return i == len(sub)
+}
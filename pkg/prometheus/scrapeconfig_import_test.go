@@ -0,0 +1,193 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+func TestImportScrapeConfigs(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		config   string
+		golden   string
+		warnings []string
+		diffs    []string
+		err      bool
+	}{
+		{
+			name: "additional scrape configs",
+			config: `- job_name: node
+  scrape_interval: 15s
+  honor_labels: true
+  params:
+    module: [http_2xx]
+  basic_auth:
+    username: admin
+    password: s3cr3t
+  tls_config:
+    insecure_skip_verify: true
+  static_configs:
+  - targets: ['node1:9100', 'node2:9100']
+    labels:
+      env: prod
+  relabel_configs:
+  - source_labels: [__address__]
+    regex: (.*):9100
+    target_label: instance
+    replacement: $1
+    action: replace
+  metric_relabel_configs:
+  - action: drop
+    regex: go_.*
+    source_labels: [__name__]
+  sample_limit: 1000000
+- job_name: Kubernetes Pods
+  bearer_token: token
+  kubernetes_sd_configs:
+  - role: pod
+    namespaces:
+      own_namespace: true
+    selectors:
+    - role: pod
+      label: app=foo
+`,
+			golden: "ImportScrapeConfigs_additional_scrape_configs.golden",
+		},
+		{
+			name: "service discovery",
+			config: `scrape_configs:
+- job_name: sd
+  consul_sd_configs:
+  - server: consul:8500
+    token: consul-token
+    services: [a, b]
+  ec2_sd_configs:
+  - region: eu-west-1
+    access_key: access
+    secret_key: secret
+  openstack_sd_configs:
+  - role: loadbalancer
+    region: r
+    password: password
+  ovhcloud_sd_configs:
+  - application_key: key
+    application_secret: secret
+    consumer_key: consumer
+    service: dedicated_server
+  http_sd_configs:
+  - url: http://sd.example.com
+    oauth2:
+      client_id: id
+      client_secret: secret
+      token_url: http://token.example.com
+  dockerswarm_sd_configs:
+  - host: unix:///var/run/docker.sock
+    role: nodes
+`,
+			golden: "ImportScrapeConfigs_service_discovery.golden",
+		},
+		{
+			name: "unsupported fields",
+			config: `global:
+  scrape_interval: 30s
+scrape_configs:
+- job_name: unsupported
+  http_headers:
+    foo:
+      values: [bar]
+  tls_config:
+    ca_file: /etc/prometheus/ca.pem
+  ec2_sd_configs:
+  - region: eu-west-1
+    profile: default
+`,
+			golden: "ImportScrapeConfigs_unsupported_fields.golden",
+			warnings: []string{
+				`"global": only the scrape configurations are converted`,
+				`job "unsupported": "ec2_sd_configs.0.profile" isn't supported by the ScrapeConfig CRD and has been dropped`,
+				`job "unsupported": "http_headers" isn't supported by the ScrapeConfig CRD and has been dropped`,
+				`job "unsupported": "tls_config.ca_file" references a file which can't be converted, store its content in a Secret and update the ScrapeConfig resource`,
+			},
+			diffs: []string{
+				`job "unsupported": ec2_sd_configs.0.profile: missing from the generated configuration`,
+				`job "unsupported": http_headers: missing from the generated configuration`,
+				`job "unsupported": tls_config: missing from the generated configuration`,
+			},
+		},
+		{
+			name: "numeric values",
+			config: `- job_name: numeric
+  static_configs:
+  - targets: [a:80]
+    labels:
+      port: 8080
+      canary: true
+  relabel_configs:
+  - target_label: shard
+    replacement: 1
+`,
+			golden: "ImportScrapeConfigs_numeric_values.golden",
+		},
+		{
+			name:   "missing job name",
+			config: `- static_configs: [{targets: [localhost:9090]}]`,
+			err:    true,
+		},
+		{
+			name:   "invalid type",
+			config: `- {job_name: invalid, sample_limit: foo}`,
+			err:    true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := ImportScrapeConfigs([]byte(tc.config), ScrapeConfigImportOptions{
+				Namespace: "default",
+				Labels:    map[string]string{"app": "prometheus"},
+			})
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.warnings, res.Warnings)
+
+			var out []byte
+			for _, sc := range res.ScrapeConfigs {
+				b, err := k8syaml.Marshal(sc)
+				require.NoError(t, err)
+				out = append(out, []byte("---\n")...)
+				out = append(out, b...)
+			}
+
+			for _, s := range res.Secrets {
+				b, err := k8syaml.Marshal(s)
+				require.NoError(t, err)
+				out = append(out, []byte("---\n")...)
+				out = append(out, b...)
+			}
+
+			golden.Assert(t, string(out), tc.golden)
+
+			diffs, err := res.Verify(nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.diffs, diffs)
+		})
+	}
+}
@@ -0,0 +1,408 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"cmp"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+const (
+	// shardAutoscalingInterval is the interval at which the operator
+	// evaluates the number of shards.
+	shardAutoscalingInterval = time.Minute
+
+	// headSeriesTimeout is the maximum duration for querying all the pods
+	// of a Prometheus resource.
+	headSeriesTimeout = 30 * time.Second
+
+	defaultScaleDownStabilizationWindow = time.Hour
+
+	tsdbStatusPath = "/api/v1/status/tsdb"
+
+	shardsScaledEvent       = "ShardsScaled"
+	autoscalingShardsAction = "AutoscalingShards"
+)
+
+// shardAutoscaler computes the number of shards of Prometheus resources from
+// the number of head series reported by the Prometheus pods.
+type shardAutoscaler struct {
+	kclient kubernetes.Interface
+
+	// httpClient returns the HTTP client used to query the Prometheus pods.
+	httpClient func(context.Context, *monitoringv1.Prometheus) (*http.Client, error)
+
+	// tsdbStatusURL returns the URL of the TSDB status API for the pod.
+	tsdbStatusURL func(*monitoringv1.Prometheus, *operator.Pod) (string, error)
+
+	mtx sync.Mutex
+	// recommendations holds the recent recommendations per Prometheus
+	// resource which are used to stabilize the scale-down decisions.
+	recommendations map[string][]recommendation
+}
+
+type recommendation struct {
+	timestamp time.Time
+	shards    int32
+}
+
+func newShardAutoscaler(kclient kubernetes.Interface) *shardAutoscaler {
+	sa := &shardAutoscaler{
+		kclient:         kclient,
+		tsdbStatusURL:   tsdbStatusURL,
+		recommendations: map[string][]recommendation{},
+	}
+	sa.httpClient = sa.newHTTPClient
+
+	return sa
+}
+
+// newHTTPClient returns an HTTP client for the Prometheus web server.
+//
+// When the web server uses TLS, the certificate presented by the pods is
+// verified against the certificate configured in the Prometheus resource.
+func (sa *shardAutoscaler) newHTTPClient(ctx context.Context, p *monitoringv1.Prometheus) (*http.Client, error) {
+	if p.Spec.Web == nil || p.Spec.Web.TLSConfig == nil {
+		return &http.Client{}, nil
+	}
+
+	tlsConfig, err := sa.webTLSConfig(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}, nil
+}
+
+// webTLSConfig returns a TLS configuration which trusts the certificate of
+// the Prometheus web server.
+func (sa *shardAutoscaler) webTLSConfig(ctx context.Context, p *monitoringv1.Prometheus) (*tls.Config, error) {
+	cert := p.Spec.Web.TLSConfig.Cert
+	if cert.Secret == nil && cert.ConfigMap == nil {
+		return nil, errors.New("the web server's certificate can only be verified when defined by web.tlsConfig.cert")
+	}
+
+	data, err := assets.NewStoreBuilder(sa.kclient.CoreV1(), sa.kclient.CoreV1()).GetKey(ctx, p.Namespace, cert)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the web server's certificate: %w", err)
+	}
+
+	var (
+		pool = x509.NewCertPool()
+		leaf *x509.Certificate
+		rest = []byte(data)
+	)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the web server's certificate: %w", err)
+		}
+
+		if leaf == nil {
+			leaf = c
+		}
+		pool.AddCert(c)
+	}
+
+	if leaf == nil {
+		return nil, errors.New("no certificate found in web.tlsConfig.cert")
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	// The pods are reached by IP address which usually isn't part of the
+	// certificate's subject alternative names.
+	if len(leaf.DNSNames) > 0 {
+		tlsConfig.ServerName = leaf.DNSNames[0]
+	}
+
+	return tlsConfig, nil
+}
+
+func tsdbStatusURL(p *monitoringv1.Prometheus, pod *operator.Pod) (string, error) {
+	if p.Spec.ListenLocal {
+		return "", errors.New("the Prometheus web server listens on the loopback interface")
+	}
+
+	if pod.Status.PodIP == "" {
+		return "", fmt.Errorf("pod %s has no IP address", pod.Name)
+	}
+
+	port, err := webPort(p, pod)
+	if err != nil {
+		return "", err
+	}
+
+	u := url.URL{
+		Scheme: p.Spec.PrometheusURIScheme(),
+		Host:   net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(port))),
+		Path:   path.Clean(p.Spec.WebRoutePrefix() + tsdbStatusPath),
+	}
+
+	return u.String(), nil
+}
+
+// webPort returns the port of the Prometheus web server from the pod's
+// specification.
+func webPort(p *monitoringv1.Prometheus, pod *operator.Pod) (int32, error) {
+	portName := cmp.Or(p.Spec.PortName, prompkg.DefaultPortName)
+
+	for _, c := range pod.Spec.Containers {
+		if c.Name != "prometheus" {
+			continue
+		}
+
+		for _, port := range c.Ports {
+			if port.Name == portName {
+				return port.ContainerPort, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("pod %s has no %q port", pod.Name, portName)
+}
+
+// headSeries returns the number of head series reported by the TSDB status
+// API.
+func headSeries(ctx context.Context, client *http.Client, u string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var status struct {
+		Status string `json:"status"`
+		Data   struct {
+			HeadStats struct {
+				NumSeries int64 `json:"numSeries"`
+			} `json:"headStats"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return 0, fmt.Errorf("failed to decode the TSDB status: %w", err)
+	}
+
+	if status.Status != "success" {
+		return 0, fmt.Errorf("unexpected response status %q", status.Status)
+	}
+
+	return status.Data.HeadStats.NumSeries, nil
+}
+
+// totalHeadSeries returns the number of head series across all the shards
+// given the ready pods of each shard.
+//
+// All the pods are queried concurrently and the whole operation is bounded
+// by headSeriesTimeout.
+func (sa *shardAutoscaler) totalHeadSeries(ctx context.Context, p *monitoringv1.Prometheus, shardPods [][]operator.Pod) (int64, error) {
+	client, err := sa.httpClient(ctx, p)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, headSeriesTimeout)
+	defer cancel()
+
+	var (
+		g      errgroup.Group
+		series = make([]int64, len(shardPods))
+	)
+	for shard, pods := range shardPods {
+		g.Go(func() error {
+			n, err := sa.shardHeadSeries(ctx, client, p, pods)
+			if err != nil {
+				return fmt.Errorf("shard %d: failed to retrieve head series: %w", shard, err)
+			}
+
+			series[shard] = n
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, n := range series {
+		total += n
+	}
+
+	return total, nil
+}
+
+// shardHeadSeries returns the number of head series of a shard given its
+// ready pods. Because the replicas of a shard scrape the same targets, it
+// returns the highest value reported by the replicas.
+func (sa *shardAutoscaler) shardHeadSeries(ctx context.Context, client *http.Client, p *monitoringv1.Prometheus, pods []operator.Pod) (int64, error) {
+	if len(pods) == 0 {
+		return 0, errors.New("no ready pod")
+	}
+
+	var (
+		wg     sync.WaitGroup
+		series = make([]int64, len(pods))
+		errs   = make([]error, len(pods))
+	)
+	for i := range pods {
+		wg.Go(func() {
+			u, err := sa.tsdbStatusURL(p, &pods[i])
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			series[i], err = headSeries(ctx, client, u)
+			if err != nil {
+				errs[i] = fmt.Errorf("pod %s: %w", pods[i].Name, err)
+			}
+		})
+	}
+	wg.Wait()
+
+	var (
+		n      int64
+		failed int
+	)
+	for i := range pods {
+		if errs[i] != nil {
+			failed++
+			continue
+		}
+
+		n = max(n, series[i])
+	}
+
+	if failed == len(pods) {
+		return 0, errors.Join(errs...)
+	}
+
+	return n, nil
+}
+
+// recommend returns the number of shards for the Prometheus resource
+// identified by key given the current number of shards and the total number
+// of head series.
+//
+// Scaling up happens immediately while scaling down uses the highest
+// recommendation over the stabilization window.
+func (sa *shardAutoscaler) recommend(key string, as *monitoringv1.ShardAutoscaling, current int32, series int64, now time.Time) (int32, error) {
+	window := defaultScaleDownStabilizationWindow
+	if as.ScaleDownStabilizationWindow != nil {
+		d, err := model.ParseDuration(string(*as.ScaleDownStabilizationWindow))
+		if err != nil {
+			return 0, fmt.Errorf("invalid scale-down stabilization window: %w", err)
+		}
+		window = time.Duration(d)
+	}
+
+	minShards, maxShards := ptr.Deref(as.MinShards, 1), as.MaxShards
+	if minShards < 1 || maxShards < minShards || as.TargetHeadSeries < 1 {
+		return 0, fmt.Errorf("invalid autoscaling configuration (minShards=%d, maxShards=%d, targetHeadSeries=%d)", minShards, maxShards, as.TargetHeadSeries)
+	}
+
+	desired := maxShards
+	if n := (series + as.TargetHeadSeries - 1) / as.TargetHeadSeries; n < int64(maxShards) {
+		desired = max(int32(n), minShards)
+	}
+
+	sa.mtx.Lock()
+	defer sa.mtx.Unlock()
+
+	recs, found := sa.recommendations[key]
+	if !found {
+		// Consider the current number of shards as a past recommendation
+		// to avoid scaling down right after the operator starts.
+		recs = []recommendation{{timestamp: now, shards: current}}
+	}
+
+	// Discard the recommendations outside the stabilization window.
+	recs = slices.DeleteFunc(recs, func(r recommendation) bool {
+		return now.Sub(r.timestamp) > window
+	})
+	recs = append(recs, recommendation{timestamp: now, shards: desired})
+	sa.recommendations[key] = recs
+
+	if desired >= current {
+		return desired, nil
+	}
+
+	stabilized := desired
+	for _, r := range recs {
+		stabilized = max(stabilized, r.shards)
+	}
+
+	return min(stabilized, current, maxShards), nil
+}
+
+// forget removes the recommendations of the Prometheus resources which
+// aren't in the given set.
+func (sa *shardAutoscaler) forget(keep map[string]struct{}) {
+	sa.mtx.Lock()
+	defer sa.mtx.Unlock()
+
+	for k := range sa.recommendations {
+		if _, found := keep[k]; !found {
+			delete(sa.recommendations, k)
+		}
+	}
+}
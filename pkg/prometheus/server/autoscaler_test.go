@@ -0,0 +1,479 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// newFakePrometheus returns a fake Prometheus server which reports the given
+// number of head series per pod name.
+func newFakePrometheus(t *testing.T, series map[string]int64) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != tsdbStatusPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		n, found := series[req.URL.Query().Get("pod")]
+		if !found {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		fmt.Fprintf(w, `{"status":"success","data":{"headStats":{"numSeries":%d,"numLabelPairs":10,"chunkCount":100}}}`, n)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func makeAutoscalerPod(portName string, port int32) *operator.Pod {
+	return &operator.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "prometheus-test-0"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "config-reloader",
+					Ports: []corev1.ContainerPort{
+						{Name: "reloader-web", ContainerPort: 8080},
+					},
+				},
+				{
+					Name: "prometheus",
+					Ports: []corev1.ContainerPort{
+						{Name: portName, ContainerPort: port},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{PodIP: "10.0.0.1"},
+	}
+}
+
+func TestTSDBStatusURL(t *testing.T) {
+	pod := makeAutoscalerPod("web", 9090)
+
+	for _, tc := range []struct {
+		name string
+		spec monitoringv1.CommonPrometheusFields
+		pod  *operator.Pod
+
+		expected string
+		err      bool
+	}{
+		{
+			name:     "default",
+			pod:      pod,
+			expected: "http://10.0.0.1:9090/api/v1/status/tsdb",
+		},
+		{
+			name: "route prefix",
+			spec: monitoringv1.CommonPrometheusFields{
+				RoutePrefix: "/prometheus/",
+			},
+			pod:      pod,
+			expected: "http://10.0.0.1:9090/prometheus/api/v1/status/tsdb",
+		},
+		{
+			name: "web TLS",
+			spec: monitoringv1.CommonPrometheusFields{
+				Web: &monitoringv1.PrometheusWebSpec{
+					WebConfigFileFields: monitoringv1.WebConfigFileFields{
+						TLSConfig: &monitoringv1.WebTLSConfig{},
+					},
+				},
+			},
+			pod:      pod,
+			expected: "https://10.0.0.1:9090/api/v1/status/tsdb",
+		},
+		{
+			name: "custom port",
+			spec: monitoringv1.CommonPrometheusFields{
+				PortName: "http-web",
+			},
+			pod:      makeAutoscalerPod("http-web", 8090),
+			expected: "http://10.0.0.1:8090/api/v1/status/tsdb",
+		},
+		{
+			name: "missing port",
+			spec: monitoringv1.CommonPrometheusFields{
+				PortName: "http-web",
+			},
+			pod: pod,
+			err: true,
+		},
+		{
+			name: "listen local",
+			spec: monitoringv1.CommonPrometheusFields{
+				ListenLocal: true,
+			},
+			pod: pod,
+			err: true,
+		},
+		{
+			name: "no pod IP",
+			pod:  &operator.Pod{},
+			err:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{CommonPrometheusFields: tc.spec},
+			}
+
+			u, err := tsdbStatusURL(p, tc.pod)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, u)
+		})
+	}
+}
+
+func TestShardHeadSeries(t *testing.T) {
+	srv := newFakePrometheus(t, map[string]int64{
+		"prometheus-test-0": 1000,
+		"prometheus-test-1": 1200,
+	})
+
+	pod := func(name string) operator.Pod {
+		return operator.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+
+	for _, tc := range []struct {
+		name string
+		pods []operator.Pod
+
+		expected int64
+		err      bool
+	}{
+		{
+			name: "no pod",
+			err:  true,
+		},
+		{
+			name:     "single replica",
+			pods:     []operator.Pod{pod("prometheus-test-0")},
+			expected: 1000,
+		},
+		{
+			name:     "highest value across replicas",
+			pods:     []operator.Pod{pod("prometheus-test-0"), pod("prometheus-test-1")},
+			expected: 1200,
+		},
+		{
+			name:     "one replica failing",
+			pods:     []operator.Pod{pod("prometheus-test-0"), pod("prometheus-test-2")},
+			expected: 1000,
+		},
+		{
+			name: "all replicas failing",
+			pods: []operator.Pod{pod("prometheus-test-2")},
+			err:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sa := newShardAutoscaler(nil)
+			sa.tsdbStatusURL = func(_ *monitoringv1.Prometheus, pod *operator.Pod) (string, error) {
+				return srv.URL + tsdbStatusPath + "?pod=" + pod.Name, nil
+			}
+
+			n, err := sa.shardHeadSeries(context.Background(), srv.Client(), &monitoringv1.Prometheus{}, tc.pods)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, n)
+		})
+	}
+}
+
+func TestHeadSeriesInvalidResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"status":"error","error":"unavailable"}`)
+	}))
+	defer srv.Close()
+
+	_, err := headSeries(context.Background(), srv.Client(), srv.URL)
+	require.Error(t, err)
+}
+
+func TestTotalHeadSeries(t *testing.T) {
+	srv := newFakePrometheus(t, map[string]int64{
+		"prometheus-test-0":         1000,
+		"prometheus-test-1":         1200,
+		"prometheus-test-shard-1-0": 500,
+	})
+
+	pod := func(name string) operator.Pod {
+		return operator.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+
+	sa := newShardAutoscaler(nil)
+	sa.tsdbStatusURL = func(_ *monitoringv1.Prometheus, pod *operator.Pod) (string, error) {
+		return srv.URL + tsdbStatusPath + "?pod=" + pod.Name, nil
+	}
+
+	n, err := sa.totalHeadSeries(context.Background(), &monitoringv1.Prometheus{}, [][]operator.Pod{
+		{pod("prometheus-test-0"), pod("prometheus-test-1")},
+		{pod("prometheus-test-shard-1-0")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1700), n)
+
+	_, err = sa.totalHeadSeries(context.Background(), &monitoringv1.Prometheus{}, [][]operator.Pod{
+		{pod("prometheus-test-0")},
+		{pod("prometheus-test-shard-2-0")},
+	})
+	require.Error(t, err)
+}
+
+func TestShardAutoscalerTLS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"status":"success","data":{"headStats":{"numSeries":1000}}}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	for _, tc := range []struct {
+		name string
+		tls  monitoringv1.WebTLSConfig
+
+		err bool
+	}{
+		{
+			name: "certificate from secret",
+			tls: monitoringv1.WebTLSConfig{
+				Cert: monitoringv1.SecretOrConfigMap{
+					Secret: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "web-tls"},
+						Key:                  "tls.crt",
+					},
+				},
+			},
+		},
+		{
+			name: "invalid certificate",
+			tls: monitoringv1.WebTLSConfig{
+				Cert: monitoringv1.SecretOrConfigMap{
+					Secret: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "web-tls"},
+						Key:                  "invalid.crt",
+					},
+				},
+			},
+			err: true,
+		},
+		{
+			name: "certificate file",
+			tls: monitoringv1.WebTLSConfig{
+				CertFile: ptr.To("/etc/tls/tls.crt"),
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sa := newShardAutoscaler(fake.NewClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-tls", Namespace: "ns"},
+				Data: map[string][]byte{
+					"tls.crt":     certPEM,
+					"invalid.crt": []byte("invalid"),
+				},
+			}))
+			sa.tsdbStatusURL = func(*monitoringv1.Prometheus, *operator.Pod) (string, error) {
+				return srv.URL + tsdbStatusPath, nil
+			}
+
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+			}
+			p.Spec.Web = &monitoringv1.PrometheusWebSpec{
+				WebConfigFileFields: monitoringv1.WebConfigFileFields{
+					TLSConfig: &tc.tls,
+				},
+			}
+
+			n, err := sa.totalHeadSeries(context.Background(), p, [][]operator.Pod{{{}}})
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, int64(1000), n)
+		})
+	}
+}
+
+func TestShardAutoscalerRecommend(t *testing.T) {
+	type step struct {
+		after   time.Duration
+		current int32
+		series  int64
+
+		expected int32
+	}
+
+	for _, tc := range []struct {
+		name        string
+		autoscaling monitoringv1.ShardAutoscaling
+		steps       []step
+		err         bool
+	}{
+		{
+			name: "scale up immediately",
+			autoscaling: monitoringv1.ShardAutoscaling{
+				MaxShards:        10,
+				TargetHeadSeries: 1000,
+			},
+			steps: []step{
+				{current: 1, series: 900, expected: 1},
+				{current: 1, series: 2500, expected: 3},
+			},
+		},
+		{
+			name: "scale up limited by maxShards",
+			autoscaling: monitoringv1.ShardAutoscaling{
+				MaxShards:        4,
+				TargetHeadSeries: 1000,
+			},
+			steps: []step{
+				{current: 2, series: 100000, expected: 4},
+			},
+		},
+		{
+			name: "minShards",
+			autoscaling: monitoringv1.ShardAutoscaling{
+				MinShards:        ptr.To(int32(2)),
+				MaxShards:        4,
+				TargetHeadSeries: 1000,
+			},
+			steps: []step{
+				{current: 1, series: 0, expected: 2},
+			},
+		},
+		{
+			name: "scale down after the stabilization window",
+			autoscaling: monitoringv1.ShardAutoscaling{
+				MaxShards:                    10,
+				TargetHeadSeries:             1000,
+				ScaleDownStabilizationWindow: ptr.To(monitoringv1.Duration("10m")),
+			},
+			steps: []step{
+				{current: 4, series: 3500, expected: 4},
+				{after: 5 * time.Minute, current: 4, series: 1500, expected: 4},
+				{after: 9 * time.Minute, current: 4, series: 1500, expected: 4},
+				{after: 11 * time.Minute, current: 4, series: 1500, expected: 2},
+			},
+		},
+		{
+			name: "scale down to the highest recommendation in the window",
+			autoscaling: monitoringv1.ShardAutoscaling{
+				MaxShards:                    10,
+				TargetHeadSeries:             1000,
+				ScaleDownStabilizationWindow: ptr.To(monitoringv1.Duration("10m")),
+			},
+			steps: []step{
+				{current: 5, series: 4500, expected: 5},
+				{after: 5 * time.Minute, current: 5, series: 2500, expected: 5},
+				{after: 12 * time.Minute, current: 5, series: 1500, expected: 3},
+			},
+		},
+		{
+			name: "scale down when above maxShards",
+			autoscaling: monitoringv1.ShardAutoscaling{
+				MaxShards:        3,
+				TargetHeadSeries: 1000,
+			},
+			steps: []step{
+				{current: 5, series: 10000, expected: 3},
+			},
+		},
+		{
+			name: "invalid stabilization window",
+			autoscaling: monitoringv1.ShardAutoscaling{
+				MaxShards:                    3,
+				TargetHeadSeries:             1000,
+				ScaleDownStabilizationWindow: ptr.To(monitoringv1.Duration("foo")),
+			},
+			steps: []step{{current: 1}},
+			err:   true,
+		},
+		{
+			name: "minShards greater than maxShards",
+			autoscaling: monitoringv1.ShardAutoscaling{
+				MinShards:        ptr.To(int32(4)),
+				MaxShards:        3,
+				TargetHeadSeries: 1000,
+			},
+			steps: []step{{current: 1}},
+			err:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sa := newShardAutoscaler(nil)
+			start := time.Now()
+
+			for i, s := range tc.steps {
+				shards, err := sa.recommend("ns/test", &tc.autoscaling, s.current, s.series, start.Add(s.after))
+				if tc.err {
+					require.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, s.expected, shards, "step %d", i)
+			}
+		})
+	}
+}
+
+func TestShardAutoscalerForget(t *testing.T) {
+	sa := newShardAutoscaler(nil)
+	as := &monitoringv1.ShardAutoscaling{MaxShards: 10, TargetHeadSeries: 1000}
+
+	for _, key := range []string{"ns/a", "ns/b"} {
+		_, err := sa.recommend(key, as, 1, 100, time.Now())
+		require.NoError(t, err)
+	}
+
+	sa.forget(map[string]struct{}{"ns/a": {}})
+	require.Contains(t, sa.recommendations, "ns/a")
+	require.NotContains(t, sa.recommendations, "ns/b")
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"log/slog"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -62,6 +63,8 @@ const (
 
 	noSelectedResourcesMessage = "No ServiceMonitor, PodMonitor, Probe, ScrapeConfig, and PrometheusRule have been selected."
 
+	invalidFederationSourcesReason = "InvalidFederationSources"
+
 	unmanagedConfigurationReason  = "ConfigurationUnmanaged"
 	unmanagedConfigurationMessage = "the operator doesn't manage the Prometheus configuration secret because neither serviceMonitorSelector nor podMonitorSelector, nor probeSelector, nor scrapeConfigSelector is specified. Unmanaged Prometheus configuration is deprecated, use additionalScrapeConfigs or the ScrapeConfig Custom Resource Definition instead. Unmanaged Prometheus configuration can also be disabled from the operator's command-line (check './operator --help')."
 
@@ -86,29 +89,38 @@ type Operator struct {
 	nsPromInf cache.SharedIndexInformer
 	nsMonInf  cache.SharedIndexInformer
 
-	promInfs  *informers.ForResource
-	smonInfs  *informers.ForResource
-	pmonInfs  *informers.ForResource
-	probeInfs *informers.ForResource
-	sconInfs  *informers.ForResource
-	ruleInfs  *informers.ForResource
-	cmapInfs  *informers.ForResource
-	secrInfs  *informers.ForResource
-	ssetInfs  *informers.ForResource
+	promInfs      *informers.ForResource
+	smonInfs      *informers.ForResource
+	pmonInfs      *informers.ForResource
+	probeInfs     *informers.ForResource
+	sconInfs      *informers.ForResource
+	httpRouteInfs *informers.ForResource
+	ruleInfs      *informers.ForResource
+	amInfs        *informers.ForResource
+	cmapInfs      *informers.ForResource
+	secrInfs      *informers.ForResource
+	ssetInfs      *informers.ForResource
 
 	rr *operator.ResourceReconciler
 
 	metrics         *operator.Metrics
 	reconciliations *operator.ReconciliationTracker
 	statusReporter  *prompkg.StatusReporter
+	scrapeJobCache  *prompkg.ScrapeJobCache
 
 	endpointSliceSupported        bool
 	scrapeConfigSupported         bool
+	httpRouteSupported            bool
 	canReadStorageClass           bool
 	disableUnmanagedConfiguration bool
 	retentionPoliciesEnabled      bool
 	configResourcesStatusEnabled  bool
 	topologyShardingEnabled       bool
+	resourceShardingEnabled       bool
+	shardAutoscalingEnabled       bool
+	alertmanagerSupported         bool
+
+	autoscaler *shardAutoscaler
 
 	newEventRecorder operator.NewEventRecorderFunc
 	finalizerSyncer  *operator.FinalizerSyncer
@@ -124,6 +136,9 @@ type selectedConfigResources struct {
 	bMons         operator.TypedResourcesSelection[*monitoringv1.Probe]
 	scrapeConfigs operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
 	rules         operator.PrometheusRuleSelection
+
+	// probeHTTPRoutes are the HTTPRoute targets of the selected probes.
+	probeHTTPRoutes map[string][]prompkg.HTTPRouteTarget
 }
 
 func (s *selectedConfigResources) Len() int {
@@ -148,6 +163,22 @@ func WithScrapeConfig() ControllerOption {
 	}
 }
 
+// WithHTTPRoute tells that the Kubernetes API supports the Gateway API
+// HTTPRoute resource.
+func WithHTTPRoute() ControllerOption {
+	return func(o *Operator) {
+		o.httpRouteSupported = true
+	}
+}
+
+// WithAlertmanager tells that the controller can watch Alertmanager objects
+// to resolve the Alertmanager selectors and references of Prometheus objects.
+func WithAlertmanager() ControllerOption {
+	return func(o *Operator) {
+		o.alertmanagerSupported = true
+	}
+}
+
 // WithStorageClassValidation tells that the controller should verify that the
 // Prometheus spec references a valid StorageClass name.
 func WithStorageClassValidation() ControllerOption {
@@ -217,11 +248,15 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		},
 		metrics:         operator.NewMetrics(r),
 		reconciliations: &operator.ReconciliationTracker{},
+		scrapeJobCache:  prompkg.NewScrapeJobCache(),
 
 		controllerID:             c.ControllerID,
 		newEventRecorder:         c.EventRecorderFactory(client, controllerName),
 		retentionPoliciesEnabled: c.Gates.Enabled(operator.PrometheusShardRetentionPolicyFeature),
 		topologyShardingEnabled:  c.Gates.Enabled(operator.PrometheusTopologyShardingFeature),
+		resourceShardingEnabled:  c.Gates.Enabled(operator.PrometheusResourceShardingFeature),
+		shardAutoscalingEnabled:  c.Gates.Enabled(operator.PrometheusShardAutoscalingFeature),
+		autoscaler:               newShardAutoscaler(client),
 		finalizerSyncer:          operator.NewNoopFinalizerSyncer(),
 	}
 	for _, opt := range opts {
@@ -323,6 +358,22 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			return nil, fmt.Errorf("error creating scrapeconfigs informers: %w", err)
 		}
 	}
+
+	if o.httpRouteSupported {
+		o.httpRouteInfs, err = informers.NewInformersForResource(
+			informers.NewDynamicInformerFactory(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				dclient,
+				resyncPeriod,
+				nil,
+			),
+			prompkg.HTTPRouteGroupVersionResource,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating httproutes informers: %w", err)
+		}
+	}
 	o.ruleInfs, err = informers.NewInformersForResource(
 		informers.NewMonitoringInformerFactories(
 			c.Namespaces.AllowList,
@@ -337,6 +388,26 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("error creating prometheusrule informers: %w", err)
 	}
 
+	if o.alertmanagerSupported {
+		// The Alertmanager resources are watched to resolve the alerting
+		// selectors and references of the Prometheus resources.
+		o.amInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AlertmanagerAllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				func(options *metav1.ListOptions) {
+					options.LabelSelector = c.AlertmanagerSelector.String()
+				},
+			),
+			monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating alertmanager informers: %w", err)
+		}
+	}
+
 	allowList := c.Namespaces.PrometheusAllowList
 	if c.WatchObjectRefsInAllNamespaces {
 		allowList = operator.MergeAllowLists(c.Namespaces.PrometheusAllowList, c.Namespaces.AllowList)
@@ -449,8 +520,10 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"ServiceMonitor", c.smonInfs},
 		{"PodMonitor", c.pmonInfs},
 		{"PrometheusRule", c.ruleInfs},
+		{"Alertmanager", c.amInfs},
 		{"Probe", c.probeInfs},
 		{"ScrapeConfig", c.sconInfs},
+		{"HTTPRoute", c.httpRouteInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
@@ -548,6 +621,22 @@ func (c *Operator) addHandlers() {
 		))
 	}
 
+	if c.httpRouteInfs != nil {
+		c.httpRouteInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			"HTTPRoute",
+			c.enqueueForMonitorNamespace,
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
 	c.ruleInfs.AddEventHandler(operator.NewEventHandler(
 		c.logger,
 		c.accessor,
@@ -562,6 +651,32 @@ func (c *Operator) addHandlers() {
 		),
 	))
 
+	if c.alertmanagerSupported {
+		c.amInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1.AlertmanagersKind,
+			c.enqueueForAlertmanagerNamespace,
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
+	// Prometheus resources referenced as federation sources.
+	c.promInfs.AddEventHandler(operator.NewEventHandler(
+		c.logger,
+		c.accessor,
+		c.metrics,
+		monitoringv1.PrometheusesKind,
+		c.enqueueForFederationNamespace,
+		operator.WithFilter(operator.GenerationChanged),
+	))
+
 	hasRefFunc := operator.HasReferenceFunc(
 		c.promInfs,
 		c.reconciliations,
@@ -608,7 +723,13 @@ func (c *Operator) Run(ctx context.Context) error {
 	if c.scrapeConfigSupported {
 		go c.sconInfs.Start(ctx.Done())
 	}
+	if c.httpRouteSupported {
+		go c.httpRouteInfs.Start(ctx.Done())
+	}
 	go c.ruleInfs.Start(ctx.Done())
+	if c.alertmanagerSupported {
+		go c.amInfs.Start(ctx.Done())
+	}
 	go c.cmapInfs.Start(ctx.Done())
 	go c.secrInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
@@ -669,11 +790,105 @@ func (c *Operator) Run(ctx context.Context) error {
 		}()
 	}
 
+	if c.shardAutoscalingEnabled {
+		// Periodically evaluate the number of shards.
+		go func() {
+			_ = wait.PollUntilContextCancel(ctx, shardAutoscalingInterval, false, func(ctx context.Context) (bool, error) {
+				c.autoscaleShards(ctx)
+				return false, nil
+			})
+		}()
+	}
+
 	c.metrics.Ready().Set(1)
 	<-ctx.Done()
 	return nil
 }
 
+// autoscaleShards updates the number of shards of the Prometheus resources
+// with autoscaling enabled.
+func (c *Operator) autoscaleShards(ctx context.Context) {
+	keys := map[string]struct{}{}
+	_ = c.promInfs.ListAll(labels.Everything(), func(obj any) {
+		p := obj.(*monitoringv1.Prometheus)
+		if p.Spec.Autoscaling == nil || p.Spec.Paused || p.DeletionTimestamp != nil {
+			return
+		}
+
+		key, err := cache.MetaNamespaceKeyFunc(p)
+		if err != nil {
+			return
+		}
+		keys[key] = struct{}{}
+
+		logger := c.logger.With("key", key)
+		if err := c.autoscale(ctx, logger, p.DeepCopy(), key); err != nil {
+			logger.Warn("failed to autoscale shards", "err", err)
+		}
+	})
+
+	c.autoscaler.forget(keys)
+}
+
+func (c *Operator) autoscale(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, key string) error {
+	// Only the active shards are considered, the retained shards don't
+	// scrape targets.
+	current := int32(len(prompkg.ExpectedStatefulSetShardNames(p)))
+
+	shardPods := make([][]operator.Pod, 0, current)
+	for shard := range int(current) {
+		obj, err := c.ssetInfs.Get(prompkg.KeyToStatefulSetKey(p, key, shard))
+		if err != nil {
+			return fmt.Errorf("shard %d: failed to retrieve statefulset: %w", shard, err)
+		}
+
+		stsReporter, err := operator.NewStatefulSetReporter(ctx, c.kclient, obj.(*appsv1.StatefulSet))
+		if err != nil {
+			return fmt.Errorf("shard %d: failed to retrieve statefulset state: %w", shard, err)
+		}
+
+		shardPods = append(shardPods, stsReporter.ReadyPods())
+	}
+
+	series, err := c.autoscaler.totalHeadSeries(ctx, p, shardPods)
+	if err != nil {
+		return err
+	}
+
+	desired, err := c.autoscaler.recommend(key, p.Spec.Autoscaling, current, series, time.Now())
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("evaluated shard autoscaling", "head_series", series, "current_shards", current, "desired_shards", desired)
+	if desired == current {
+		return nil
+	}
+
+	// The scaled-down shards are retained until their data ages out which
+	// requires the shard retention policy.
+	if desired < current && !c.retentionPoliciesEnabled {
+		logger.Warn("skipping scale-down because the PrometheusShardRetentionPolicy feature gate isn't enabled", "current_shards", current, "desired_shards", desired)
+		return nil
+	}
+
+	pClient := c.mclient.MonitoringV1().Prometheuses(p.Namespace)
+	scale, err := pClient.GetScale(ctx, p.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get scale: %w", err)
+	}
+
+	scale.Spec.Replicas = desired
+	if _, err := pClient.UpdateScale(ctx, p.Name, scale, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update scale: %w", err)
+	}
+
+	logger.Info("scaled shards", "head_series", series, "from", current, "to", desired)
+	c.newEventRecorder(p).Eventf(p, corev1.EventTypeNormal, shardsScaledEvent, autoscalingShardsAction, "Scaled shards from %d to %d (%d head series)", current, desired, series)
+
+	return nil
+}
+
 // Iterate implements the operator.StatusReconciler interface.
 func (c *Operator) Iterate(processFn func(operator.StatusGetter)) {
 	if err := c.promInfs.ListAll(labels.Everything(), func(o any) {
@@ -696,6 +911,47 @@ func (c *Operator) enqueueForMonitorNamespace(nsName string) {
 	c.enqueueForNamespace(c.nsMonInf.GetStore(), nsName)
 }
 
+// enqueueForAlertmanagerNamespace enqueues all Prometheus object keys which
+// select or reference Alertmanager resources in the given namespace.
+func (c *Operator) enqueueForAlertmanagerNamespace(nsName string) {
+	err := c.promInfs.ListAll(labels.Everything(), func(obj any) {
+		p := obj.(*monitoringv1.Prometheus)
+		if p.Spec.Alerting == nil {
+			return
+		}
+
+		if operator.SelectsAlertmanagersInNamespace(p.Namespace, p.Spec.Alerting.AlertmanagerSelector, p.Spec.Alerting.AlertmanagerRefs, nsName) {
+			c.rr.EnqueueForReconciliation(p)
+		}
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all Prometheus instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+// enqueueForFederationNamespace enqueues all Prometheus object keys which
+// reference federation sources in the given namespace.
+func (c *Operator) enqueueForFederationNamespace(nsName string) {
+	err := c.promInfs.ListAll(labels.Everything(), func(obj any) {
+		p := obj.(*monitoringv1.Prometheus)
+		for _, src := range p.Spec.FederationSources {
+			if ptr.Deref(src.Namespace, p.Namespace) == nsName {
+				c.rr.EnqueueForReconciliation(p)
+				return
+			}
+		}
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all Prometheus instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
 // enqueueForNamespace enqueues all Prometheus object keys that belong to the
 // given namespace or select objects in the given namespace.
 func (c *Operator) enqueueForNamespace(store cache.Store, nsName string) {
@@ -829,13 +1085,23 @@ func (c *Operator) handleMonitorNamespaceUpdate(oldo, curo any) {
 	err := c.promInfs.ListAll(labels.Everything(), func(obj any) {
 		p := obj.(*monitoringv1.Prometheus)
 
-		for name, selector := range map[string]*metav1.LabelSelector{
+		selectors := map[string]*metav1.LabelSelector{
 			"PodMonitors":     p.Spec.PodMonitorNamespaceSelector,
 			"Probes":          p.Spec.ProbeNamespaceSelector,
 			"PrometheusRules": p.Spec.RuleNamespaceSelector,
 			"ScrapeConfigs":   p.Spec.ScrapeConfigNamespaceSelector,
 			"ServiceMonitors": p.Spec.ServiceMonitorNamespaceSelector,
-		} {
+		}
+
+		// The namespace labels also determine the scrape class of the
+		// resources.
+		for _, sc := range p.Spec.ScrapeClasses {
+			if sc.NamespaceSelector != nil {
+				selectors["ScrapeClass/"+sc.Name] = sc.NamespaceSelector
+			}
+		}
+
+		for name, selector := range selectors {
 
 			sync, err := k8s.LabelSelectionHasChanged(old.Labels, cur.Labels, selector)
 			if err != nil {
@@ -889,6 +1155,7 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 
 	if p == nil {
 		c.reconciliations.ForgetObject(key)
+		c.scrapeJobCache.Forget(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return closure, nil
 	}
@@ -911,6 +1178,7 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 
 	if c.rr.DeletionInProgress(p) {
 		c.reconciliations.ForgetObject(key)
+		c.scrapeJobCache.Forget(key)
 		return closure, nil
 	}
 
@@ -919,6 +1187,18 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		return closure, nil
 	}
 
+	if ss := p.Spec.ShardingStrategy; prompkg.IsResourceShardingMode(ss) {
+		if !c.resourceShardingEnabled {
+			return closure, fmt.Errorf("feature gate for Prometheus resource sharding is not enabled (sharding mode %q)", *ss.Mode)
+		}
+
+		// Resource sharding requires per-shard configurations which isn't
+		// possible when the configuration is managed by the user.
+		if c.unmanagedPrometheusConfiguration(p) {
+			return closure, fmt.Errorf("sharding mode %q requires the configuration to be managed by the operator", *ss.Mode)
+		}
+	}
+
 	c.recordDeprecatedFields(key, logger, p)
 
 	if err := operator.CheckStorageClass(ctx, c.canReadStorageClass, c.kclient, p.Spec.Storage); err != nil {
@@ -948,7 +1228,7 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		return closure, err
 	}
 
-	opts := []prompkg.ConfigGeneratorOption{}
+	opts := []prompkg.ConfigGeneratorOption{prompkg.WithScrapeJobCache(c.scrapeJobCache)}
 	if c.endpointSliceSupported {
 		opts = append(opts, prompkg.WithEndpointSliceSupport())
 	}
@@ -958,12 +1238,33 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 	if c.topologyShardingEnabled {
 		opts = append(opts, prompkg.WithPrometheusTopologySharding())
 	}
+	if c.resourceShardingEnabled {
+		opts = append(opts, prompkg.WithPrometheusResourceSharding())
+	}
+	amEndpoints, err := c.selectAlertmanagerEndpoints(logger, p)
+	if err != nil {
+		return closure, err
+	}
+	if len(amEndpoints) > 0 {
+		opts = append(opts, prompkg.WithAlertmanagerEndpoints(amEndpoints))
+	}
+	if len(resources.probeHTTPRoutes) > 0 {
+		opts = append(opts, prompkg.WithProbeHTTPRoutes(resources.probeHTTPRoutes))
+	}
+	federationEndpoints, err := c.selectFederationEndpoints(logger, p)
+	if err != nil {
+		return closure, err
+	}
+	if len(federationEndpoints) > 0 {
+		opts = append(opts, prompkg.WithFederationEndpoints(federationEndpoints))
+	}
 	cg, err := prompkg.NewConfigGenerator(logger, p, opts...)
 	if err != nil {
 		return closure, err
 	}
 
-	if err := c.createOrUpdateConfigurationSecret(ctx, logger, p, cg, ruleConfigMapNames, assetStore, resources); err != nil {
+	scrapeConfigFiles, err := c.createOrUpdateConfigurationSecret(ctx, logger, p, cg, ruleConfigMapNames, assetStore, resources)
+	if err != nil {
 		return closure, fmt.Errorf("creating config failed: %w", err)
 	}
 	c.reconciliations.UpdateReferenceTracker(key, assetStore.RefTracker())
@@ -1012,6 +1313,8 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		}
 	}
 
+	staticConfigsFrom := prompkg.StaticConfigsFrom(resources.scrapeConfigs.ValidResources())
+
 	ssetClient := c.kclient.AppsV1().StatefulSets(p.Namespace)
 
 	// Reconcile all active statefulset shards.
@@ -1042,9 +1345,27 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 				// processing.
 				continue
 			}
+
+			if _, found := existingStatefulSet.Annotations[deletionDeadlineAnnotation]; found {
+				// The shard has been retained after a scale-down and it is
+				// active again: cancel its deletion.
+				logger.Info("cancelling the deletion of the retained shard")
+				if err := c.cancelShardDeletion(ctx, existingStatefulSet); err != nil {
+					return closure, fmt.Errorf("failed to cancel the deletion of statefulset: %w", err)
+				}
+			}
+		}
+
+		// Without resource sharding, all shards share the configuration of
+		// the first shard.
+		var scrapeConfigSecrets *operator.ShardedSecret
+		if cg.IsResourceShardingActive() {
+			scrapeConfigSecrets = scrapeConfigFiles[int32(shard)]
+		} else {
+			scrapeConfigSecrets = scrapeConfigFiles[0]
 		}
 
-		newSSetInputHash, err := createSSetInputHash(*p, c.config, ruleConfigMapNames, tlsAssets, existingStatefulSet.Spec)
+		newSSetInputHash, err := createSSetInputHash(*p, c.config, ruleConfigMapNames, tlsAssets, scrapeConfigSecrets, staticConfigsFrom, existingStatefulSet.Spec)
 		if err != nil {
 			return closure, err
 		}
@@ -1057,7 +1378,9 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 			ruleConfigMapNames,
 			newSSetInputHash,
 			int32(shard),
-			tlsAssets)
+			tlsAssets,
+			scrapeConfigSecrets,
+			staticConfigsFrom)
 		if err != nil {
 			return closure, fmt.Errorf("making statefulset failed: %w", err)
 		}
@@ -1132,6 +1455,10 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		return closure, fmt.Errorf("failed to clean up excess StatefulSets: %w", errors.Join(deleteErrs...))
 	}
 
+	if err := c.deleteInactiveShardSecrets(ctx, p, cg); err != nil {
+		return closure, fmt.Errorf("failed to clean up the configuration secrets of inactive shards: %w", err)
+	}
+
 	return closure, err
 }
 
@@ -1260,12 +1587,7 @@ func (c *Operator) processShardRetention(ctx context.Context, p *monitoringv1.Pr
 		return true, nil
 	}
 
-	if p.Spec.ShardRetentionPolicy == nil {
-		// ShardRetentionPolicy not configured, the default behavior is to delete.
-		return true, nil
-	}
-
-	if ptr.Deref(p.Spec.ShardRetentionPolicy.WhenScaled, monitoringv1.DeleteWhenScaledRetentionType) != monitoringv1.RetainWhenScaledRetentionType {
+	if c.whenScaledRetentionType(p) != monitoringv1.RetainWhenScaledRetentionType {
 		return true, nil
 	}
 
@@ -1311,6 +1633,45 @@ func (c *Operator) processShardRetention(ctx context.Context, p *monitoringv1.Pr
 	return false, err
 }
 
+// whenScaledRetentionType returns the retention policy of the scaled-down
+// shards. When not configured, the shards are deleted unless the number of
+// shards is managed by the autoscaler in which case they are retained until
+// their data ages out.
+func (c *Operator) whenScaledRetentionType(p *monitoringv1.Prometheus) monitoringv1.WhenScaledRetentionType {
+	if p.Spec.ShardRetentionPolicy != nil && p.Spec.ShardRetentionPolicy.WhenScaled != nil {
+		return *p.Spec.ShardRetentionPolicy.WhenScaled
+	}
+
+	if c.shardAutoscalingEnabled && p.Spec.Autoscaling != nil {
+		return monitoringv1.RetainWhenScaledRetentionType
+	}
+
+	return monitoringv1.DeleteWhenScaledRetentionType
+}
+
+// cancelShardDeletion removes the deletion deadline from a retained shard.
+func (c *Operator) cancelShardDeletion(ctx context.Context, sset *appsv1.StatefulSet) error {
+	patchData, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{
+				deletionDeadlineAnnotation: nil,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.kclient.AppsV1().StatefulSets(sset.Namespace).Patch(
+		ctx,
+		sset.Name,
+		types.StrategicMergePatchType,
+		patchData,
+		metav1.PatchOptions{FieldManager: k8s.PrometheusOperatorFieldManager})
+
+	return err
+}
+
 func deadlineExpired(deadline string) (bool, error) {
 	t, err := time.Parse(annotationTimeFormat, deadline)
 	if err != nil {
@@ -1328,7 +1689,7 @@ func deadlineExpired(deadline string) (bool, error) {
 // The function should only be called when the shard retention policy is set to Retain.
 func gracePeriodForPrometheusStorage(p *monitoringv1.Prometheus) (time.Duration, error) {
 	var retention monitoringv1.Duration
-	if p.Spec.ShardRetentionPolicy.Retain != nil {
+	if p.Spec.ShardRetentionPolicy != nil && p.Spec.ShardRetentionPolicy.Retain != nil {
 		retention = p.Spec.ShardRetentionPolicy.Retain.RetentionPeriod
 	} else {
 		if p.Spec.RetentionSize != "" && p.Spec.Retention == "" {
@@ -1448,7 +1809,7 @@ func (c *Operator) unmanagedPrometheusConfiguration(p *monitoringv1.Prometheus)
 		p.Spec.ScrapeConfigSelector == nil
 }
 
-func createSSetInputHash(p monitoringv1.Prometheus, c prompkg.Config, ruleConfigMapNames []string, tlsAssets *operator.ShardedSecret, ssSpec appsv1.StatefulSetSpec) (string, error) {
+func createSSetInputHash(p monitoringv1.Prometheus, c prompkg.Config, ruleConfigMapNames []string, tlsAssets, scrapeConfigSecrets *operator.ShardedSecret, staticConfigsFrom []monitoringv1.SecretOrConfigMap, ssSpec appsv1.StatefulSetSpec) (string, error) {
 	var http2 *bool
 	if p.Spec.Web != nil && p.Spec.Web.HTTPConfig != nil {
 		http2 = p.Spec.Web.HTTPConfig.HTTP2
@@ -1468,6 +1829,8 @@ func createSSetInputHash(p monitoringv1.Prometheus, c prompkg.Config, ruleConfig
 		StatefulSetSpec       appsv1.StatefulSetSpec
 		RuleConfigMaps        []string `hash:"set"`
 		ShardedSecret         *operator.ShardedSecret
+		ScrapeConfigSecrets   *operator.ShardedSecret
+		StaticConfigsFrom     []monitoringv1.SecretOrConfigMap
 	}{
 		PrometheusLabels:      p.Labels,
 		PrometheusAnnotations: p.Annotations,
@@ -1477,6 +1840,8 @@ func createSSetInputHash(p monitoringv1.Prometheus, c prompkg.Config, ruleConfig
 		StatefulSetSpec:       ssSpec,
 		RuleConfigMaps:        ruleConfigMapNames,
 		ShardedSecret:         tlsAssets,
+		ScrapeConfigSecrets:   scrapeConfigSecrets,
+		StaticConfigsFrom:     staticConfigsFrom,
 	},
 		nil,
 	)
@@ -1489,7 +1854,12 @@ func createSSetInputHash(p monitoringv1.Prometheus, c prompkg.Config, ruleConfig
 
 // getSeletedConfigResources returns all the configuration resources (PodMonitor, ServiceMonitor, Probes and ScrapeConfigs) selected by the Prometheus.
 func (c *Operator) getSelectedConfigResources(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, store *assets.StoreBuilder) (*selectedConfigResources, error) {
-	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p))
+	var rsOpts []prompkg.ResourceSelectorOption
+	if c.httpRouteInfs != nil {
+		rsOpts = append(rsOpts, prompkg.WithHTTPRouteSupport())
+	}
+
+	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p), rsOpts...)
 
 	if err != nil {
 		return nil, err
@@ -1509,6 +1879,14 @@ func (c *Operator) getSelectedConfigResources(ctx context.Context, logger *slog.
 		return nil, fmt.Errorf("selecting Probes failed: %w", err)
 	}
 
+	var probeHTTPRoutes map[string][]prompkg.HTTPRouteTarget
+	if c.httpRouteInfs != nil {
+		probeHTTPRoutes, err = resourceSelector.SelectProbeHTTPRoutes(bmons, c.httpRouteInfs.ListAllByNamespace)
+		if err != nil {
+			return nil, fmt.Errorf("selecting HTTPRoutes failed: %w", err)
+		}
+	}
+
 	var scrapeConfigs operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
 	if c.sconInfs != nil {
 		scrapeConfigs, err = resourceSelector.SelectScrapeConfigs(ctx, c.sconInfs.ListAllByNamespace)
@@ -1523,15 +1901,20 @@ func (c *Operator) getSelectedConfigResources(ctx context.Context, logger *slog.
 	}
 
 	return &selectedConfigResources{
-		sMons:         smons,
-		bMons:         bmons,
-		pMons:         pmons,
-		scrapeConfigs: scrapeConfigs,
-		rules:         rules,
+		sMons:           smons,
+		bMons:           bmons,
+		pMons:           pmons,
+		scrapeConfigs:   scrapeConfigs,
+		rules:           rules,
+		probeHTTPRoutes: probeHTTPRoutes,
 	}, nil
 }
 
-func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, cg *prompkg.ConfigGenerator, ruleConfigMapNames []string, store *assets.StoreBuilder, resources *selectedConfigResources) error {
+// createOrUpdateConfigurationSecret generates the Prometheus configuration
+// and stores it into Secrets. It returns the Secrets holding the scrape
+// configuration files of each configuration shard when the configuration is
+// too large for a single Secret.
+func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, cg *prompkg.ConfigGenerator, ruleConfigMapNames []string, store *assets.StoreBuilder, resources *selectedConfigResources) (map[int32]*operator.ShardedSecret, error) {
 	// If no service/pod monitor and probe selectors are configured, the user
 	// wants to manage configuration themselves. Let's create an empty Secret
 	// if it doesn't exist.
@@ -1539,7 +1922,7 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 
 		s, err := prompkg.MakeConfigurationSecret(p, c.config, nil)
 		if err != nil {
-			return fmt.Errorf("failed to generate empty configuration secret: %w", err)
+			return nil, fmt.Errorf("failed to generate empty configuration secret: %w", err)
 		}
 
 		sClient := c.kclient.CoreV1().Secrets(p.Namespace)
@@ -1547,25 +1930,25 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		if apierrors.IsNotFound(err) {
 			logger.Debug("creating an empty configuration secret")
 			if _, err := c.kclient.CoreV1().Secrets(p.Namespace).Create(ctx, s, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
-				return fmt.Errorf("failed to create an empty configuration secret: %w", err)
+				return nil, fmt.Errorf("failed to create an empty configuration secret: %w", err)
 			}
 
-			return nil
+			return nil, nil
 		}
 
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddRemoteReadsToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteRead); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := cg.AddRemoteWriteToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddAPIServerConfigToStore(ctx, store, p.GetNamespace(), p.Spec.APIServerConfig); err != nil {
-		return err
+		return nil, err
 	}
 
 	if p.Spec.Alerting != nil {
@@ -1573,58 +1956,258 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 
 		for i, am := range ams {
 			if err := validateAlertmanagerEndpoints(p, am); err != nil {
-				return fmt.Errorf("alertmanager %d: %w", i, err)
+				return nil, fmt.Errorf("alertmanager %d: %w", i, err)
 			}
 		}
 
 		if err := addAlertmanagerEndpointsToStore(ctx, store, p.GetNamespace(), ams); err != nil {
-			return err
+			return nil, err
 		}
+
+		if err := store.AddSafeTLSConfig(ctx, p.GetNamespace(), p.Spec.Alerting.AlertmanagerTLSConfig); err != nil {
+			return nil, fmt.Errorf("alertmanagerTLSConfig: %w", err)
+		}
+	}
+
+	if err := prompkg.AddFederationSourcesToStore(ctx, store, p.GetNamespace(), p.Spec.FederationSources); err != nil {
+		return nil, err
 	}
 
 	if err := prompkg.AddScrapeClassesToStore(ctx, store, p.GetNamespace(), p.Spec.ScrapeClasses); err != nil {
-		return fmt.Errorf("failed to process scrape classes: %w", err)
+		return nil, fmt.Errorf("failed to process scrape classes: %w", err)
 	}
 
 	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 	additionalScrapeConfigs, err := k8s.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
 	}
 	additionalAlertRelabelConfigs, err := k8s.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalAlertRelabelConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
 	}
 	additionalAlertManagerConfigs, err := k8s.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalAlertManagerConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
-	}
-
-	// Update secret based on the most recent configuration.
-	conf, err := cg.GenerateServerConfiguration(
-		p,
-		resources.sMons.ValidResources(),
-		resources.pMons.ValidResources(),
-		resources.bMons.ValidResources(),
-		resources.scrapeConfigs.ValidResources(),
-		store,
-		additionalScrapeConfigs,
-		additionalAlertRelabelConfigs,
-		additionalAlertManagerConfigs,
-		ruleConfigMapNames,
-	)
+		return nil, fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
+	}
+
+	scrapeConfigFiles := map[int32]*operator.ShardedSecret{}
+	generate := func(cg *prompkg.ConfigGenerator, shard int32) error {
+		// Update secret based on the most recent configuration.
+		conf, err := cg.GenerateServerConfiguration(
+			p,
+			resources.sMons.ValidResources(),
+			resources.pMons.ValidResources(),
+			resources.bMons.ValidResources(),
+			resources.scrapeConfigs.ValidResources(),
+			store,
+			additionalScrapeConfigs,
+			additionalAlertRelabelConfigs,
+			additionalAlertManagerConfigs,
+			ruleConfigMapNames,
+		)
+		if err != nil {
+			return fmt.Errorf("generating config failed: %w", err)
+		}
+
+		// Without resource sharding, all the StatefulSets load the
+		// configuration of the first shard.
+		workloads, err := c.configurationWorkloads(p, shard, !cg.IsResourceShardingActive())
+		if err != nil {
+			return err
+		}
+
+		filesExist, err := prompkg.ScrapeConfigFilesSecretsExist(c.secrInfs, p, shard)
+		if err != nil {
+			return err
+		}
+
+		scrapeConfigSecrets, err := prompkg.ReconcileConfigurationSecrets(ctx, logger, c.kclient, c.reconciliations, p, c.config, cg, shard, conf, workloads, filesExist)
+		if err != nil {
+			return err
+		}
+
+		if scrapeConfigSecrets != nil {
+			scrapeConfigFiles[shard] = scrapeConfigSecrets
+		}
+
+		return nil
+	}
+
+	if !cg.IsResourceShardingActive() {
+		if err := generate(cg, 0); err != nil {
+			return nil, err
+		}
+
+		return scrapeConfigFiles, nil
+	}
+
+	// With resource sharding, each shard has its own configuration which
+	// contains only the resources assigned to the shard.
+	shards, err := c.configurationShards(p)
 	if err != nil {
-		return fmt.Errorf("generating config failed: %w", err)
+		return nil, err
+	}
+
+	for _, shard := range shards {
+		if err := generate(cg.ForShard(shard), shard); err != nil {
+			return nil, fmt.Errorf("shard %d: %w", shard, err)
+		}
 	}
 
-	// Compress config to avoid 1mb secret limit for a while
-	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
+	return scrapeConfigFiles, nil
+}
+
+// configurationWorkloads returns the state of the existing StatefulSets
+// which load the configuration of the given shard. If all is true, it returns
+// the state of all the StatefulSets.
+func (c *Operator) configurationWorkloads(p *monitoringv1.Prometheus, shard int32, all bool) ([]prompkg.WorkloadState, error) {
+	var workloads []prompkg.WorkloadState
+	err := c.ssetInfs.ListAllByNamespace(p.Namespace, labels.SelectorFromSet(labels.Set{prompkg.PrometheusNameLabelName: p.Name, prompkg.PrometheusModeLabelName: prometheusMode}), func(obj any) {
+		sset := obj.(*appsv1.StatefulSet)
+		if s, ok := shardFromStatefulSet(sset); !all && (!ok || s != shard) {
+			return
+		}
+
+		workloads = append(workloads, prompkg.StatefulSetState(sset))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing StatefulSet resources failed: %w", err)
+	}
+
+	return workloads, nil
+}
+
+// configurationShards returns the shards which require a configuration
+// secret: the expected shards and the inactive shards being retained.
+// Retained shards get no resource assigned.
+func (c *Operator) configurationShards(p *monitoringv1.Prometheus) ([]int32, error) {
+	n := int32(len(prompkg.ExpectedStatefulSetShardNames(p)))
+
+	shards := make([]int32, 0, n)
+	for i := range n {
+		shards = append(shards, i)
+	}
+
+	err := c.ssetInfs.ListAllByNamespace(p.Namespace, labels.SelectorFromSet(labels.Set{prompkg.PrometheusNameLabelName: p.Name, prompkg.PrometheusModeLabelName: prometheusMode}), func(obj any) {
+		shard, ok := shardFromStatefulSet(obj.(*appsv1.StatefulSet))
+		if !ok || shard < n {
+			return
+		}
+
+		shards = append(shards, shard)
+	})
 	if err != nil {
-		return fmt.Errorf("creating compressed secret failed: %w", err)
+		return nil, fmt.Errorf("listing StatefulSet resources failed: %w", err)
 	}
 
-	logger.Debug("updating Prometheus configuration secret")
-	return k8s.CreateOrUpdateSecret(ctx, sClient, s)
+	return shards, nil
+}
+
+// deleteInactiveShardSecrets deletes the per-shard configuration Secrets
+// (including the scrape configuration files) of the shards which don't load
+// their own configuration anymore. It happens when the number of shards is
+// reduced or when resource sharding is disabled. Secrets still mounted by a
+// StatefulSet are kept until the StatefulSet is updated or deleted.
+func (c *Operator) deleteInactiveShardSecrets(ctx context.Context, p *monitoringv1.Prometheus, cg *prompkg.ConfigGenerator) error {
+	// The configuration Secret of shard 0 is shared by all sharding modes.
+	active := map[int32]struct{}{0: {}}
+	if cg.IsResourceShardingActive() && !c.unmanagedPrometheusConfiguration(p) {
+		shards, err := c.configurationShards(p)
+		if err != nil {
+			return err
+		}
+
+		for _, shard := range shards {
+			active[shard] = struct{}{}
+		}
+	}
+
+	mounted := map[string]struct{}{}
+	err := c.ssetInfs.ListAllByNamespace(p.Namespace, labels.SelectorFromSet(labels.Set{prompkg.PrometheusNameLabelName: p.Name, prompkg.PrometheusModeLabelName: prometheusMode}), func(obj any) {
+		for _, v := range obj.(*appsv1.StatefulSet).Spec.Template.Spec.Volumes {
+			if v.Secret != nil {
+				mounted[v.Secret.SecretName] = struct{}{}
+			}
+
+			if v.Projected == nil {
+				continue
+			}
+
+			for _, src := range v.Projected.Sources {
+				if src.Secret != nil {
+					mounted[src.Secret.Name] = struct{}{}
+				}
+			}
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("listing StatefulSet resources failed: %w", err)
+	}
+
+	var inactive []string
+	err = c.secrInfs.ListAllByNamespace(p.Namespace, labels.Everything(), func(obj any) {
+		name := obj.(metav1.Object).GetName()
+
+		shard, ok := shardFromConfigSecretName(p, name)
+		if !ok {
+			return
+		}
+
+		if _, ok := active[shard]; ok {
+			return
+		}
+
+		if _, ok := mounted[name]; ok {
+			return
+		}
+
+		inactive = append(inactive, name)
+	})
+	if err != nil {
+		return fmt.Errorf("listing Secret resources failed: %w", err)
+	}
+
+	var deleteErrs []error
+	for _, name := range inactive {
+		if err := c.kclient.CoreV1().Secrets(p.Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			deleteErrs = append(deleteErrs, fmt.Errorf("failed to delete Secret %s: %w", name, err))
+		}
+	}
+
+	return errors.Join(deleteErrs...)
+}
+
+// shardFromConfigSecretName returns the shard index of a per-shard
+// configuration Secret or scrape configuration files Secret. It returns false
+// for shard 0 and for the Secrets which aren't specific to a shard.
+func shardFromConfigSecretName(p *monitoringv1.Prometheus, name string) (int32, bool) {
+	rest, found := strings.CutPrefix(name, prompkg.ConfigSecretName(p)+"-shard-")
+	if !found {
+		return 0, false
+	}
+
+	idx, suffix, _ := strings.Cut(rest, "-")
+	if suffix != "" && !strings.HasPrefix(suffix, "scrape-config-files-") {
+		return 0, false
+	}
+
+	shard, err := strconv.ParseInt(idx, 10, 32)
+	if err != nil || shard <= 0 {
+		return 0, false
+	}
+
+	return int32(shard), true
+}
+
+// shardFromStatefulSet returns the shard index of the StatefulSet.
+func shardFromStatefulSet(s *appsv1.StatefulSet) (int32, bool) {
+	shard, err := strconv.ParseInt(s.Labels[prompkg.ShardLabelName], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return int32(shard), true
 }
 
 func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitoringv1.Prometheus) error {
@@ -1722,6 +2305,93 @@ func validateAlertmanagerEndpoints(p *monitoringv1.Prometheus, am monitoringv1.A
 	return nil
 }
 
+// selectAlertmanagerEndpoints returns the endpoints of the Alertmanager
+// resources selected or referenced by the Prometheus resource.
+//
+// If the operator doesn't watch Alertmanager resources, the selectors and
+// references are ignored and reported in the status.
+func (c *Operator) selectAlertmanagerEndpoints(logger *slog.Logger, p *monitoringv1.Prometheus) ([]operator.AlertmanagerEndpoint, error) {
+	if !p.Spec.Alerting.HasAlertmanagerResources() {
+		return nil, nil
+	}
+
+	if !c.alertmanagerSupported {
+		logger.Warn("ignoring alertmanagerSelector and alertmanagerRefs because the operator doesn't watch Alertmanager resources")
+		c.reconciliations.AddReasonAndMessage(operator.KeyForObject(p), operator.AlertmanagerResourcesUnsupportedReason, operator.AlertmanagerResourcesUnsupportedMessage)
+		return nil, nil
+	}
+
+	ams, err := operator.SelectAlertmanagers(c.amInfs, p.Namespace, p.Spec.Alerting.AlertmanagerSelector, p.Spec.Alerting.AlertmanagerRefs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select Alertmanager resources: %w", err)
+	}
+
+	eps := make([]operator.AlertmanagerEndpoint, 0, len(ams))
+	for _, am := range ams {
+		eps = append(eps, operator.NewAlertmanagerEndpoint(am))
+	}
+
+	return eps, nil
+}
+
+// selectFederationEndpoints returns the endpoints of the Prometheus resources
+// referenced as federation sources, indexed by namespace and name.
+//
+// Invalid federation sources and sources referencing the Prometheus resource
+// itself are ignored and reported in the status.
+func (c *Operator) selectFederationEndpoints(logger *slog.Logger, p *monitoringv1.Prometheus) (map[string]prompkg.FederationEndpoint, error) {
+	if len(p.Spec.FederationSources) == 0 {
+		return nil, nil
+	}
+
+	var (
+		eps     = make(map[string]prompkg.FederationEndpoint, len(p.Spec.FederationSources))
+		self    = operator.KeyForObject(p)
+		invalid []string
+	)
+	for i, src := range p.Spec.FederationSources {
+		key := prompkg.FederationSourceKey(p.Namespace, src)
+		if key == self {
+			logger.Warn("ignoring federation source which references the Prometheus resource itself", "source", key)
+			invalid = append(invalid, fmt.Sprintf("federationSources[%d]: the Prometheus resource can't federate itself", i))
+			continue
+		}
+
+		if err := prompkg.ValidateFederationSource(src); err != nil {
+			logger.Warn("ignoring invalid federation source", "source", key, "err", err)
+			invalid = append(invalid, fmt.Sprintf("federationSources[%d]: %s", i, err))
+			continue
+		}
+
+		if _, found := eps[key]; found {
+			continue
+		}
+
+		source, err := operator.GetObjectFromKey[*monitoringv1.Prometheus](c.promInfs, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Prometheus %q: %w", key, err)
+		}
+
+		if source == nil || source.DeletionTimestamp != nil {
+			logger.Debug("ignoring federation source which doesn't exist", "source", key)
+			continue
+		}
+
+		if source.Spec.ListenLocal {
+			logger.Warn("ignoring federation source which listens on the loopback interface", "source", key)
+			continue
+		}
+
+		eps[key] = prompkg.NewFederationEndpoint(source, governingServiceName)
+	}
+
+	if len(invalid) > 0 {
+		c.reconciliations.AddReasonAndMessage(self, invalidFederationSourcesReason, strings.Join(invalid, "; "))
+	}
+
+	return eps, nil
+}
+
 func addAlertmanagerEndpointsToStore(ctx context.Context, store *assets.StoreBuilder, namespace string, ams []monitoringv1.AlertmanagerEndpoints) error {
 	for i, am := range ams {
 		if err := store.AddBasicAuth(ctx, namespace, am.BasicAuth); err != nil {
@@ -29,9 +29,11 @@ import (
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 )
@@ -207,10 +209,10 @@ func TestCreateStatefulSetInputHash(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			c := prompkg.Config{}
 
-			p1Hash, err := createSSetInputHash(tc.a, c, []string{}, &operator.ShardedSecret{}, appsv1.StatefulSetSpec{})
+			p1Hash, err := createSSetInputHash(tc.a, c, []string{}, &operator.ShardedSecret{}, nil, nil, appsv1.StatefulSetSpec{})
 			require.NoError(t, err)
 
-			p2Hash, err := createSSetInputHash(tc.b, c, []string{}, &operator.ShardedSecret{}, appsv1.StatefulSetSpec{})
+			p2Hash, err := createSSetInputHash(tc.b, c, []string{}, &operator.ShardedSecret{}, nil, nil, appsv1.StatefulSetSpec{})
 			require.NoError(t, err)
 
 			if !tc.equal {
@@ -220,7 +222,7 @@ func TestCreateStatefulSetInputHash(t *testing.T) {
 
 			require.Equal(t, p1Hash, p2Hash, "expected two Prometheus CRDs to produce the same hash but got different hash")
 
-			p2Hash, err = createSSetInputHash(tc.a, c, []string{}, &operator.ShardedSecret{}, appsv1.StatefulSetSpec{Replicas: ptr.To(int32(2))})
+			p2Hash, err = createSSetInputHash(tc.a, c, []string{}, &operator.ShardedSecret{}, nil, nil, appsv1.StatefulSetSpec{Replicas: ptr.To(int32(2))})
 			require.NoError(t, err)
 
 			require.NotEqual(t, p1Hash, p2Hash, "expected same Prometheus CRDs with different statefulset specs to produce different hashes but got equal hash")
@@ -267,6 +269,7 @@ func TestProcessShardRetention(t *testing.T) {
 	for _, tc := range []struct {
 		name                     string
 		retentionPoliciesEnabled bool
+		shardAutoscalingEnabled  bool
 		spec                     monitoringv1.PrometheusSpec
 		annotations              map[string]string
 		injectPatchError         bool
@@ -345,6 +348,29 @@ func TestProcessShardRetention(t *testing.T) {
 			expectedPatch:          true,
 			expectedDeadlineIsZero: true,
 		},
+		{
+			name:                     "autoscaling without ShardRetentionPolicy",
+			retentionPoliciesEnabled: true,
+			shardAutoscalingEnabled:  true,
+			spec: monitoringv1.PrometheusSpec{
+				Autoscaling: &monitoringv1.ShardAutoscaling{MaxShards: 10, TargetHeadSeries: 1000},
+			},
+			expectedDelete:           false,
+			expectedPatch:            true,
+			expectedDeadlineDuration: 24 * time.Hour,
+		},
+		{
+			name:                     "autoscaling with WhenScaled set to Delete",
+			retentionPoliciesEnabled: true,
+			shardAutoscalingEnabled:  true,
+			spec: monitoringv1.PrometheusSpec{
+				Autoscaling: &monitoringv1.ShardAutoscaling{MaxShards: 10, TargetHeadSeries: 1000},
+				ShardRetentionPolicy: &monitoringv1.ShardRetentionPolicy{
+					WhenScaled: ptr.To(monitoringv1.DeleteWhenScaledRetentionType),
+				},
+			},
+			expectedDelete: true,
+		},
 		{
 			name:                     "patch failure returns error",
 			retentionPoliciesEnabled: true,
@@ -376,6 +402,7 @@ func TestProcessShardRetention(t *testing.T) {
 			}
 			o := &Operator{
 				retentionPoliciesEnabled: tc.retentionPoliciesEnabled,
+				shardAutoscalingEnabled:  tc.shardAutoscalingEnabled,
 				kclient:                  kclient,
 			}
 
@@ -427,6 +454,39 @@ func TestProcessShardRetention(t *testing.T) {
 	}
 }
 
+func TestCancelShardDeletion(t *testing.T) {
+	sset := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus-example-shard-1",
+			Namespace: "test",
+			Annotations: map[string]string{
+				deletionDeadlineAnnotation: time.Now().UTC().Add(time.Hour).Format(annotationTimeFormat),
+				"foo":                      "bar",
+			},
+		},
+	}
+	kclient := fake.NewSimpleClientset(sset)
+	o := &Operator{kclient: kclient}
+
+	require.NoError(t, o.cancelShardDeletion(context.Background(), sset))
+
+	actions := kclient.Actions()
+	require.Len(t, actions, 1)
+	patchAction, ok := actions[0].(clienttesting.PatchAction)
+	require.True(t, ok)
+
+	var body struct {
+		Metadata struct {
+			Annotations map[string]*string `json:"annotations"`
+		} `json:"metadata"`
+	}
+	require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &body))
+	v, found := body.Metadata.Annotations[deletionDeadlineAnnotation]
+	require.True(t, found)
+	require.Nil(t, v)
+	require.Len(t, body.Metadata.Annotations, 1)
+}
+
 func TestGracePeriodForPrometheusStorage(t *testing.T) {
 	for _, tc := range []struct {
 		name             string
@@ -509,3 +569,186 @@ func TestGracePeriodForPrometheusStorage(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectFederationEndpointsIgnoresInvalidSources(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "global",
+			Namespace: "monitoring",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			FederationSources: []monitoringv1.FederationSource{
+				{
+					Name:  "global",
+					Match: []string{`{__name__=~"job:.*"}`},
+				},
+				{
+					Namespace: ptr.To("zone-a"),
+					Name:      "zone-a",
+					Match:     []string{`sum(up)`},
+				},
+			},
+		},
+	}
+
+	o := &Operator{reconciliations: &operator.ReconciliationTracker{}}
+	eps, err := o.selectFederationEndpoints(prompkg.NewLogger(), p)
+	require.NoError(t, err)
+	require.Empty(t, eps)
+
+	cond := o.reconciliations.GetCondition(operator.KeyForObject(p), 1)
+	require.Equal(t, monitoringv1.ConditionTrue, cond.Status)
+	require.Equal(t, invalidFederationSourcesReason, cond.Reason)
+	require.Contains(t, cond.Message, "federationSources[0]")
+	require.Contains(t, cond.Message, "federationSources[1]")
+}
+
+func TestSelectAlertmanagerEndpointsWithoutAlertmanagerSupport(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "monitoring",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			Alerting: &monitoringv1.AlertingSpec{
+				AlertmanagerRefs: []monitoringv1.AlertmanagerReference{{Name: "main"}},
+			},
+		},
+	}
+
+	o := &Operator{reconciliations: &operator.ReconciliationTracker{}}
+	eps, err := o.selectAlertmanagerEndpoints(prompkg.NewLogger(), p)
+	require.NoError(t, err)
+	require.Empty(t, eps)
+
+	cond := o.reconciliations.GetCondition(operator.KeyForObject(p), 1)
+	require.Equal(t, operator.AlertmanagerResourcesUnsupportedReason, cond.Reason)
+}
+
+func TestDeleteInactiveShardSecrets(t *testing.T) {
+	secret := func(name string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name             string
+		resourceSharding bool
+		expected         []string
+	}{
+		{
+			name: "default sharding mode",
+			expected: []string{
+				"other",
+				"prometheus-test",
+				"prometheus-test-scrape-config-files-0",
+				// Still mounted by the retained StatefulSet of shard 2.
+				"prometheus-test-shard-2",
+			},
+		},
+		{
+			name:             "resource sharding",
+			resourceSharding: true,
+			expected: []string{
+				"other",
+				"prometheus-test",
+				"prometheus-test-scrape-config-files-0",
+				"prometheus-test-shard-1",
+				"prometheus-test-shard-1-scrape-config-files-0",
+				"prometheus-test-shard-2",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Shards:                 ptr.To(int32(2)),
+						ServiceMonitorSelector: &metav1.LabelSelector{},
+						ShardingStrategy:       &monitoringv1.ShardingStrategy{Mode: ptr.To(monitoringv1.NamespaceShardingStrategyMode)},
+					},
+				},
+			}
+
+			kclient := fake.NewClientset(
+				secret("other"),
+				secret("prometheus-test"),
+				secret("prometheus-test-scrape-config-files-0"),
+				secret("prometheus-test-shard-1"),
+				secret("prometheus-test-shard-1-scrape-config-files-0"),
+				secret("prometheus-test-shard-2"),
+				secret("prometheus-test-shard-3"),
+				&appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "prometheus-test-shard-2",
+						Namespace: "default",
+						Labels: map[string]string{
+							prompkg.PrometheusNameLabelName: "test",
+							prompkg.PrometheusModeLabelName: prometheusMode,
+							prompkg.ShardLabelName:          "2",
+						},
+					},
+					Spec: appsv1.StatefulSetSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Volumes: []corev1.Volume{
+									{
+										Name: "config",
+										VolumeSource: corev1.VolumeSource{
+											Secret: &corev1.SecretVolumeSource{SecretName: "prometheus-test-shard-2"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			)
+
+			ifs := informers.NewKubeInformerFactories(
+				map[string]struct{}{metav1.NamespaceAll: {}},
+				nil,
+				kclient,
+				0,
+				nil,
+			)
+			secrInfs, err := informers.NewInformersForResource(ifs, corev1.SchemeGroupVersion.WithResource(string(corev1.ResourceSecrets)))
+			require.NoError(t, err)
+			ssetInfs, err := informers.NewInformersForResource(ifs, appsv1.SchemeGroupVersion.WithResource("statefulsets"))
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			secrInfs.Start(ctx.Done())
+			ssetInfs.Start(ctx.Done())
+			require.True(t, cache.WaitForCacheSync(ctx.Done(), secrInfs.HasSynced, ssetInfs.HasSynced))
+
+			var opts []prompkg.ConfigGeneratorOption
+			if tc.resourceSharding {
+				opts = append(opts, prompkg.WithPrometheusResourceSharding())
+			}
+			cg, err := prompkg.NewConfigGenerator(prompkg.NewLogger(), p, opts...)
+			require.NoError(t, err)
+
+			o := &Operator{kclient: kclient, secrInfs: secrInfs, ssetInfs: ssetInfs}
+			require.NoError(t, o.deleteInactiveShardSecrets(context.Background(), p, cg))
+
+			secrets, err := kclient.CoreV1().Secrets("default").List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+
+			var names []string
+			for _, s := range secrets.Items {
+				names = append(names, s.Name)
+			}
+			require.ElementsMatch(t, tc.expected, names)
+		})
+	}
+}
@@ -18,9 +18,14 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 
+	"github.com/cespare/xxhash/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -81,24 +86,136 @@ func (c *Operator) selectPrometheusRules(p *monitoringv1.Prometheus, logger *slo
 }
 
 func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitoringv1.Prometheus, rules operator.PrometheusRuleSelection, logger *slog.Logger) ([]string, error) {
+	if ruleShardingActive(p) {
+		return c.createOrUpdateShardedRuleConfigMaps(ctx, p, rules, logger)
+	}
 
 	// Update the corresponding ConfigMap resources.
-	prs := operator.NewPrometheusRuleSyncer(
+	prs := c.newPrometheusRuleSyncer(p, 0, labels.Set{prompkg.LabelPrometheusName: p.Name}, logger)
+
+	configMapNames, err := prs.Sync(ctx, rules.RuleFiles())
+	if err != nil {
+		return nil, fmt.Errorf("synchronizing PrometheusRules failed: %w", err)
+	}
+
+	return prs.AppendConfigMapNames(configMapNames, 3), nil
+}
+
+// createOrUpdateShardedRuleConfigMaps synchronizes the rule ConfigMaps of
+// each shard. Each shard only gets the rule groups assigned to it.
+//
+// The returned names are the names of the shard 0's ConfigMaps. The other
+// shards mount their own ConfigMaps at the same paths so that all the shards
+// can share the same configuration.
+func (c *Operator) createOrUpdateShardedRuleConfigMaps(ctx context.Context, p *monitoringv1.Prometheus, rules operator.PrometheusRuleSelection, logger *slog.Logger) ([]string, error) {
+	shards := int32(len(prompkg.ExpectedStatefulSetShardNames(p)))
+
+	ruleFiles, err := rules.RuleFilesByShard(shards, func(rule *monitoringv1.PrometheusRule, i int) int32 {
+		return ruleGroupShard(p, shards, rule, i)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("assigning PrometheusRules to shards failed: %w", err)
+	}
+
+	var (
+		n       int
+		desired = map[string]struct{}{}
+	)
+	for shard := range shards {
+		prs := c.newPrometheusRuleSyncer(
+			p,
+			shard,
+			labels.Set{
+				prompkg.LabelPrometheusName: p.Name,
+				prompkg.ShardLabelName:      strconv.Itoa(int(shard)),
+			},
+			logger.With("shard", shard),
+		)
+
+		configMapNames, err := prs.Sync(ctx, ruleFiles[shard])
+		if err != nil {
+			return nil, fmt.Errorf("synchronizing PrometheusRules for shard %d failed: %w", shard, err)
+		}
+
+		for _, name := range configMapNames {
+			desired[name] = struct{}{}
+		}
+		n = max(n, len(configMapNames))
+	}
+
+	// Delete the ConfigMaps which aren't used anymore (e.g. ConfigMaps of
+	// scaled-down shards or generated by a previous rule sharding mode).
+	var unused []string
+	if err := c.cmapInfs.ListAllByNamespace(
+		p.Namespace,
+		labels.SelectorFromSet(labels.Set{prompkg.LabelPrometheusName: p.Name}),
+		func(obj any) {
+			name := obj.(metav1.Object).GetName()
+			if _, found := desired[name]; !found {
+				unused = append(unused, name)
+			}
+		},
+	); err != nil {
+		return nil, fmt.Errorf("listing rule ConfigMaps failed: %w", err)
+	}
+
+	cmClient := c.kclient.CoreV1().ConfigMaps(p.Namespace)
+	for _, name := range unused {
+		logger.Debug("deleting unused ConfigMap for PrometheusRule", "configmap", name)
+		if err := cmClient.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete unused ConfigMap %q: %w", name, err)
+		}
+	}
+
+	prs := c.newPrometheusRuleSyncer(p, 0, nil, logger)
+	return prs.AppendConfigMapNames(nil, max(n, 3)), nil
+}
+
+func (c *Operator) newPrometheusRuleSyncer(p *monitoringv1.Prometheus, shard int32, selector labels.Set, logger *slog.Logger) *operator.PrometheusRuleSyncer {
+	return operator.NewPrometheusRuleSyncer(
 		logger,
-		fmt.Sprintf("prometheus-%s", p.Name),
+		prompkg.PrefixedNameForShard(p, shard),
 		c.kclient.CoreV1().ConfigMaps(p.Namespace),
-		labels.Set{prompkg.LabelPrometheusName: p.Name},
+		selector,
 		[]operator.ObjectOption{
 			operator.WithAnnotations(c.config.Annotations),
 			operator.WithLabels(c.config.Labels),
 			operator.WithManagingOwner(p),
 		},
 	)
+}
 
-	configMapNames, err := prs.Sync(ctx, rules.RuleFiles())
-	if err != nil {
-		return nil, fmt.Errorf("synchronizing PrometheusRules failed: %w", err)
+// ruleShardingActive returns true if the rule groups are assigned to
+// specific shards.
+func ruleShardingActive(p *monitoringv1.Prometheus) bool {
+	if p.Spec.RuleSharding == nil {
+		return false
 	}
 
-	return prs.AppendConfigMapNames(configMapNames, 3), nil
+	return ptr.Deref(p.Spec.RuleSharding.Mode, monitoringv1.AllRuleShardingMode) != monitoringv1.AllRuleShardingMode
+}
+
+// ruleGroupShard returns the shard evaluating the i-th rule group of the
+// PrometheusRule.
+func ruleGroupShard(p *monitoringv1.Prometheus, shards int32, rule *monitoringv1.PrometheusRule, i int) int32 {
+	group := rule.Spec.Groups[i]
+	if group.Shard != nil {
+		return *group.Shard % shards
+	}
+
+	if *p.Spec.RuleSharding.Mode == monitoringv1.FirstShardRuleShardingMode {
+		return 0
+	}
+
+	return int32(xxhash.Sum64String(fmt.Sprintf("%s/%s/%s", rule.Namespace, rule.Name, group.Name)) % uint64(shards))
+}
+
+// ruleConfigMapNameForShard returns the name of the shard's ConfigMap which
+// is mounted in place of the given rule ConfigMap.
+func ruleConfigMapNameForShard(p *monitoringv1.Prometheus, shard int32, name string) string {
+	if !ruleShardingActive(p) {
+		return name
+	}
+
+	return prompkg.PrefixedNameForShard(p, shard) + strings.TrimPrefix(name, prompkg.PrefixedName(p))
 }
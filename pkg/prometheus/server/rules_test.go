@@ -0,0 +1,164 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+func makePrometheusWithRuleSharding(mode monitoringv1.RuleShardingMode, shards int32) *monitoringv1.Prometheus {
+	return &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				Shards: ptr.To(shards),
+			},
+			RuleSharding: &monitoringv1.RuleShardingStrategy{
+				Mode: ptr.To(mode),
+			},
+		},
+	}
+}
+
+func TestRuleGroupShard(t *testing.T) {
+	rule := &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rule",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{
+			{Name: "group-0"},
+			{Name: "group-1", Shard: ptr.To(int32(1))},
+			{Name: "group-2", Shard: ptr.To(int32(5))},
+		}},
+	}
+
+	t.Run("hash", func(t *testing.T) {
+		p := makePrometheusWithRuleSharding(monitoringv1.HashRuleShardingMode, 3)
+
+		shard := ruleGroupShard(p, 3, rule, 0)
+		require.GreaterOrEqual(t, shard, int32(0))
+		require.Less(t, shard, int32(3))
+		// The assignment is stable.
+		require.Equal(t, shard, ruleGroupShard(p, 3, rule, 0))
+
+		require.Equal(t, int32(1), ruleGroupShard(p, 3, rule, 1))
+		// The shard value is taken modulo the number of shards.
+		require.Equal(t, int32(2), ruleGroupShard(p, 3, rule, 2))
+	})
+
+	t.Run("first shard", func(t *testing.T) {
+		p := makePrometheusWithRuleSharding(monitoringv1.FirstShardRuleShardingMode, 3)
+
+		require.Equal(t, int32(0), ruleGroupShard(p, 3, rule, 0))
+		require.Equal(t, int32(1), ruleGroupShard(p, 3, rule, 1))
+		require.Equal(t, int32(2), ruleGroupShard(p, 3, rule, 2))
+	})
+}
+
+func TestRuleConfigMapNameForShard(t *testing.T) {
+	p := makePrometheusWithRuleSharding(monitoringv1.HashRuleShardingMode, 2)
+	require.Equal(t, "prometheus-test-rulefiles-0", ruleConfigMapNameForShard(p, 0, "prometheus-test-rulefiles-0"))
+	require.Equal(t, "prometheus-test-shard-1-rulefiles-0", ruleConfigMapNameForShard(p, 1, "prometheus-test-rulefiles-0"))
+
+	p = makePrometheusWithRuleSharding(monitoringv1.AllRuleShardingMode, 2)
+	require.Equal(t, "prometheus-test-rulefiles-0", ruleConfigMapNameForShard(p, 1, "prometheus-test-rulefiles-0"))
+}
+
+func TestCreateOrUpdateShardedRuleConfigMaps(t *testing.T) {
+	p := makePrometheusWithRuleSharding(monitoringv1.HashRuleShardingMode, 2)
+
+	// ConfigMaps left over by a previous configuration.
+	kclient := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "prometheus-test-rulefiles-1",
+				Namespace: "default",
+				Labels:    map[string]string{prompkg.LabelPrometheusName: "test"},
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "prometheus-test-shard-2-rulefiles-0",
+				Namespace: "default",
+				Labels: map[string]string{
+					prompkg.LabelPrometheusName: "test",
+					prompkg.ShardLabelName:      "2",
+				},
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other",
+				Namespace: "default",
+			},
+		},
+	)
+	cmapInfs, err := informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			map[string]struct{}{metav1.NamespaceAll: {}},
+			nil,
+			kclient,
+			0,
+			nil,
+		),
+		corev1.SchemeGroupVersion.WithResource(string(corev1.ResourceConfigMaps)),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	cmapInfs.Start(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), cmapInfs.HasSynced))
+
+	o := &Operator{kclient: kclient, cmapInfs: cmapInfs}
+
+	names, err := o.createOrUpdateRuleConfigMaps(context.Background(), p, operator.PrometheusRuleSelection{}, prompkg.NewLogger())
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"prometheus-test-rulefiles-0",
+		"prometheus-test-rulefiles-1",
+		"prometheus-test-rulefiles-2",
+	}, names)
+
+	cms, err := kclient.CoreV1().ConfigMaps("default").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+
+	var got []string
+	for _, cm := range cms.Items {
+		got = append(got, cm.Name)
+	}
+	require.ElementsMatch(t, []string{
+		"other",
+		"prometheus-test-rulefiles-0",
+		"prometheus-test-shard-1-rulefiles-0",
+	}, got)
+}
@@ -49,6 +49,8 @@ func makeStatefulSet(
 	inputHash string,
 	shard int32,
 	tlsSecrets *operator.ShardedSecret,
+	scrapeConfigSecrets *operator.ShardedSecret,
+	staticConfigsFrom []monitoringv1.SecretOrConfigMap,
 ) (*appsv1.StatefulSet, error) {
 	cpf := p.GetCommonPrometheusFields()
 	objMeta := p.GetObjectMeta()
@@ -62,7 +64,7 @@ func makeStatefulSet(
 	// We need to re-set the common fields because cpf is only a copy of the original object.
 	// We set some defaults if some fields are not present, and we want those fields set in the original Prometheus object before building the StatefulSetSpec.
 	p.SetCommonPrometheusFields(cpf)
-	spec, err := makeStatefulSetSpec(p, config, cg, shard, ruleConfigMapNames, tlsSecrets)
+	spec, err := makeStatefulSetSpec(p, config, cg, shard, ruleConfigMapNames, tlsSecrets, scrapeConfigSecrets, staticConfigsFrom)
 	if err != nil {
 		return nil, fmt.Errorf("make StatefulSet spec: %w", err)
 	}
@@ -146,6 +148,8 @@ func makeStatefulSetSpec(
 	shard int32,
 	ruleConfigMapNames []string,
 	tlsSecrets *operator.ShardedSecret,
+	scrapeConfigSecrets *operator.ShardedSecret,
+	staticConfigsFrom []monitoringv1.SecretOrConfigMap,
 ) (*appsv1.StatefulSetSpec, error) {
 	cpf := p.GetCommonPrometheusFields()
 
@@ -165,12 +169,17 @@ func makeStatefulSetSpec(
 
 	promArgs := buildServerArgs(cg, p)
 
-	volumes, promVolumeMounts, err := prompkg.BuildCommonVolumes(p, tlsSecrets, true)
+	configSecretName := prompkg.ConfigSecretName(p)
+	if cg.IsResourceShardingActive() {
+		configSecretName = prompkg.ConfigSecretNameForShard(p, shard)
+	}
+
+	volumes, promVolumeMounts, err := prompkg.BuildCommonVolumes(p, configSecretName, tlsSecrets, staticConfigsFrom, true)
 	if err != nil {
 		return nil, err
 	}
 
-	volumes, promVolumeMounts = appendServerVolumes(p, volumes, promVolumeMounts, ruleConfigMapNames)
+	volumes, promVolumeMounts = appendServerVolumes(p, shard, volumes, promVolumeMounts, ruleConfigMapNames)
 
 	configReloaderVolumeMounts := prompkg.CreateConfigReloaderVolumeMounts()
 
@@ -269,6 +278,16 @@ func makeStatefulSetSpec(
 	if topologyZone != "" {
 		reloaderOpts = append(reloaderOpts, operator.InzoneShard(ptr.To(cg.InzoneShardForShard(shard))))
 	}
+
+	// When the configuration is too large for a single Secret, the scrape
+	// configurations are stored in separate files which the config-reloader
+	// decompresses and writes to the config-out volume.
+	if scrapeConfigSecrets != nil {
+		vol, mount, opt := prompkg.ScrapeConfigFilesVolume(scrapeConfigSecrets)
+		volumes = append(volumes, vol)
+		configReloaderVolumeMounts = append(configReloaderVolumeMounts, mount)
+		reloaderOpts = append(reloaderOpts, opt)
+	}
 	operatorInitContainers = append(operatorInitContainers,
 		prompkg.BuildConfigReloader(
 			p,
@@ -466,7 +485,9 @@ func buildServerArgs(cg *prompkg.ConfigGenerator, p *monitoringv1.Prometheus) []
 }
 
 // appendServerVolumes returns a set of volumes to be mounted on the statefulset spec that are specific to Prometheus Server.
-func appendServerVolumes(p *monitoringv1.Prometheus, volumes []corev1.Volume, volumeMounts []corev1.VolumeMount, ruleConfigMapNames []string) ([]corev1.Volume, []corev1.VolumeMount) {
+// When the rule groups are assigned to shards, the shard's rule ConfigMaps are
+// mounted in place of the given ones.
+func appendServerVolumes(p *monitoringv1.Prometheus, shard int32, volumes []corev1.Volume, volumeMounts []corev1.VolumeMount, ruleConfigMapNames []string) ([]corev1.Volume, []corev1.VolumeMount) {
 	// not mount 2 emptyDir volumes at the same mountpath
 	if volume, ok := queryLogFileVolume(p.Spec.QueryLogFile); ok && p.Spec.ScrapeFailureLogFile == nil {
 		volumes = append(volumes, volume)
@@ -478,7 +499,7 @@ func appendServerVolumes(p *monitoringv1.Prometheus, volumes []corev1.Volume, vo
 			VolumeSource: corev1.VolumeSource{
 				ConfigMap: &corev1.ConfigMapVolumeSource{
 					LocalObjectReference: corev1.LocalObjectReference{
-						Name: name,
+						Name: ruleConfigMapNameForShard(p, shard, name),
 					},
 					Optional: ptr.To(true),
 				},
@@ -62,7 +62,9 @@ func makeStatefulSetFromPrometheus(p monitoringv1.Prometheus) (*appsv1.StatefulS
 		nil,
 		"abc",
 		0,
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil,
+		nil)
 }
 
 func TestStatefulSetLabelingAndAnnotations(t *testing.T) {
@@ -429,13 +431,77 @@ func TestStatefulSetVolumeInitial(t *testing.T) {
 		[]string{"rules-configmap-one"},
 		"",
 		0,
-		shardedSecret)
+		shardedSecret,
+		nil,
+		nil)
 	require.NoError(t, err)
 
 	require.Equalf(t, expected.Spec.Template.Spec.Volumes, sset.Spec.Template.Spec.Volumes, "expected volumes to match \n%s", pretty.Compare(expected.Spec.Template.Spec.Volumes, sset.Spec.Template.Spec.Volumes))
 	require.Equalf(t, expected.Spec.Template.Spec.Containers[0].VolumeMounts, sset.Spec.Template.Spec.Containers[0].VolumeMounts, "expected volume mounts to match \n%s", pretty.Compare(expected.Spec.Template.Spec.Containers[0].VolumeMounts, sset.Spec.Template.Spec.Containers[0].VolumeMounts))
 }
 
+func TestStatefulSetScrapeConfigFiles(t *testing.T) {
+	p := monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+	}
+
+	cg, err := prompkg.NewConfigGenerator(prompkg.NewLogger(), &p)
+	require.NoError(t, err)
+
+	scrapeConfigSecrets, err := operator.ReconcileShardedSecret(
+		context.Background(),
+		map[string][]byte{
+			"scrape-configs-0000.yaml": make([]byte, operator.MaxSecretDataSizeBytes-30),
+			"scrape-configs-0001.yaml": []byte("data"),
+		},
+		fake.NewClientset(),
+		prompkg.NewScrapeConfigFilesSecret(&p, defaultTestConfig, 0),
+	)
+	require.NoError(t, err)
+
+	sset, err := makeStatefulSet("test", &p, defaultTestConfig, cg, nil, "", 0, &operator.ShardedSecret{}, scrapeConfigSecrets, nil)
+	require.NoError(t, err)
+
+	require.Contains(t, sset.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: "scrape-config-files",
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "prometheus-test-scrape-config-files-0"}}},
+					{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "prometheus-test-scrape-config-files-1"}}},
+				},
+			},
+		},
+	})
+
+	for _, c := range append(sset.Spec.Template.Spec.InitContainers, sset.Spec.Template.Spec.Containers...) {
+		if c.Name != "config-reloader" && c.Name != "init-config-reloader" {
+			require.NotContains(t, c.VolumeMounts, corev1.VolumeMount{Name: "scrape-config-files", MountPath: "/etc/prometheus/scrape_config_files"})
+			continue
+		}
+
+		require.Contains(t, c.VolumeMounts, corev1.VolumeMount{Name: "scrape-config-files", MountPath: "/etc/prometheus/scrape_config_files"})
+		require.Contains(t, c.Args, "--config-dir=/etc/prometheus/scrape_config_files")
+		require.Contains(t, c.Args, "--config-dir-output=/etc/prometheus/config_out/scrape_config_files")
+	}
+
+	// Without scrape configuration files, nothing is mounted.
+	sset, err = makeStatefulSet("test", &p, defaultTestConfig, cg, nil, "", 0, &operator.ShardedSecret{}, nil, nil)
+	require.NoError(t, err)
+
+	for _, v := range sset.Spec.Template.Spec.Volumes {
+		require.NotEqual(t, "scrape-config-files", v.Name)
+	}
+	for _, c := range sset.Spec.Template.Spec.InitContainers {
+		for _, arg := range c.Args {
+			require.False(t, strings.HasPrefix(arg, "--config-dir"), arg)
+		}
+	}
+}
+
 func TestAdditionalConfigMap(t *testing.T) {
 	sset, err := makeStatefulSetFromPrometheus(monitoringv1.Prometheus{
 		Spec: monitoringv1.PrometheusSpec{
@@ -825,7 +891,9 @@ func TestPrometheusDefaultBaseImageFlag(t *testing.T) {
 		nil,
 		"",
 		0,
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil,
+		nil)
 	require.NoError(t, err)
 
 	image := sset.Spec.Template.Spec.Containers[0].Image
@@ -867,7 +935,9 @@ func TestThanosDefaultBaseImageFlag(t *testing.T) {
 		nil,
 		"",
 		0,
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil,
+		nil)
 	require.NoError(t, err)
 
 	image := sset.Spec.Template.Spec.Containers[2].Image
@@ -1361,7 +1431,9 @@ func TestReplicasConfigurationWithSharding(t *testing.T) {
 		nil,
 		"",
 		1,
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil,
+		nil)
 	require.NoError(t, err)
 
 	require.Equal(t, int32(2), *sset.Spec.Replicas, "Unexpected replicas configuration.")
@@ -1402,7 +1474,9 @@ func TestSidecarResources(t *testing.T) {
 			nil,
 			"",
 			0,
-			&operator.ShardedSecret{})
+			&operator.ShardedSecret{},
+			nil,
+			nil)
 		require.NoError(t, err)
 		return sset
 	})
@@ -1761,6 +1835,89 @@ func TestExpectedStatefulSetShardNames(t *testing.T) {
 	}
 }
 
+func TestConfigSecretWithResourceSharding(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		mode    monitoringv1.ShardingStrategyMode
+		shard   int32
+		enabled bool
+
+		expected string
+	}{
+		{
+			name:     "address mode",
+			mode:     monitoringv1.AddressShardingStrategyMode,
+			shard:    1,
+			enabled:  true,
+			expected: "prometheus-test",
+		},
+		{
+			name:     "namespace mode without feature gate",
+			mode:     monitoringv1.NamespaceShardingStrategyMode,
+			shard:    1,
+			expected: "prometheus-test",
+		},
+		{
+			name:     "namespace mode shard 0",
+			mode:     monitoringv1.NamespaceShardingStrategyMode,
+			shard:    0,
+			enabled:  true,
+			expected: "prometheus-test",
+		},
+		{
+			name:     "namespace mode shard 1",
+			mode:     monitoringv1.NamespaceShardingStrategyMode,
+			shard:    1,
+			enabled:  true,
+			expected: "prometheus-test-shard-1",
+		},
+		{
+			name:     "monitor mode shard 2",
+			mode:     monitoringv1.MonitorShardingStrategyMode,
+			shard:    2,
+			enabled:  true,
+			expected: "prometheus-test-shard-2",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Shards:           ptr.To(int32(3)),
+						ShardingStrategy: &monitoringv1.ShardingStrategy{Mode: ptr.To(tc.mode)},
+					},
+				},
+			}
+
+			var opts []prompkg.ConfigGeneratorOption
+			if tc.enabled {
+				opts = append(opts, prompkg.WithPrometheusResourceSharding())
+			}
+
+			cg, err := prompkg.NewConfigGenerator(prompkg.NewLogger(), &p, opts...)
+			require.NoError(t, err)
+
+			sset, err := makeStatefulSet("test", &p, defaultTestConfig, cg, nil, "", tc.shard, &operator.ShardedSecret{}, nil, nil)
+			require.NoError(t, err)
+
+			var found bool
+			for _, v := range sset.Spec.Template.Spec.Volumes {
+				if v.Name != "config" {
+					continue
+				}
+
+				found = true
+				require.NotNil(t, v.Secret)
+				require.Equal(t, tc.expected, v.Secret.SecretName)
+			}
+			require.True(t, found)
+		})
+	}
+}
+
 func TestExpectStatefulSetMinReadySeconds(t *testing.T) {
 	sset, err := makeStatefulSetFromPrometheus(monitoringv1.Prometheus{
 		Spec: monitoringv1.PrometheusSpec{},
@@ -1798,7 +1955,9 @@ func TestConfigReloader(t *testing.T) {
 		nil,
 		"",
 		int32(expectedShardNum),
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil,
+		nil)
 	require.NoError(t, err)
 
 	expectedArgsConfigReloader := []string{
@@ -1858,7 +2017,9 @@ func TestConfigReloaderWithSignal(t *testing.T) {
 		nil,
 		"",
 		int32(expectedShardNum),
-		&operator.ShardedSecret{})
+		&operator.ShardedSecret{},
+		nil,
+		nil)
 	require.NoError(t, err)
 
 	expectedArgsConfigReloader := []string{
@@ -3488,6 +3649,8 @@ func TestConfigReloaderTopologyZoneEnvVar(t *testing.T) {
 				"",
 				tc.shardIndex,
 				&operator.ShardedSecret{},
+				nil,
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -3519,3 +3682,45 @@ func TestConfigReloaderTopologyZoneEnvVar(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleConfigMapVolumesWithRuleSharding(t *testing.T) {
+	p := monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				Shards: ptr.To(int32(2)),
+			},
+			RuleSharding: &monitoringv1.RuleShardingStrategy{
+				Mode: ptr.To(monitoringv1.HashRuleShardingMode),
+			},
+		},
+	}
+
+	cg, err := prompkg.NewConfigGenerator(prompkg.NewLogger(), &p)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		shard    int32
+		expected string
+	}{
+		{shard: 0, expected: "prometheus-test-rulefiles-0"},
+		{shard: 1, expected: "prometheus-test-shard-1-rulefiles-0"},
+	} {
+		sset, err := makeStatefulSet("test", &p, defaultTestConfig, cg, []string{"prometheus-test-rulefiles-0"}, "", tc.shard, &operator.ShardedSecret{}, nil, nil)
+		require.NoError(t, err)
+
+		var found bool
+		for _, v := range sset.Spec.Template.Spec.Volumes {
+			if v.Name != "prometheus-test-rulefiles-0" {
+				continue
+			}
+
+			found = true
+			require.Equal(t, tc.expected, v.ConfigMap.Name)
+		}
+		require.True(t, found)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"path"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8s"
+)
+
+const (
+	staticConfigsSecretsDir    = "/etc/prometheus/static-configs/secrets/"
+	staticConfigsConfigMapsDir = "/etc/prometheus/static-configs/configmaps/"
+
+	// staticConfigsFileExtension is the extension of the mounted files.
+	// Prometheus infers the format of the file service discovery files from
+	// their extension and since YAML is a superset of JSON, both formats are
+	// supported.
+	staticConfigsFileExtension = ".yaml"
+)
+
+// StaticConfigsFrom returns the ConfigMap and Secret keys referenced by the
+// staticConfigsFrom field of the given ScrapeConfig resources.
+func StaticConfigsFrom(sCons map[string]*monitoringv1alpha1.ScrapeConfig) []monitoringv1.SecretOrConfigMap {
+	var sels []monitoringv1.SecretOrConfigMap
+	for _, k := range sortutil.SortedKeys(sCons) {
+		sels = append(sels, sCons[k].Spec.StaticConfigsFrom...)
+	}
+
+	return sels
+}
+
+// staticConfigsFromFile returns the path of the file mounted for the given
+// ConfigMap or Secret key.
+func staticConfigsFromFile(sel monitoringv1.SecretOrConfigMap) string {
+	if sel.Secret != nil {
+		return path.Join(staticConfigsSecretsDir, sel.Secret.Name, sel.Secret.Key+staticConfigsFileExtension)
+	}
+
+	return path.Join(staticConfigsConfigMapsDir, sel.ConfigMap.Name, sel.ConfigMap.Key+staticConfigsFileExtension)
+}
+
+// buildStaticConfigsFromVolumes returns the volumes and volume mounts of the
+// ConfigMaps and Secrets referenced by the staticConfigsFrom field of the
+// ScrapeConfig resources.
+//
+// The objects are mounted directly (and not copied into the Secrets
+// managed by the operator) which means that Prometheus picks up changes of
+// the targets as soon as the kubelet updates the files.
+func buildStaticConfigsFromVolumes(sels []monitoringv1.SecretOrConfigMap) ([]corev1.Volume, []corev1.VolumeMount, error) {
+	var (
+		secrets    = map[string][]string{}
+		configMaps = map[string][]string{}
+	)
+	for _, sel := range sels {
+		switch {
+		case sel.Secret != nil:
+			secrets[sel.Secret.Name] = append(secrets[sel.Secret.Name], sel.Secret.Key)
+		case sel.ConfigMap != nil:
+			configMaps[sel.ConfigMap.Name] = append(configMaps[sel.ConfigMap.Name], sel.ConfigMap.Key)
+		}
+	}
+
+	items := func(keys []string) []corev1.KeyToPath {
+		slices.Sort(keys)
+		keys = slices.Compact(keys)
+
+		items := make([]corev1.KeyToPath, 0, len(keys))
+		for _, k := range keys {
+			items = append(items, corev1.KeyToPath{Key: k, Path: k + staticConfigsFileExtension})
+		}
+
+		return items
+	}
+
+	var (
+		volumes      []corev1.Volume
+		volumeMounts []corev1.VolumeMount
+	)
+
+	rn := k8s.NewResourceNamerWithPrefix("static-configs-secret")
+	for _, s := range sortutil.SortedKeys(secrets) {
+		name, err := rn.UniqueDNS1123Label(s)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: s,
+					Items:      items(secrets[s]),
+					// A missing object or key shouldn't prevent the pods
+					// from starting: the ScrapeConfig resource is rejected
+					// at the next reconciliation.
+					Optional: ptr.To(true),
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      name,
+			ReadOnly:  true,
+			MountPath: staticConfigsSecretsDir + s,
+		})
+	}
+
+	rn = k8s.NewResourceNamerWithPrefix("static-configs-configmap")
+	for _, c := range sortutil.SortedKeys(configMaps) {
+		name, err := rn.UniqueDNS1123Label(c)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: c,
+					},
+					Items:    items(configMaps[c]),
+					Optional: ptr.To(true),
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      name,
+			ReadOnly:  true,
+			MountPath: staticConfigsConfigMapsDir + c,
+		})
+	}
+
+	return volumes, volumeMounts, nil
+}
@@ -0,0 +1,116 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestBuildStaticConfigsFromVolumes(t *testing.T) {
+	configMapKey := func(name, key string) monitoringv1.SecretOrConfigMap {
+		return monitoringv1.SecretOrConfigMap{
+			ConfigMap: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Key:                  key,
+			},
+		}
+	}
+	secretKey := func(name, key string) monitoringv1.SecretOrConfigMap {
+		return monitoringv1.SecretOrConfigMap{
+			Secret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Key:                  key,
+			},
+		}
+	}
+
+	sels := []monitoringv1.SecretOrConfigMap{
+		configMapKey("targets", "b.json"),
+		secretKey("targets", "a"),
+		configMapKey("targets", "a.yaml"),
+		// Duplicate keys are mounted once.
+		configMapKey("targets", "b.json"),
+	}
+
+	volumes, volumeMounts, err := buildStaticConfigsFromVolumes(sels)
+	require.NoError(t, err)
+
+	require.Equal(t, []corev1.Volume{
+		{
+			Name: "static-configs-secret-targets-25dd98ec",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: "targets",
+					Items: []corev1.KeyToPath{
+						{Key: "a", Path: "a.yaml"},
+					},
+					Optional: ptr.To(true),
+				},
+			},
+		},
+		{
+			Name: "static-configs-configmap-targets-25dd98ec",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "targets"},
+					Items: []corev1.KeyToPath{
+						{Key: "a.yaml", Path: "a.yaml.yaml"},
+						{Key: "b.json", Path: "b.json.yaml"},
+					},
+					Optional: ptr.To(true),
+				},
+			},
+		},
+	}, volumes)
+
+	require.Equal(t, []corev1.VolumeMount{
+		{
+			Name:      "static-configs-secret-targets-25dd98ec",
+			ReadOnly:  true,
+			MountPath: "/etc/prometheus/static-configs/secrets/targets",
+		},
+		{
+			Name:      "static-configs-configmap-targets-25dd98ec",
+			ReadOnly:  true,
+			MountPath: "/etc/prometheus/static-configs/configmaps/targets",
+		},
+	}, volumeMounts)
+
+	// The files referenced by the configuration match the mounted files.
+	for _, sel := range sels {
+		var (
+			mountPath = volumeMounts[1].MountPath
+			items     = volumes[1].ConfigMap.Items
+			key       string
+		)
+		if sel.Secret != nil {
+			mountPath = volumeMounts[0].MountPath
+			items = volumes[0].Secret.Items
+			key = sel.Secret.Key
+		} else {
+			key = sel.ConfigMap.Key
+		}
+
+		require.Contains(t, items, corev1.KeyToPath{Key: key, Path: path.Base(staticConfigsFromFile(sel))})
+		require.Equal(t, mountPath, path.Dir(staticConfigsFromFile(sel)))
+	}
+}
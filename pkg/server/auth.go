@@ -0,0 +1,270 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/client-go/kubernetes"
+)
+
+// defaultAuthCacheSize is the maximum number of authentication and
+// authorization decisions kept in memory.
+const defaultAuthCacheSize = 1024
+
+// AuthConfig defines the delegated authentication and authorization settings
+// of the web server.
+type AuthConfig struct {
+	Enabled  bool
+	CacheTTL time.Duration
+}
+
+// DelegatedAuth protects HTTP handlers by delegating the authentication and
+// authorization of the requests to the Kubernetes API server (similar to
+// kube-rbac-proxy).
+//
+// Bearer tokens are validated with the TokenReview API and the authenticated
+// user must be allowed to access the request's path as a non-resource URL
+// (checked with the SubjectAccessReview API).
+type DelegatedAuth struct {
+	logger  *slog.Logger
+	kclient kubernetes.Interface
+
+	ttl        time.Duration
+	authnCache *cache.LRUExpireCache
+	authzCache *cache.LRUExpireCache
+}
+
+// NewDelegatedAuth returns a *DelegatedAuth from the given AuthConfig.
+// It returns nil when delegated authentication and authorization isn't
+// enabled.
+func NewDelegatedAuth(logger *slog.Logger, c *AuthConfig, kclient kubernetes.Interface) (*DelegatedAuth, error) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+
+	if !c.Enabled {
+		return nil, nil
+	}
+
+	if c.CacheTTL < 0 {
+		return nil, fmt.Errorf("invalid cache TTL %s: must be greater than or equal to 0", c.CacheTTL)
+	}
+
+	if kclient == nil {
+		return nil, fmt.Errorf("a Kubernetes client is required for delegated authentication and authorization")
+	}
+
+	logger.Info("delegated authentication and authorization enabled", "cache_ttl", c.CacheTTL)
+
+	return &DelegatedAuth{
+		logger:     logger,
+		kclient:    kclient,
+		ttl:        c.CacheTTL,
+		authnCache: cache.NewLRUExpireCache(defaultAuthCacheSize),
+		authzCache: cache.NewLRUExpireCache(defaultAuthCacheSize),
+	}, nil
+}
+
+// Handler wraps the given handler with delegated authentication and
+// authorization. The handler is returned as-is when da is nil.
+func (da *DelegatedAuth) Handler(next http.Handler) http.Handler {
+	if da == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token, found := bearerToken(req)
+		if !found {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		user, err := da.authenticate(req.Context(), token)
+		if err != nil {
+			da.logger.Warn("failed to authenticate request", "path", req.URL.Path, "err", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		if user == nil {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		allowed, err := da.authorize(req.Context(), user, requestVerb(req.Method), req.URL.Path)
+		if err != nil {
+			da.logger.Warn("failed to authorize request", "path", req.URL.Path, "user", user.Username, "err", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		if !allowed {
+			da.logger.Debug("request forbidden", "path", req.URL.Path, "user", user.Username)
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+// authenticate returns the user information associated to the token or nil
+// if the token isn't valid.
+func (da *DelegatedAuth) authenticate(ctx context.Context, token string) (*authenticationv1.UserInfo, error) {
+	h := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(h[:])
+
+	if v, found := da.authnCache.Get(key); found {
+		return v.(*authenticationv1.UserInfo), nil
+	}
+
+	tr, err := da.kclient.AuthenticationV1().TokenReviews().Create(
+		ctx,
+		&authenticationv1.TokenReview{
+			Spec: authenticationv1.TokenReviewSpec{
+				Token: token,
+			},
+		},
+		metav1.CreateOptions{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("token review: %w", err)
+	}
+
+	if !tr.Status.Authenticated {
+		// Failed lookups aren't cached so that an unauthenticated client
+		// can't fill the cache and evict the valid entries.
+		return nil, nil
+	}
+
+	user := &tr.Status.User
+	da.authnCache.Add(key, user, da.ttl)
+
+	return user, nil
+}
+
+// authorize returns whether the user is allowed to perform the verb on the
+// non-resource path.
+func (da *DelegatedAuth) authorize(ctx context.Context, user *authenticationv1.UserInfo, verb, path string) (bool, error) {
+	key, err := authorizationKey(user, verb, path)
+	if err != nil {
+		return false, err
+	}
+
+	if v, found := da.authzCache.Get(key); found {
+		return v.(bool), nil
+	}
+
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(slices.Clone(v))
+	}
+
+	sar, err := da.kclient.AuthorizationV1().SubjectAccessReviews().Create(
+		ctx,
+		&authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				User:   user.Username,
+				UID:    user.UID,
+				Groups: user.Groups,
+				Extra:  extra,
+				NonResourceAttributes: &authorizationv1.NonResourceAttributes{
+					Path: path,
+					Verb: verb,
+				},
+			},
+		},
+		metav1.CreateOptions{},
+	)
+	if err != nil {
+		return false, fmt.Errorf("subject access review: %w", err)
+	}
+
+	da.authzCache.Add(key, sar.Status.Allowed, da.ttl)
+
+	return sar.Status.Allowed, nil
+}
+
+// authorizationKey returns the key of the authorization decision in the
+// cache. It covers all the user attributes sent with the SubjectAccessReview
+// (including the extra attributes) since they may change the decision.
+func authorizationKey(user *authenticationv1.UserInfo, verb, path string) (string, error) {
+	// Maps are encoded with sorted keys hence the key is deterministic.
+	b, err := json.Marshal(struct {
+		Username string
+		UID      string
+		Groups   []string
+		Extra    map[string]authenticationv1.ExtraValue
+		Verb     string
+		Path     string
+	}{
+		Username: user.Username,
+		UID:      user.UID,
+		Groups:   user.Groups,
+		Extra:    user.Extra,
+		Verb:     verb,
+		Path:     path,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to compute the authorization cache key: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func bearerToken(req *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(req.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", false
+	}
+
+	return token, true
+}
+
+// requestVerb maps the HTTP method to the Kubernetes verb for non-resource
+// requests.
+func requestVerb(method string) string {
+	switch method {
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		return "delete"
+	default:
+		return "get"
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func newFakeAuthClient(tokenReviews, accessReviews *int, fail bool) *fake.Clientset {
+	c := fake.NewClientset()
+
+	c.PrependReactor("create", "tokenreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		*tokenReviews++
+		if fail {
+			return true, nil, errors.New("API server unavailable")
+		}
+
+		tr := action.(clienttesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		switch tr.Spec.Token {
+		case "admin-token":
+			tr.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User:          authenticationv1.UserInfo{Username: "admin"},
+			}
+		case "scoped-admin-token":
+			tr.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User: authenticationv1.UserInfo{
+					Username: "admin",
+					Extra:    map[string]authenticationv1.ExtraValue{"scopes": {"metrics"}},
+				},
+			}
+		case "user-token":
+			tr.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User:          authenticationv1.UserInfo{Username: "user"},
+			}
+		}
+
+		return true, tr, nil
+	})
+
+	c.PrependReactor("create", "subjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		*accessReviews++
+
+		sar := action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		sar.Status.Allowed = sar.Spec.User == "admin" &&
+			sar.Spec.NonResourceAttributes != nil &&
+			sar.Spec.NonResourceAttributes.Path == "/metrics" &&
+			sar.Spec.NonResourceAttributes.Verb == "get"
+
+		return true, sar, nil
+	})
+
+	return c
+}
+
+func TestNewDelegatedAuth(t *testing.T) {
+	da, err := NewDelegatedAuth(nil, &AuthConfig{}, nil)
+	require.NoError(t, err)
+	require.Nil(t, da)
+
+	// A nil *DelegatedAuth returns the original handler.
+	h := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+	require.NotNil(t, da.Handler(h))
+
+	_, err = NewDelegatedAuth(nil, &AuthConfig{Enabled: true}, nil)
+	require.Error(t, err)
+
+	_, err = NewDelegatedAuth(nil, &AuthConfig{Enabled: true, CacheTTL: -time.Second}, fake.NewClientset())
+	require.Error(t, err)
+}
+
+func TestDelegatedAuthHandler(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		method string
+		path   string
+		header string
+		fail   bool
+
+		expected int
+	}{
+		{
+			name:     "no authorization header",
+			path:     "/metrics",
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:     "basic authentication",
+			path:     "/metrics",
+			header:   "Basic YWRtaW46YWRtaW4=",
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:     "invalid token",
+			path:     "/metrics",
+			header:   "Bearer invalid-token",
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:     "authorized user",
+			path:     "/metrics",
+			header:   "Bearer admin-token",
+			expected: http.StatusOK,
+		},
+		{
+			name:     "authorized user with lower-case scheme",
+			path:     "/metrics",
+			header:   "bearer admin-token",
+			expected: http.StatusOK,
+		},
+		{
+			name:     "authorized user with forbidden path",
+			path:     "/debug/pprof/",
+			header:   "Bearer admin-token",
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "authorized user with forbidden verb",
+			method:   http.MethodPost,
+			path:     "/metrics",
+			header:   "Bearer admin-token",
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "unauthorized user",
+			path:     "/metrics",
+			header:   "Bearer user-token",
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "API server failure",
+			path:     "/metrics",
+			header:   "Bearer admin-token",
+			fail:     true,
+			expected: http.StatusInternalServerError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var tokenReviews, accessReviews int
+			da, err := NewDelegatedAuth(nil, &AuthConfig{Enabled: true, CacheTTL: time.Minute}, newFakeAuthClient(&tokenReviews, &accessReviews, tc.fail))
+			require.NoError(t, err)
+
+			h := da.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			req := httptest.NewRequest(method, tc.path, nil)
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expected, rec.Code)
+		})
+	}
+}
+
+func TestDelegatedAuthCache(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		ttl   time.Duration
+		token string
+
+		expectedCode          int
+		expectedTokenReviews  int
+		expectedAccessReviews int
+	}{
+		{
+			name:                  "cache enabled",
+			ttl:                   time.Minute,
+			token:                 "admin-token",
+			expectedCode:          http.StatusOK,
+			expectedTokenReviews:  1,
+			expectedAccessReviews: 1,
+		},
+		{
+			name:                  "cache disabled",
+			ttl:                   0,
+			token:                 "admin-token",
+			expectedCode:          http.StatusOK,
+			expectedTokenReviews:  3,
+			expectedAccessReviews: 3,
+		},
+		{
+			name:                 "invalid token not cached",
+			ttl:                  time.Minute,
+			token:                "invalid-token",
+			expectedCode:         http.StatusUnauthorized,
+			expectedTokenReviews: 3,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var tokenReviews, accessReviews int
+			da, err := NewDelegatedAuth(nil, &AuthConfig{Enabled: true, CacheTTL: tc.ttl}, newFakeAuthClient(&tokenReviews, &accessReviews, false))
+			require.NoError(t, err)
+
+			h := da.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			for range 3 {
+				req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+				require.Equal(t, tc.expectedCode, rec.Code)
+			}
+
+			require.Equal(t, tc.expectedTokenReviews, tokenReviews)
+			require.Equal(t, tc.expectedAccessReviews, accessReviews)
+		})
+	}
+}
+
+func TestDelegatedAuthCacheKeyIncludesExtra(t *testing.T) {
+	var tokenReviews, accessReviews int
+	da, err := NewDelegatedAuth(nil, &AuthConfig{Enabled: true, CacheTTL: time.Minute}, newFakeAuthClient(&tokenReviews, &accessReviews, false))
+	require.NoError(t, err)
+
+	h := da.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	// Both tokens authenticate the same user with different extra
+	// attributes.
+	for _, token := range []string{"admin-token", "scoped-admin-token", "admin-token", "scoped-admin-token"} {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	require.Equal(t, 2, tokenReviews)
+	require.Equal(t, 2, accessReviews)
+}
@@ -64,6 +64,10 @@ func DefaultConfig(listenAddress string, enableTLS bool) Config {
 			Curves:         operator.StringSet{},
 			ReloadInterval: time.Minute,
 		},
+
+		AuthConfig: AuthConfig{
+			CacheTTL: time.Minute,
+		},
 	}
 }
 
@@ -86,6 +90,9 @@ func RegisterFlags(fs *flag.FlagSet, c *Config) {
 		"If omitted, the default Go cipher suites will be used. "+
 		"Note that TLS 1.3 ciphersuites are not configurable.")
 	fs.Var(&c.TLSConfig.Curves, "web.tls-curves", "Comma-separated list of TLS curves for the server. Supported values: "+strings.Join(slices.Sorted(maps.Keys(supportedCurves)), ", ")+".")
+
+	fs.BoolVar(&c.AuthConfig.Enabled, "web.enable-delegated-auth", c.AuthConfig.Enabled, "Authenticate and authorize the requests to the metrics and debug endpoints using the Kubernetes TokenReview and SubjectAccessReview APIs.")
+	fs.DurationVar(&c.AuthConfig.CacheTTL, "web.delegated-auth-cache-ttl", c.AuthConfig.CacheTTL, "Duration for which the delegated authentication and authorization decisions are cached.")
 }
 
 var supportedCurves = map[string]tls.CurveID{}
@@ -109,6 +116,7 @@ type Config struct {
 	ListenAddress string
 	EnableHTTP2   bool
 	TLSConfig     TLSConfig
+	AuthConfig    AuthConfig
 }
 
 // TLSConfig defines the TLS settings of the web server.
@@ -15,10 +15,16 @@
 package thanos
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
+	"net"
+	"path"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -55,8 +61,11 @@ const (
 	applicationNameLabelValue = "thanos-ruler"
 	controllerName            = "thanos-controller"
 	rwConfigFile              = "remote-write.yaml"
+	alertmanagersConfigFile   = "alertmanagers.yaml"
 
 	noSelectedResourcesMessage = "No PrometheusRule have been selected."
+
+	unresolvedAlertmanagersReason = "UnresolvedAlertmanagers"
 )
 
 var minRemoteWriteVersion = semver.MustParse("0.24.0")
@@ -78,6 +87,9 @@ type Operator struct {
 	thanosRulerInfs *informers.ForResource
 	cmapInfs        *informers.ForResource
 	ruleInfs        *informers.ForResource
+	amInfs          *informers.ForResource
+	amSvcInfs       *informers.ForResource
+	amSsetInfs      *informers.ForResource
 	ssetInfs        *informers.ForResource
 
 	rr *operator.ResourceReconciler
@@ -94,6 +106,7 @@ type Operator struct {
 	config Config
 
 	configResourcesStatusEnabled bool
+	alertmanagerSupported        bool
 
 	finalizerSyncer *operator.FinalizerSyncer
 }
@@ -127,6 +140,15 @@ func WithConfigResourceStatus() ControllerOption {
 	}
 }
 
+// WithAlertmanager tells that the controller can watch Alertmanager objects
+// (and their governing services) to resolve the Alertmanager selectors and
+// references of ThanosRuler objects.
+func WithAlertmanager() ControllerOption {
+	return func(o *Operator) {
+		o.alertmanagerSupported = true
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -245,6 +267,58 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("error creating prometheusrule informers: %w", err)
 	}
 
+	if o.alertmanagerSupported {
+		// The Alertmanager resources are watched to resolve the Alertmanager
+		// selectors and references of the ThanosRuler resources.
+		o.amInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AlertmanagerAllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				func(options *metav1.ListOptions) {
+					options.LabelSelector = c.AlertmanagerSelector.String()
+				},
+			),
+			monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating alertmanager informers: %w", err)
+		}
+
+		// The governing services and the StatefulSets of the Alertmanager
+		// resources are watched to resolve the web port of the pods.
+		o.amSvcInfs, err = informers.NewInformersForResource(
+			informers.NewKubeInformerFactories(
+				c.Namespaces.AlertmanagerAllowList,
+				c.Namespaces.DenyList,
+				o.kclient,
+				resyncPeriod,
+				nil,
+			),
+			corev1.SchemeGroupVersion.WithResource(string(corev1.ResourceServices)),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating alertmanager service informers: %w", err)
+		}
+
+		o.amSsetInfs, err = informers.NewInformersForResource(
+			informers.NewKubeInformerFactories(
+				c.Namespaces.AlertmanagerAllowList,
+				c.Namespaces.DenyList,
+				o.kclient,
+				resyncPeriod,
+				func(options *metav1.ListOptions) {
+					options.LabelSelector = labelSelectorForAlertmanagerStatefulSets()
+				},
+			),
+			appsv1.SchemeGroupVersion.WithResource("statefulsets"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating alertmanager statefulset informers: %w", err)
+		}
+	}
+
 	o.ssetInfs, err = informers.NewInformersForResource(
 		informers.NewKubeInformerFactories(
 			c.Namespaces.ThanosRulerAllowList,
@@ -309,8 +383,17 @@ func (o *Operator) waitForCacheSync(ctx context.Context) error {
 		{"ThanosRuler", o.thanosRulerInfs},
 		{"ConfigMap", o.cmapInfs},
 		{"PrometheusRule", o.ruleInfs},
+		{"Alertmanager", o.amInfs},
+		{"AlertmanagerService", o.amSvcInfs},
+		{"AlertmanagerStatefulSet", o.amSsetInfs},
 		{"StatefulSet", o.ssetInfs},
 	} {
+		// Skipping informers that were not started. If the prerequisites for
+		// the Alertmanager CRD were not met, their informers will be nil.
+		if infs.informersForResource == nil {
+			continue
+		}
+
 		for _, inf := range infs.informersForResource.GetInformers() {
 			if !operator.WaitForNamedCacheSync(ctx, "thanos", o.logger.With("informer", infs.name), inf.Informer()) {
 				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
@@ -362,6 +445,40 @@ func (o *Operator) addHandlers() {
 		),
 	))
 
+	if o.alertmanagerSupported {
+		o.amInfs.AddEventHandler(operator.NewEventHandler(
+			o.logger,
+			o.accessor,
+			o.metrics,
+			monitoringv1.AlertmanagersKind,
+			o.enqueueForAlertmanagerNamespace,
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+
+		o.amSvcInfs.AddEventHandler(operator.NewEventHandler(
+			o.logger,
+			o.accessor,
+			o.metrics,
+			"Service",
+			o.enqueueForAlertmanagerNamespace,
+			operator.WithFilter(operator.ResourceVersionChanged),
+		))
+
+		o.amSsetInfs.AddEventHandler(operator.NewEventHandler(
+			o.logger,
+			o.accessor,
+			o.metrics,
+			"StatefulSet",
+			o.enqueueForAlertmanagerNamespace,
+			operator.WithFilter(operator.GenerationChanged),
+		))
+	}
+
 	// The controller needs to watch the namespaces in which the rules live
 	// because a label change on a namespace may trigger a configuration
 	// change.
@@ -380,6 +497,11 @@ func (o *Operator) Run(ctx context.Context) error {
 	go o.thanosRulerInfs.Start(ctx.Done())
 	go o.cmapInfs.Start(ctx.Done())
 	go o.ruleInfs.Start(ctx.Done())
+	if o.alertmanagerSupported {
+		go o.amInfs.Start(ctx.Done())
+		go o.amSvcInfs.Start(ctx.Done())
+		go o.amSsetInfs.Start(ctx.Done())
+	}
 	go o.nsRuleInf.Run(ctx.Done())
 	if o.nsRuleInf != o.nsThanosRulerInf {
 		go o.nsThanosRulerInf.Run(ctx.Done())
@@ -548,7 +670,12 @@ func (o *Operator) sync(ctx context.Context, key string) (func(context.Context)
 
 	assetStore := assets.NewStoreBuilder(o.kclient.CoreV1(), o.kclient.CoreV1())
 
-	if err := o.createOrUpdateRulerConfigSecret(ctx, assetStore, tr); err != nil {
+	amConfig, err := o.generateAlertmanagersConfig(ctx, logger, assetStore, tr)
+	if err != nil {
+		return closure, err
+	}
+
+	if err := o.createOrUpdateRulerConfigSecret(ctx, assetStore, tr, amConfig); err != nil {
 		return closure, fmt.Errorf("failed to synchronize ruler config secret: %w", err)
 	}
 
@@ -590,7 +717,12 @@ func (o *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		return closure, nil
 	}
 
-	newSSetInputHash, err := createSSetInputHash(*tr, o.config, tlsAssets, ruleConfigMapNames, existingStatefulSet.Spec)
+	var amConfigFile []byte
+	if amConfig != nil {
+		amConfigFile = amConfig.config
+	}
+
+	newSSetInputHash, err := createSSetInputHash(*tr, o.config, tlsAssets, ruleConfigMapNames, amConfigFile, existingStatefulSet.Spec)
 	if err != nil {
 		return closure, err
 	}
@@ -745,7 +877,7 @@ func (o *Operator) UpdateStatus(ctx context.Context, key string) error {
 	return nil
 }
 
-func createSSetInputHash(tr monitoringv1.ThanosRuler, c Config, tlsAssets *operator.ShardedSecret, ruleConfigMapNames []string, ss appsv1.StatefulSetSpec) (string, error) {
+func createSSetInputHash(tr monitoringv1.ThanosRuler, c Config, tlsAssets *operator.ShardedSecret, ruleConfigMapNames []string, alertmanagersConfig []byte, ss appsv1.StatefulSetSpec) (string, error) {
 
 	// The controller should ignore any changes to RevisionHistoryLimit field because
 	// it may be modified by external actors.
@@ -760,6 +892,9 @@ func createSSetInputHash(tr monitoringv1.ThanosRuler, c Config, tlsAssets *opera
 		StatefulSetSpec        appsv1.StatefulSetSpec
 		RuleConfigMaps         []string `hash:"set"`
 		ShardedSecret          *operator.ShardedSecret
+		// Thanos Ruler doesn't reload the Alertmanager configuration file
+		// (the file SD files are excluded on purpose).
+		AlertmanagersConfig []byte
 	}{
 		ThanosRulerLabels:      tr.Labels,
 		ThanosRulerAnnotations: tr.Annotations,
@@ -768,6 +903,7 @@ func createSSetInputHash(tr monitoringv1.ThanosRuler, c Config, tlsAssets *opera
 		StatefulSetSpec:        ss,
 		RuleConfigMaps:         ruleConfigMapNames,
 		ShardedSecret:          tlsAssets,
+		AlertmanagersConfig:    alertmanagersConfig,
 	},
 		nil,
 	)
@@ -786,6 +922,23 @@ func (o *Operator) enqueueForRulesNamespace(nsName string) {
 	o.enqueueForNamespace(o.nsRuleInf.GetStore(), nsName)
 }
 
+// enqueueForAlertmanagerNamespace enqueues all ThanosRuler object keys which
+// select or reference Alertmanager resources in the given namespace.
+func (o *Operator) enqueueForAlertmanagerNamespace(nsName string) {
+	err := o.thanosRulerInfs.ListAll(labels.Everything(), func(obj any) {
+		tr := obj.(*monitoringv1.ThanosRuler)
+
+		if operator.SelectsAlertmanagersInNamespace(tr.Namespace, tr.Spec.AlertmanagerSelector, tr.Spec.AlertmanagerRefs, nsName) {
+			o.rr.EnqueueForReconciliation(tr)
+		}
+	})
+	if err != nil {
+		o.logger.Error("listing all ThanosRuler instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
 // enqueueForNamespace enqueues all ThanosRuler object keys that belong to the
 // given namespace or select objects in the given namespace.
 func (o *Operator) enqueueForNamespace(store cache.Store, nsName string) {
@@ -930,7 +1083,182 @@ func labelSelectorForStatefulSets() string {
 	)
 }
 
-func (o *Operator) createOrUpdateRulerConfigSecret(ctx context.Context, store *assets.StoreBuilder, tr *monitoringv1.ThanosRuler) error {
+// labelSelectorForAlertmanagerStatefulSets returns the label selector
+// matching the StatefulSets of the Alertmanager resources.
+func labelSelectorForAlertmanagerStatefulSets() string {
+	return fmt.Sprintf(
+		"%s in (%s),%s in (%s)",
+		operator.ManagedByLabelKey, operator.ManagedByLabelValue,
+		operator.ApplicationNameLabelKey, "alertmanager",
+	)
+}
+
+// alertmanagersConfig is the Thanos Ruler configuration generated from the
+// Alertmanager resources.
+type alertmanagersConfig struct {
+	// config is the content of the Alertmanager configuration file which
+	// Thanos Ruler reads only at startup.
+	config []byte
+	// sdFiles contains the addresses of the Alertmanager pods, keyed by file
+	// name. Thanos Ruler watches the files for changes.
+	sdFiles map[string][]byte
+}
+
+// alertmanagersGroup identifies Alertmanager resources sharing the same
+// endpoint settings.
+type alertmanagersGroup struct {
+	scheme     string
+	pathPrefix string
+	apiVersion string
+}
+
+// generateAlertmanagersConfig returns the Thanos Ruler configuration of the
+// Alertmanager resources selected or referenced by the ThanosRuler resource.
+// It returns nil if the configuration isn't managed by the operator.
+//
+// The Alertmanager resources are grouped by endpoint settings and the
+// addresses of each group are written to a file SD file. As a result, the
+// configuration file (which requires a restart of Thanos Ruler) changes only
+// when the set of groups changes.
+//
+// Alertmanager resources whose web port can't be resolved are ignored and
+// reported in the status.
+func (o *Operator) generateAlertmanagersConfig(ctx context.Context, logger *slog.Logger, store *assets.StoreBuilder, tr *monitoringv1.ThanosRuler) (*alertmanagersConfig, error) {
+	if !alertmanagerResourcesSelected(tr) {
+		return nil, nil
+	}
+
+	if err := store.AddSafeTLSConfig(ctx, tr.Namespace, tr.Spec.AlertmanagerTLSConfig); err != nil {
+		return nil, fmt.Errorf("alertmanagerTLSConfig: %w", err)
+	}
+
+	var (
+		key        = operator.KeyForObject(tr)
+		ams        []*monitoringv1.Alertmanager
+		unresolved []string
+		err        error
+	)
+	if o.alertmanagerSupported {
+		ams, err = operator.SelectAlertmanagers(o.amInfs, tr.Namespace, tr.Spec.AlertmanagerSelector, tr.Spec.AlertmanagerRefs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to select Alertmanager resources: %w", err)
+		}
+	} else {
+		logger.Warn("ignoring alertmanagerSelector and alertmanagerRefs because the operator doesn't watch Alertmanager resources")
+		o.reconciliations.AddReasonAndMessage(key, operator.AlertmanagerResourcesUnsupportedReason, operator.AlertmanagerResourcesUnsupportedMessage)
+	}
+
+	targets := map[alertmanagersGroup][]string{}
+	for _, am := range ams {
+		ep := operator.NewAlertmanagerEndpoint(am)
+		g := alertmanagersGroup{
+			scheme:     strings.ToLower(string(ep.Scheme)),
+			pathPrefix: ep.PathPrefix,
+			apiVersion: strings.ToLower(string(ep.APIVersion)),
+		}
+
+		if _, found := targets[g]; !found {
+			targets[g] = []string{}
+		}
+
+		if len(ep.Hosts) == 0 {
+			continue
+		}
+
+		port, err := operator.ResolveAlertmanagerPort(o.amSvcInfs, o.amSsetInfs, ep)
+		if err != nil {
+			logger.Warn("ignoring Alertmanager whose port can't be resolved", "alertmanager", ep.Namespace+"/"+ep.Name, "err", err)
+			unresolved = append(unresolved, fmt.Sprintf("%s/%s: %s", ep.Namespace, ep.Name, err))
+			continue
+		}
+
+		for _, host := range ep.Hosts {
+			targets[g] = append(targets[g], net.JoinHostPort(host, strconv.Itoa(int(port))))
+		}
+	}
+
+	if len(unresolved) > 0 {
+		o.reconciliations.AddReasonAndMessage(key, unresolvedAlertmanagersReason, "failed to resolve the port of the Alertmanager resources: "+strings.Join(unresolved, "; "))
+	}
+
+	groups := slices.SortedFunc(maps.Keys(targets), func(a, b alertmanagersGroup) int {
+		return cmp.Or(
+			cmp.Compare(a.scheme, b.scheme),
+			cmp.Compare(a.pathPrefix, b.pathPrefix),
+			cmp.Compare(a.apiVersion, b.apiVersion),
+		)
+	})
+
+	amc := &alertmanagersConfig{
+		sdFiles: make(map[string][]byte, len(groups)),
+	}
+	cfgs := make([]yaml.MapSlice, 0, len(groups))
+	for i, g := range groups {
+		sdFile := fmt.Sprintf("alertmanagers-sd-%d.yaml", i)
+		b, err := yaml.Marshal([]yaml.MapSlice{{{Key: "targets", Value: targets[g]}}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal Alertmanager targets: %w", err)
+		}
+		amc.sdFiles[sdFile] = b
+
+		cfg := yaml.MapSlice{
+			{Key: "file_sd_configs", Value: []yaml.MapSlice{
+				{{Key: "files", Value: []string{path.Join(alertmanagersConfigDir, sdFile)}}},
+			}},
+			{Key: "scheme", Value: g.scheme},
+			{Key: "path_prefix", Value: g.pathPrefix},
+			{Key: "api_version", Value: g.apiVersion},
+		}
+
+		if g.scheme == "https" && tr.Spec.AlertmanagerTLSConfig != nil {
+			cfg = append(cfg, yaml.MapItem{
+				Key: "http_config",
+				Value: yaml.MapSlice{
+					{Key: "tls_config", Value: alertmanagersTLSConfig(store.ForNamespace(tr.Namespace), tr.Spec.AlertmanagerTLSConfig)},
+				},
+			})
+		}
+
+		cfgs = append(cfgs, cfg)
+	}
+
+	amc.config, err = yaml.Marshal(yaml.MapSlice{{Key: "alertmanagers", Value: cfgs}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Alertmanager configuration: %w", err)
+	}
+
+	return amc, nil
+}
+
+// alertmanagersTLSConfig returns the Thanos TLS configuration for the given
+// TLS settings. The certificates and keys are read from the TLS assets.
+func alertmanagersTLSConfig(store assets.StoreGetter, tlsConfig *monitoringv1.SafeTLSConfig) yaml.MapSlice {
+	cfg := yaml.MapSlice{}
+
+	if tlsConfig.CA.Secret != nil || tlsConfig.CA.ConfigMap != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "ca_file", Value: path.Join(tlsAssetsDir, store.TLSAsset(tlsConfig.CA))})
+	}
+
+	if tlsConfig.Cert.Secret != nil || tlsConfig.Cert.ConfigMap != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "cert_file", Value: path.Join(tlsAssetsDir, store.TLSAsset(tlsConfig.Cert))})
+	}
+
+	if tlsConfig.KeySecret != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "key_file", Value: path.Join(tlsAssetsDir, store.TLSAsset(tlsConfig.KeySecret))})
+	}
+
+	if tlsConfig.ServerName != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "server_name", Value: *tlsConfig.ServerName})
+	}
+
+	if tlsConfig.InsecureSkipVerify != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "insecure_skip_verify", Value: *tlsConfig.InsecureSkipVerify})
+	}
+
+	return cfg
+}
+
+func (o *Operator) createOrUpdateRulerConfigSecret(ctx context.Context, store *assets.StoreBuilder, tr *monitoringv1.ThanosRuler, amConfig *alertmanagersConfig) error {
 	sClient := o.kclient.CoreV1().Secrets(tr.GetNamespace())
 
 	s := &corev1.Secret{
@@ -1078,6 +1406,11 @@ func (o *Operator) createOrUpdateRulerConfigSecret(ctx context.Context, store *a
 	}
 	s.Data[rwConfigFile] = rwConfig
 
+	if amConfig != nil {
+		s.Data[alertmanagersConfigFile] = amConfig.config
+		maps.Copy(s.Data, amConfig.sdFiles)
+	}
+
 	if err = k8s.CreateOrUpdateSecret(ctx, sClient, s); err != nil {
 		return err
 	}
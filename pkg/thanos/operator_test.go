@@ -21,13 +21,19 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"gotest.tools/v3/golden"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
 
@@ -125,7 +131,7 @@ func TestCreateOrUpdateRulerConfigSecret(t *testing.T) {
 				},
 			)
 
-			err := o.createOrUpdateRulerConfigSecret(context.Background(), sb, tr)
+			err := o.createOrUpdateRulerConfigSecret(context.Background(), sb, tr, nil)
 			if tc.expectErr {
 				require.Error(t, err)
 				return
@@ -138,3 +144,191 @@ func TestCreateOrUpdateRulerConfigSecret(t *testing.T) {
 		})
 	}
 }
+
+const caPEM = `-----BEGIN CERTIFICATE-----
+MIIB4zCCAY2gAwIBAgIUf+9T+SQuY7RzRfLrT/m3ZLZa/nswDQYJKoZIhvcNAQEL
+BQAwRTELMAkGA1UEBhMCQVUxEzARBgNVBAgMClNvbWUtU3RhdGUxITAfBgNVBAoM
+GEludGVybmV0IFdpZGdpdHMgUHR5IEx0ZDAgFw0yMDEwMTkxMzA1MDlaGA8yMTIw
+MDkyNTEzMDUwOVowRTELMAkGA1UEBhMCQVUxEzARBgNVBAgMClNvbWUtU3RhdGUx
+ITAfBgNVBAoMGEludGVybmV0IFdpZGdpdHMgUHR5IEx0ZDBcMA0GCSqGSIb3DQEB
+AQUAA0sAMEgCQQDbXwmz6fkHnfs3p5dirgW/m5G1eOSddS8atIwhOzaYSNG03/Z4
+P6HWCGDCgUg77fOsX+tzYWkXy0T+GwQrTLDdAgMBAAGjUzBRMB0GA1UdDgQWBBTC
+CNvaPTFE1Xt5WUREDoF/mTOg7DAfBgNVHSMEGDAWgBTCCNvaPTFE1Xt5WUREDoF/
+mTOg7DAPBgNVHRMBAf8EBTADAQH/MA0GCSqGSIb3DQEBCwUAA0EAzhzA2n5nSnka
+k9iw9ZHayRBSgnGAYKFdiGyvceKPzR3LJ8vMdGeYh/TSHHgZ4QSam/J7vHWCkJmc
+7c98vpkIaw==
+-----END CERTIFICATE-----`
+
+func TestGenerateAlertmanagersConfig(t *testing.T) {
+	ams := []runtime.Object{
+		&monitoringv1.Alertmanager{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default", Labels: map[string]string{"team": "a"}},
+			Spec:       monitoringv1.AlertmanagerSpec{Replicas: ptr.To(int32(2))},
+		},
+		&monitoringv1.Alertmanager{
+			ObjectMeta: metav1.ObjectMeta{Name: "secure", Namespace: "monitoring"},
+			Spec: monitoringv1.AlertmanagerSpec{
+				ServiceName: ptr.To("alertmanager-secure"),
+				Web: &monitoringv1.AlertmanagerWebSpec{
+					WebConfigFileFields: monitoringv1.WebConfigFileFields{
+						TLSConfig: &monitoringv1.WebTLSConfig{},
+					},
+				},
+			},
+		},
+		&monitoringv1.Alertmanager{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-service", Namespace: "default"},
+			Spec:       monitoringv1.AlertmanagerSpec{ServiceName: ptr.To("missing")},
+		},
+	}
+
+	objects := []runtime.Object{
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-operated", Namespace: "default"},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Name: "web", Port: 9093, TargetPort: intstr.FromString("web")}},
+			},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-main", Namespace: "default"},
+			Spec: appsv1.StatefulSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{Name: "alertmanager", Ports: []corev1.ContainerPort{{Name: "web", ContainerPort: 9093}}},
+						},
+					},
+				},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-secure", Namespace: "monitoring"},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Name: "web", Port: 8443, TargetPort: intstr.FromInt32(8443)}},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "am-tls", Namespace: "default"},
+			Data:       map[string][]byte{"ca.crt": []byte(caPEM)},
+		},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		spec        monitoringv1.ThanosRulerSpec
+		unsupported bool
+		golden      string
+		sdFiles     map[string]string
+		reason      string
+	}{
+		{
+			name: "selector",
+			spec: monitoringv1.ThanosRulerSpec{
+				AlertmanagerSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			},
+			golden: "alertmanagers_selector.golden",
+			sdFiles: map[string]string{
+				"alertmanagers-sd-0.yaml": "- targets:\n  - alertmanager-main-0.alertmanager-operated.default.svc:9093\n  - alertmanager-main-1.alertmanager-operated.default.svc:9093\n",
+			},
+		},
+		{
+			name: "references with TLS",
+			spec: monitoringv1.ThanosRulerSpec{
+				AlertmanagerRefs: []monitoringv1.AlertmanagerReference{
+					{Name: "main"},
+					{Name: "secure", Namespace: ptr.To("monitoring")},
+					{Name: "missing"},
+				},
+				AlertmanagerTLSConfig: &monitoringv1.SafeTLSConfig{
+					CA: monitoringv1.SecretOrConfigMap{
+						Secret: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "am-tls"},
+							Key:                  "ca.crt",
+						},
+					},
+					ServerName: ptr.To("alertmanager"),
+				},
+			},
+			golden: "alertmanagers_refs_tls.golden",
+			sdFiles: map[string]string{
+				"alertmanagers-sd-0.yaml": "- targets:\n  - alertmanager-main-0.alertmanager-operated.default.svc:9093\n  - alertmanager-main-1.alertmanager-operated.default.svc:9093\n",
+				"alertmanagers-sd-1.yaml": "- targets:\n  - alertmanager-secure-0.alertmanager-secure.monitoring.svc:8443\n",
+			},
+		},
+		{
+			name: "unresolved port",
+			spec: monitoringv1.ThanosRulerSpec{
+				AlertmanagerRefs: []monitoringv1.AlertmanagerReference{
+					{Name: "main"},
+					{Name: "no-service"},
+				},
+			},
+			golden: "alertmanagers_selector.golden",
+			sdFiles: map[string]string{
+				"alertmanagers-sd-0.yaml": "- targets:\n  - alertmanager-main-0.alertmanager-operated.default.svc:9093\n  - alertmanager-main-1.alertmanager-operated.default.svc:9093\n",
+			},
+			reason: unresolvedAlertmanagersReason,
+		},
+		{
+			name: "alertmanager not supported",
+			spec: monitoringv1.ThanosRulerSpec{
+				AlertmanagerSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			},
+			unsupported: true,
+			golden:      "alertmanagers_unsupported.golden",
+			sdFiles:     map[string]string{},
+			reason:      operator.AlertmanagerResourcesUnsupportedReason,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			kclient := fake.NewClientset(objects...)
+			o := &Operator{
+				kclient:               kclient,
+				logger:                slog.Default(),
+				reconciliations:       &operator.ReconciliationTracker{},
+				alertmanagerSupported: !tc.unsupported,
+			}
+
+			if o.alertmanagerSupported {
+				var err error
+				o.amInfs, err = informers.NewInformersForResource(
+					informers.NewMonitoringInformerFactories(map[string]struct{}{metav1.NamespaceAll: {}}, nil, monitoringfake.NewSimpleClientset(ams...), 0, nil),
+					monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName),
+				)
+				require.NoError(t, err)
+
+				ifs := informers.NewKubeInformerFactories(map[string]struct{}{metav1.NamespaceAll: {}}, nil, kclient, 0, nil)
+				o.amSvcInfs, err = informers.NewInformersForResource(ifs, corev1.SchemeGroupVersion.WithResource(string(corev1.ResourceServices)))
+				require.NoError(t, err)
+				o.amSsetInfs, err = informers.NewInformersForResource(ifs, appsv1.SchemeGroupVersion.WithResource("statefulsets"))
+				require.NoError(t, err)
+
+				for _, infs := range []*informers.ForResource{o.amInfs, o.amSvcInfs, o.amSsetInfs} {
+					infs.Start(ctx.Done())
+					require.True(t, cache.WaitForCacheSync(ctx.Done(), infs.HasSynced))
+				}
+			}
+
+			tr := &monitoringv1.ThanosRuler{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Spec:       tc.spec,
+			}
+
+			amc, err := o.generateAlertmanagersConfig(ctx, slog.Default(), assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1()), tr)
+			require.NoError(t, err)
+
+			if tc.reason != "" {
+				cond := o.reconciliations.GetCondition(operator.KeyForObject(tr), 1)
+				require.Equal(t, tc.reason, cond.Reason)
+			}
+
+			golden.Assert(t, string(amc.config), tc.golden)
+			require.Len(t, amc.sdFiles, len(tc.sdFiles))
+			for k, v := range tc.sdFiles {
+				require.Equal(t, v, string(amc.sdFiles[k]))
+			}
+		})
+	}
+}
@@ -41,6 +41,7 @@ import (
 const (
 	rulesDir                  = "/etc/thanos/rules"
 	configDir                 = "/etc/thanos/config"
+	alertmanagersConfigDir    = "/etc/thanos/config/alertmanagers-config"
 	storageDir                = "/thanos/data"
 	webConfigDir              = "/etc/thanos/web_config"
 	tlsAssetsDir              = "/etc/thanos/certs"
@@ -267,6 +268,23 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 	if tr.Spec.AlertManagersConfig != nil {
 		trVolumes, trVolumeMounts, fullPath = mountSecretKey(trVolumes, trVolumeMounts, tr.Spec.AlertManagersConfig, "alertmanager-config")
 		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "alertmanagers.config-file", Value: fullPath})
+	} else if alertmanagerResourcesSelected(tr) {
+		// The whole secret is mounted (without subPath) so that the updates
+		// of the file SD files are propagated to the running pods.
+		trVolumes = append(trVolumes, corev1.Volume{
+			Name: "alertmanagers-config",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: rulerConfigSecretName(tr.Name),
+				},
+			},
+		})
+		trVolumeMounts = append(trVolumeMounts, corev1.VolumeMount{
+			Name:      "alertmanagers-config",
+			MountPath: alertmanagersConfigDir,
+			ReadOnly:  true,
+		})
+		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "alertmanagers.config-file", Value: filepath.Join(alertmanagersConfigDir, alertmanagersConfigFile)})
 	} else if len(tr.Spec.AlertManagersURL) > 0 {
 		for _, url := range tr.Spec.AlertManagersURL {
 			trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "alertmanagers.url", Value: url})
@@ -621,6 +639,16 @@ func mountSecretKey(vols []corev1.Volume, vmounts []corev1.VolumeMount, secretSe
 		filepath.Join(mountpath, secretSelector.Key)
 }
 
+// alertmanagerResourcesSelected returns true if the Alertmanager
+// configuration is generated from Alertmanager resources.
+func alertmanagerResourcesSelected(tr *monitoringv1.ThanosRuler) bool {
+	if tr.Spec.AlertManagersConfig != nil {
+		return false
+	}
+
+	return tr.Spec.AlertmanagerSelector != nil || len(tr.Spec.AlertmanagerRefs) > 0
+}
+
 func rulerConfigSecretName(name string) string {
 	return fmt.Sprintf("%s-config", prefixedName(name))
 }
@@ -1617,3 +1617,55 @@ func TestStatefulSetUpdateStrategy(t *testing.T) {
 		})
 	}
 }
+
+func TestAlertmanagerResources(t *testing.T) {
+	const expectedArg = "--alertmanagers.config-file=/etc/thanos/config/alertmanagers-config/alertmanagers.yaml"
+
+	for _, tc := range []struct {
+		name     string
+		spec     monitoringv1.ThanosRulerSpec
+		expected bool
+	}{
+		{
+			name: "no alertmanager resources",
+		},
+		{
+			name: "alertmanager references",
+			spec: monitoringv1.ThanosRulerSpec{
+				AlertmanagerRefs: []monitoringv1.AlertmanagerReference{{Name: "main"}},
+			},
+			expected: true,
+		},
+		{
+			name: "alertmanager selector",
+			spec: monitoringv1.ThanosRulerSpec{
+				AlertmanagerSelector: &metav1.LabelSelector{},
+			},
+			expected: true,
+		},
+		{
+			name: "alertmanagersConfig takes precedence",
+			spec: monitoringv1.ThanosRulerSpec{
+				AlertManagersConfig: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "alertmanagers"},
+					Key:                  "config.yaml",
+				},
+				AlertmanagerRefs: []monitoringv1.AlertmanagerReference{{Name: "main"}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.spec.QueryEndpoints = emptyQueryEndpoints
+			sset, err := makeStatefulSet(&monitoringv1.ThanosRuler{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       tc.spec,
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, slices.Contains(sset.Spec.Template.Spec.Containers[0].Args, expectedArg))
+			require.Equal(t, tc.expected, slices.ContainsFunc(sset.Spec.Template.Spec.Volumes, func(v corev1.Volume) bool {
+				return v.Name == "alertmanagers-config"
+			}))
+		})
+	}
+}
@@ -390,6 +390,13 @@ func (f *Framework) CreateOrUpdatePrometheusOperatorWithOpts(
 		return nil, fmt.Errorf("initialize PrometheusAgent v1alpha1 CRD: %w", err)
 	}
 
+	err = f.CreateOrUpdateCRDAndWaitUntilReady(ctx, monitoringv1alpha1.ReceiverTestName, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return f.MonClientV1alpha1.ReceiverTests(corev1.NamespaceAll).List(ctx, opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("initialize ReceiverTest v1alpha1 CRD: %w", err)
+	}
+
 	if opts.EnableScrapeConfigs {
 		err = f.CreateOrUpdateCRDAndWaitUntilReady(ctx, monitoringv1alpha1.ScrapeConfigName, func(opts metav1.ListOptions) (runtime.Object, error) {
 			return f.MonClientV1alpha1.ScrapeConfigs(corev1.NamespaceAll).List(ctx, opts)